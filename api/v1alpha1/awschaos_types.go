@@ -63,6 +63,11 @@ type AWSChaosSpec struct {
 	// +optional
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
 	// SecretName defines the name of kubernetes secret.
 	// +optional
 	SecretName *string `json:"secretName,omitempty" webhook:",nilable"`
@@ -72,6 +77,16 @@ type AWSChaosSpec struct {
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
 }
 
 // AWSChaosStatus represents the status of an AWSChaos
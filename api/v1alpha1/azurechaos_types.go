@@ -63,6 +63,11 @@ type AzureChaosSpec struct {
 	// +optional
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
 	AzureSelector `json:",inline"`
 }
 
@@ -98,6 +103,16 @@ type AzureSelector struct {
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
 }
 
 func (obj *AzureChaos) GetSelectorSpecs() map[string]interface{} {
@@ -54,9 +54,24 @@ type BlockChaosSpec struct {
 	// +optional
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
 }
 
 // BlockDelaySpec describes the block delay specification
@@ -78,6 +93,13 @@ type ContainerNodeVolumePathSelector struct {
 	VolumeName string `json:"volumeName"`
 }
 
+func (in *ContainerNodeVolumePathSelector) WithAllMode() interface{} {
+	selector := in.DeepCopy()
+	selector.Mode = AllMode
+	selector.Value = ""
+	return selector
+}
+
 // BlockChaosStatus represents the status of a BlockChaos
 type BlockChaosStatus struct {
 	ChaosStatus `json:",inline"`
@@ -0,0 +1,185 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +chaos-mesh:base
+// +chaos-mesh:webhook:enableUpdate
+
+// ChaosPolicy restricts the chaos experiments which could be created in,
+// or target, a set of namespaces.
+type ChaosPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the restrictions of the policy
+	Spec ChaosPolicySpec `json:"spec"`
+}
+
+// ChaosPolicySpec defines the restrictions applied to the matched namespaces.
+// An experiment is governed by a policy if its own namespace, or any namespace
+// it targets, is matched by the policy. If both Namespaces and NamespaceSelectors
+// are empty, the policy governs all namespaces.
+type ChaosPolicySpec struct {
+	// Namespaces is a set of namespaces governed by this policy.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelectors is a map of labels, the namespaces which have all these
+	// labels are governed by this policy.
+	// +optional
+	NamespaceSelectors map[string]string `json:"namespaceSelectors,omitempty"`
+
+	// Rules defines the allowed kinds and actions of chaos. If it's empty,
+	// all kinds of chaos are allowed.
+	// +optional
+	Rules []ChaosPolicyRule `json:"rules,omitempty"`
+
+	// MaxConcurrent is the maximum number of running experiments governed by
+	// this policy at the same time.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxConcurrent *int `json:"maxConcurrent,omitempty"`
+
+	// MaxDuration is the maximum duration of a governed experiment. An experiment
+	// without duration is considered to be running forever, and will be rejected.
+	// +optional
+	MaxDuration *string `json:"maxDuration,omitempty" webhook:"Duration"`
+
+	// MaxPodsPercent is the maximum percentage of pods in a governed namespace
+	// which could be affected by a single experiment. It's a number from 0-100.
+	// +optional
+	MaxPodsPercent *int `json:"maxPodsPercent,omitempty" webhook:"Percent"`
+
+	// BlackoutWindows are the periods during which no chaos is allowed in the
+	// governed namespaces. Running experiments are stopped when a window begins.
+	// +optional
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty"`
+}
+
+// ChaosPolicyRule allows a kind of chaos, and optionally restricts its actions.
+type ChaosPolicyRule struct {
+	// Kind is the kind of chaos, e.g. NetworkChaos
+	Kind string `json:"kind"`
+
+	// Actions is the allowed actions of this kind. If it's empty, all actions
+	// are allowed.
+	// +optional
+	Actions []string `json:"actions,omitempty"`
+}
+
+// BlackoutWindow is a recurring period during which chaos is forbidden.
+type BlackoutWindow struct {
+	// Schedule is a cron expression for the beginning of the window. The time
+	// zone could be specified with a `CRON_TZ=` prefix.
+	Schedule string `json:"schedule"`
+
+	// Duration is the length of the window, started from each activation of the schedule.
+	Duration string `json:"duration" webhook:"Duration"`
+}
+
+// +kubebuilder:object:root=true
+
+// ChaosPolicyList contains a list of ChaosPolicy
+type ChaosPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ChaosPolicy `json:"items"`
+}
+
+// Matches returns whether the namespace with given labels is governed by the policy
+func (in *ChaosPolicySpec) Matches(namespace string, namespaceLabels map[string]string) bool {
+	if len(in.Namespaces) == 0 && len(in.NamespaceSelectors) == 0 {
+		return true
+	}
+
+	for _, ns := range in.Namespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+
+	if len(in.NamespaceSelectors) > 0 {
+		return labels.SelectorFromSet(in.NamespaceSelectors).Matches(labels.Set(namespaceLabels))
+	}
+
+	return false
+}
+
+// Allows returns whether the kind of chaos with the action is allowed by the rules
+func (in *ChaosPolicySpec) Allows(kind string, action string) bool {
+	if len(in.Rules) == 0 {
+		return true
+	}
+
+	for _, rule := range in.Rules {
+		if rule.Kind != kind {
+			continue
+		}
+		if len(rule.Actions) == 0 {
+			return true
+		}
+		for _, allowed := range rule.Actions {
+			if allowed == action {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ActiveUntil returns the end of the window if now is inside the window,
+// and returns nil if it's not.
+func (in *BlackoutWindow) ActiveUntil(now time.Time) (*time.Time, error) {
+	schedule, err := StandardCronParser.Parse(in.Schedule)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := time.ParseDuration(in.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	// iterate over all the activations in (now - duration, now], and the last
+	// one decides the end of the window
+	var end *time.Time
+	for begin := schedule.Next(now.Add(-duration)); !begin.IsZero() && !begin.After(now); begin = schedule.Next(begin) {
+		windowEnd := begin.Add(duration)
+		end = &windowEnd
+	}
+
+	return end, nil
+}
+
+// NextBegin returns the next beginning of the window after now. It returns a
+// zero time if the schedule will never be activated.
+func (in *BlackoutWindow) NextBegin(now time.Time) (time.Time, error) {
+	schedule, err := StandardCronParser.Parse(in.Schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return schedule.Next(now), nil
+}
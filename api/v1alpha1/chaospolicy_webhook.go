@@ -0,0 +1,57 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (in *ChaosPolicySpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	kinds := AllKinds()
+	for i, rule := range in.Rules {
+		if _, ok := kinds[rule.Kind]; !ok {
+			allErrs = append(allErrs, field.Invalid(path.Child("rules").Index(i).Child("kind"), rule.Kind,
+				fmt.Sprintf("unknown kind of chaos: %s", rule.Kind)))
+		}
+	}
+
+	if in.MaxConcurrent != nil && *in.MaxConcurrent < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("maxConcurrent"), *in.MaxConcurrent,
+			"maxConcurrent should not be negative"))
+	}
+
+	return allErrs
+}
+
+func (in *BlackoutWindow) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := StandardCronParser.Parse(in.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("schedule"), in.Schedule,
+			fmt.Sprintf("parse schedule field error:%s", err)))
+	}
+
+	if len(in.Duration) == 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("duration"), in.Duration,
+			"the duration of blackout window is required"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("chaospolicy_webhook", func() {
+	Context("webhook.Validator of chaospolicy", func() {
+		It("Validate", func() {
+			maxConcurrent := -1
+			maxPodsPercent := 120
+			invalidDuration := "1x"
+			testCases := []struct {
+				policy ChaosPolicy
+				err    string
+			}{
+				{
+					ChaosPolicy{
+						Spec: ChaosPolicySpec{
+							Rules: []ChaosPolicyRule{{Kind: "FooChaos"}},
+						},
+					},
+					"unknown kind of chaos",
+				}, {
+					ChaosPolicy{
+						Spec: ChaosPolicySpec{
+							MaxConcurrent: &maxConcurrent,
+						},
+					},
+					"maxConcurrent should not be negative",
+				}, {
+					ChaosPolicy{
+						Spec: ChaosPolicySpec{
+							MaxPodsPercent: &maxPodsPercent,
+						},
+					},
+					"percent field should be in 0-100",
+				}, {
+					ChaosPolicy{
+						Spec: ChaosPolicySpec{
+							MaxDuration: &invalidDuration,
+						},
+					},
+					"parse duration field error",
+				}, {
+					ChaosPolicy{
+						Spec: ChaosPolicySpec{
+							BlackoutWindows: []BlackoutWindow{{Schedule: "every day", Duration: "1h"}},
+						},
+					},
+					"parse schedule field error",
+				}, {
+					ChaosPolicy{
+						Spec: ChaosPolicySpec{
+							BlackoutWindows: []BlackoutWindow{{Schedule: "@daily"}},
+						},
+					},
+					"the duration of blackout window is required",
+				}, {
+					ChaosPolicy{
+						Spec: ChaosPolicySpec{
+							Namespaces: []string{"default"},
+							Rules:      []ChaosPolicyRule{{Kind: KindNetworkChaos, Actions: []string{"delay"}}},
+							BlackoutWindows: []BlackoutWindow{
+								{Schedule: "CRON_TZ=Asia/Shanghai 0 18 * * 5", Duration: "63h"},
+							},
+						},
+					},
+					"",
+				},
+			}
+
+			for _, testCase := range testCases {
+				_, err := testCase.policy.ValidateCreate()
+				if len(testCase.err) != 0 {
+					Expect(err).To(HaveOccurred())
+					Expect(strings.Contains(err.Error(), testCase.err)).To(BeTrue())
+				} else {
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+		})
+	})
+	Context("ChaosPolicySpec", func() {
+		It("Matches", func() {
+			spec := ChaosPolicySpec{
+				Namespaces:         []string{"ns1"},
+				NamespaceSelectors: map[string]string{"env": "prod"},
+			}
+			Expect(spec.Matches("ns1", nil)).To(BeTrue())
+			Expect(spec.Matches("ns2", map[string]string{"env": "prod"})).To(BeTrue())
+			Expect(spec.Matches("ns2", map[string]string{"env": "dev"})).To(BeFalse())
+			Expect((&ChaosPolicySpec{}).Matches("ns2", nil)).To(BeTrue())
+		})
+		It("Allows", func() {
+			spec := ChaosPolicySpec{
+				Rules: []ChaosPolicyRule{
+					{Kind: KindNetworkChaos, Actions: []string{"delay"}},
+					{Kind: KindStressChaos},
+				},
+			}
+			Expect(spec.Allows(KindNetworkChaos, "delay")).To(BeTrue())
+			Expect(spec.Allows(KindNetworkChaos, "loss")).To(BeFalse())
+			Expect(spec.Allows(KindStressChaos, "")).To(BeTrue())
+			Expect(spec.Allows(KindPodChaos, "pod-kill")).To(BeFalse())
+		})
+	})
+})
@@ -37,6 +37,29 @@ type ChaosStatus struct {
 
 	// Experiment records the last experiment state.
 	Experiment ExperimentStatus `json:"experiment"`
+
+	// RemoteClusters records the state of the chaos in every remote cluster, if the chaos is fanned out
+	// to several remote clusters. The conditions and records above are aggregated from them.
+	// +optional
+	RemoteClusters []RemoteClusterChaosStatus `json:"remoteClusters,omitempty"`
+}
+
+// RemoteClusterChaosStatus represents the state of the chaos in a remote cluster
+type RemoteClusterChaosStatus struct {
+	// Name is the name of the remote cluster
+	Name string `json:"name"`
+
+	// Conditions represents the current condition of the chaos in the remote cluster
+	// +optional
+	Conditions []ChaosCondition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:Enum=Run;Stop
+	// +optional
+	DesiredPhase DesiredPhase `json:"desiredPhase,omitempty"`
+
+	// Error represents the reason why the chaos could not be created in the remote cluster
+	// +optional
+	Error string `json:"error,omitempty"`
 }
 
 type ChaosConditionType string
@@ -46,6 +69,8 @@ const (
 	ConditionAllInjected  ChaosConditionType = "AllInjected"
 	ConditionAllRecovered ChaosConditionType = "AllRecovered"
 	ConditionPaused       ChaosConditionType = "Paused"
+	// ConditionAllTargetsSupported is false if some of the selected targets are not capable of the chaos
+	ConditionAllTargetsSupported ChaosConditionType = "AllTargetsSupported"
 )
 
 type ChaosCondition struct {
@@ -70,6 +95,70 @@ type ExperimentStatus struct {
 	// +optional
 	// Records are used to track the running status
 	Records []*Record `json:"containerRecords,omitempty"`
+	// Rollouts records the progress of the rollout of every selector which has a rollout
+	// +optional
+	Rollouts []RolloutStatus `json:"rollouts,omitempty"`
+	// LastSelectedTime is the last time when the targets were selected
+	// +optional
+	LastSelectedTime *metav1.Time `json:"lastSelectedTime,omitempty"`
+	// Intermittent records the progress of the intermittent chaos
+	// +optional
+	Intermittent *IntermittentStatus `json:"intermittent,omitempty"`
+}
+
+// IntermittentSpec describes how to inject and recover the chaos repeatedly within one experiment, e.g. to
+// model a flapping dependency
+type IntermittentSpec struct {
+	// OnPeriod is the duration to keep the chaos injected in every cycle.
+	OnPeriod string `json:"onPeriod" webhook:"Duration"`
+
+	// OffPeriod is the duration to keep the chaos recovered in every cycle.
+	OffPeriod string `json:"offPeriod" webhook:"Duration"`
+
+	// Jitter is the maximum random duration added to every on and off period.
+	// +optional
+	Jitter string `json:"jitter,omitempty" webhook:"Duration"`
+}
+
+// IntermittentStatus represents the progress of the intermittent chaos
+type IntermittentStatus struct {
+	// Phase is the desired phase of the current period, `Run` in the on period and `Stop` in the off period
+	// +kubebuilder:validation:Enum=Run;Stop
+	Phase DesiredPhase `json:"phase"`
+	// PeriodEndTime is the time when the current period ends
+	PeriodEndTime metav1.Time `json:"periodEndTime"`
+	// Cycles is the number of the on periods which have started
+	Cycles int `json:"cycles"`
+}
+
+type RolloutPhase string
+
+const (
+	// RolloutProgressing means some batches of the rollout haven't been injected
+	RolloutProgressing RolloutPhase = "Progressing"
+	// RolloutCompleted means all batches of the rollout have been injected
+	RolloutCompleted RolloutPhase = "Completed"
+	// RolloutHalted means the rollout has been stopped because of a failure, and the following batches will
+	// never be injected
+	RolloutHalted RolloutPhase = "Halted"
+)
+
+// RolloutStatus represents the progress of the rollout of a selector
+type RolloutStatus struct {
+	// SelectorKey is the key of the selector, which is the same as the `selectorKey` of the records
+	SelectorKey string `json:"selectorKey"`
+	// Phase is the phase of the rollout
+	Phase RolloutPhase `json:"phase"`
+	// ReleasedBatches is the number of batches which are allowed to be injected
+	ReleasedBatches int `json:"releasedBatches"`
+	// TotalBatches is the number of all batches
+	TotalBatches int `json:"totalBatches"`
+	// NextBatchTime is the time when the next batch will be injected, if the rollout is waiting for the interval
+	// +optional
+	NextBatchTime *metav1.Time `json:"nextBatchTime,omitempty"`
+	// Message is the detail message, e.g. the reason why the rollout is halted
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 type Record struct {
@@ -82,6 +171,14 @@ type Record struct {
 	RecoveredCount int `json:"recoveredCount"`
 	// Events are the essential details about the injections and recoveries
 	Events []RecordEvent `json:"events,omitempty"`
+	// RemoteCluster is the remote cluster where the target is, if the chaos is fanned out to several
+	// remote clusters
+	// +optional
+	RemoteCluster string `json:"remoteCluster,omitempty"`
+	// Unsupported is the reason why the target is not capable of the chaos, e.g. a required kernel module
+	// is missing on the node. The chaos is never injected into an unsupported target.
+	// +optional
+	Unsupported string `json:"unsupported,omitempty"`
 }
 
 type Phase string
@@ -178,8 +275,18 @@ type WebhookObject interface {
 	webhook.Defaulter
 }
 
+// +kubebuilder:object:generate=false
+
+// IntermittentObject is the chaos which could be injected and recovered repeatedly
+type IntermittentObject interface {
+	StatefulObject
+	GetIntermittent() *IntermittentSpec
+}
+
 // +kubebuilder:object:generate=false
 type RemoteObject interface {
 	StatefulObject
 	GetRemoteCluster() string
+	GetRemoteClusters() []string
+	GetRemoteClusterSelector() *metav1.LabelSelector
 }
@@ -173,6 +173,47 @@ func (f *FloatStr) Default(root interface{}, field *reflect.StructField) {
 	}
 }
 
+func (in *RolloutSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	if in.BatchSize <= 0 {
+		return field.ErrorList{
+			field.Invalid(path.Child("batchSize"), in.BatchSize, "batch size must be greater than 0"),
+		}
+	}
+
+	return nil
+}
+
+func (in *IntermittentSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	// the one-shot chaos is always running, so it could never be recovered in the off periods
+	if obj, ok := root.(interface{ IsOneShot() bool }); ok && obj.IsOneShot() {
+		return field.ErrorList{field.Forbidden(path, "the one-shot chaos could not be intermittent")}
+	}
+
+	var allErrs field.ErrorList
+	for _, period := range []struct {
+		name  string
+		value string
+	}{{"onPeriod", in.OnPeriod}, {"offPeriod", in.OffPeriod}} {
+		if period.value == "" {
+			allErrs = append(allErrs, field.Required(path.Child(period.name), "the period is required"))
+			continue
+		}
+		// the format has been validated by the Duration webhook
+		if duration, err := time.ParseDuration(period.value); err == nil && duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(path.Child(period.name), period.value, "the period must be greater than 0"))
+		}
+	}
+	return allErrs
+}
+
 func init() {
 	genericwebhook.Register("Duration", reflect.PtrTo(reflect.TypeOf(Duration(""))))
 	genericwebhook.Register("Percent", reflect.PtrTo(reflect.TypeOf(Percent(0))))
@@ -0,0 +1,240 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="duration",type=string,JSONPath=`.spec.duration`
+// +chaos-mesh:experiment
+
+// CompositeChaos is the Schema for the compositechaos API. It selects the targets once, and injects a list
+// of faults into exactly the same containers.
+type CompositeChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CompositeChaosSpec   `json:"spec"`
+	Status CompositeChaosStatus `json:"status,omitempty"`
+}
+
+var _ InnerObjectWithCustomStatus = (*CompositeChaos)(nil)
+var _ InnerObjectWithSelector = (*CompositeChaos)(nil)
+var _ InnerObject = (*CompositeChaos)(nil)
+
+// CompositeChaosSpec defines the desired state of CompositeChaos
+type CompositeChaosSpec struct {
+	ContainerSelector `json:",inline"`
+
+	// Faults is the list of faults to be injected into every selected container. The faults are injected
+	// in order and recovered in the reverse order. If a fault fails to be injected, the faults injected
+	// before it are recovered, so that a container is either injected with all the faults or none of them.
+	// +kubebuilder:validation:MinItems=1
+	Faults []CompositeFault `json:"faults"`
+
+	// Duration represents the duration of the chaos action.
+	// +optional
+	Duration *string `json:"duration,omitempty" webhook:"Duration"`
+
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
+	// RemoteCluster represents the remote cluster where the chaos will be deployed
+	// +optional
+	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
+}
+
+// CompositeFault is a fault of CompositeChaos. Exactly one of network, stress, io and time should be set.
+type CompositeFault struct {
+	// Name is the name of the fault, which is unique in the CompositeChaos.
+	Name string `json:"name"`
+
+	// Network injects a traffic control fault into the pods of the selected containers.
+	// +optional
+	Network *CompositeNetworkFault `json:"network,omitempty"`
+
+	// Stress runs stressors in the selected containers.
+	// +optional
+	Stress *CompositeStressFault `json:"stress,omitempty"`
+
+	// IO injects an IO fault into a volume of the selected containers.
+	// +optional
+	IO *CompositeIOFault `json:"io,omitempty"`
+
+	// Time shifts the clock of the selected containers.
+	// +optional
+	Time *CompositeTimeFault `json:"time,omitempty"`
+}
+
+// CompositeNetworkFault is the traffic control part of NetworkChaosSpec. It affects all the outgoing traffic
+// of the pods, or only the traffic to the external targets if they are set.
+type CompositeNetworkFault struct {
+	// Action defines the specific network chaos action.
+	// Supported action: netem, delay, loss, duplicate, corrupt, bandwidth
+	// +kubebuilder:validation:Enum=netem;delay;loss;duplicate;corrupt;bandwidth
+	Action NetworkChaosAction `json:"action"`
+
+	// Device represents the network device to be affected.
+	// +optional
+	Device string `json:"device,omitempty"`
+
+	// TcParameter represents the traffic control definition
+	TcParameter `json:",inline"`
+
+	// ExternalTargets represents network targets outside k8s
+	// +optional
+	ExternalTargets []string `json:"externalTargets,omitempty"`
+}
+
+// CompositeStressFault is the stressors part of StressChaosSpec
+type CompositeStressFault struct {
+	// Stressors defines plenty of stressors supported to stress system components out.
+	// You can use one or more of them to make up various kinds of stresses. At least
+	// one of the stressors should be specified.
+	// +optional
+	Stressors *Stressors `json:"stressors,omitempty"`
+
+	// StressngStressors defines the stressors in `stress-ng` dialect, see also the same field of StressChaos.
+	// +optional
+	StressngStressors string `json:"stressngStressors,omitempty"`
+}
+
+// CompositeIOFault is the IO fault part of IOChaosSpec
+type CompositeIOFault struct {
+	// Action defines the specific pod chaos action.
+	// Supported action: latency / fault / attrOverride / mistake
+	// +kubebuilder:validation:Enum=latency;fault;attrOverride;mistake
+	Action IOChaosType `json:"action"`
+
+	// Delay defines the value of I/O chaos action delay.
+	// A delay string is a possibly signed sequence of
+	// decimal numbers, each with optional fraction and a unit suffix,
+	// such as "300ms".
+	// Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	// +ui:form:when=action=='latency'
+	// +optional
+	Delay string `json:"delay,omitempty" webhook:"Duration"`
+
+	// Errno defines the error code that returned by I/O action.
+	// refer to: https://www-numi.fnal.gov/offline_software/srt_public_context/WebDocs/Errors/unix_system_errors.html
+	// +ui:form:when=action=='fault'
+	// +optional
+	Errno uint32 `json:"errno,omitempty" webhook:"IOErrno"`
+
+	// Attr defines the overrided attribution
+	// +ui:form:when=action=='attrOverride'
+	// +optional
+	Attr *AttrOverrideSpec `json:"attr,omitempty"`
+
+	// Mistake defines what types of incorrectness are injected to IO operations
+	// +ui:form:when=action=='mistake'
+	// +optional
+	Mistake *MistakeSpec `json:"mistake,omitempty"`
+
+	// Path defines the path of files for injecting I/O chaos action.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Methods defines the I/O methods for injecting I/O chaos action.
+	// default: all I/O methods.
+	// +optional
+	Methods []IoMethod `json:"methods,omitempty" faker:"ioMethods"`
+
+	// Percent defines the percentage of injection errors and provides a number from 0-100.
+	// default: 100.
+	// +optional
+	// +kubebuilder:default=100
+	Percent int `json:"percent,omitempty" webhook:"Percent"`
+
+	// VolumePath represents the mount path of injected volume
+	VolumePath string `json:"volumePath"`
+}
+
+// CompositeTimeFault is the time offset part of TimeChaosSpec
+type CompositeTimeFault struct {
+	// TimeOffset defines the delta time of injected program. It's a possibly signed sequence of decimal numbers, such as
+	// "300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
+	TimeOffset string `json:"timeOffset" webhook:"TimeOffset"`
+
+	// ClockIds defines all affected clock id, see also the same field of TimeChaos.
+	// Default value is ["CLOCK_REALTIME"]
+	ClockIds []string `json:"clockIds,omitempty" webhook:"ClockIds,nilable"`
+}
+
+// CompositeChaosStatus defines the observed state of CompositeChaos
+type CompositeChaosStatus struct {
+	ChaosStatus `json:",inline"`
+
+	// Instances records the states of the faults in every selected container
+	// +optional
+	Instances map[string]CompositeInstance `json:"instances,omitempty"`
+}
+
+// CompositeInstance records the states of the faults in a container
+type CompositeInstance struct {
+	// Faults records the state of every fault, in the same order as the faults in the spec
+	// +optional
+	Faults []CompositeFaultInstance `json:"faults,omitempty"`
+
+	// RollingBack is true when a fault failed to be injected, and the faults injected before it are being
+	// recovered.
+	// +optional
+	RollingBack bool `json:"rollingBack,omitempty"`
+
+	// Message is the error which caused the last rollback
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// CompositeFaultInstance records the state of a fault in a container
+type CompositeFaultInstance struct {
+	// Name is the name of the fault
+	Name string `json:"name"`
+
+	// Phase is the phase of the fault in the container
+	// +optional
+	Phase Phase `json:"phase,omitempty"`
+
+	// Generation is the generation of the PodNetworkChaos or PodIOChaos to wait for
+	// +optional
+	Generation int64 `json:"generation,omitempty"`
+
+	// Stress is the stress-ng instance of the stress fault
+	// +optional
+	Stress *StressInstance `json:"stress,omitempty"`
+}
+
+func (obj *CompositeChaos) GetSelectorSpecs() map[string]interface{} {
+	return map[string]interface{}{
+		".": &obj.Spec.ContainerSelector,
+	}
+}
+
+func (obj *CompositeChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import "k8s.io/apimachinery/pkg/util/validation/field"
+
+func (in *CompositeChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	faultsField := path.Child("faults")
+	if len(in.Faults) == 0 {
+		allErrs = append(allErrs, field.Required(faultsField, "at least one fault is required"))
+	}
+
+	names := make(map[string]struct{})
+	for i, fault := range in.Faults {
+		faultField := faultsField.Index(i)
+		if len(fault.Name) == 0 {
+			allErrs = append(allErrs, field.Required(faultField.Child("name"), "the name of the fault is required"))
+		} else if _, ok := names[fault.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(faultField.Child("name"), fault.Name))
+		}
+		names[fault.Name] = struct{}{}
+
+		count := 0
+		for _, set := range []bool{fault.Network != nil, fault.Stress != nil, fault.IO != nil, fault.Time != nil} {
+			if set {
+				count++
+			}
+		}
+		if count != 1 {
+			allErrs = append(allErrs, field.Invalid(faultField, fault.Name,
+				"exactly one of network, stress, io and time should be specified"))
+		}
+
+		if fault.Stress != nil && len(fault.Stress.StressngStressors) == 0 && fault.Stress.Stressors == nil {
+			allErrs = append(allErrs, field.Invalid(faultField.Child("stress"), fault.Stress, "missing stressors"))
+		}
+	}
+
+	return allErrs
+}
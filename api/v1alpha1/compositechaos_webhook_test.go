@@ -0,0 +1,190 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("compositechaos_webhook", func() {
+	Context("webhook.Validator of compositechaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name    string
+				chaos   CompositeChaos
+				execute func(chaos *CompositeChaos) error
+				expect  string
+			}
+
+			tcs := []TestCase{
+				{
+					name: "simple ValidateDelete",
+					chaos: CompositeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo1",
+						},
+					},
+					execute: func(chaos *CompositeChaos) error {
+						_, err := chaos.ValidateDelete()
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "missing faults",
+					chaos: CompositeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo2",
+						},
+					},
+					execute: func(chaos *CompositeChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "duplicated names of faults",
+					chaos: CompositeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo3",
+						},
+						Spec: CompositeChaosSpec{
+							Faults: []CompositeFault{
+								{Name: "shift", Time: &CompositeTimeFault{TimeOffset: "-1h"}},
+								{Name: "shift", Time: &CompositeTimeFault{TimeOffset: "1h"}},
+							},
+						},
+					},
+					execute: func(chaos *CompositeChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "more than one kind in a fault",
+					chaos: CompositeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: CompositeChaosSpec{
+							Faults: []CompositeFault{
+								{
+									Name:   "cpu",
+									Stress: &CompositeStressFault{StressngStressors: "--cpu 1"},
+									Time:   &CompositeTimeFault{TimeOffset: "-1h"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *CompositeChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "missing stressors",
+					chaos: CompositeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: CompositeChaosSpec{
+							Faults: []CompositeFault{
+								{Name: "cpu", Stress: &CompositeStressFault{}},
+							},
+						},
+					},
+					execute: func(chaos *CompositeChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid time offset",
+					chaos: CompositeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: CompositeChaosSpec{
+							Faults: []CompositeFault{
+								{Name: "shift", Time: &CompositeTimeFault{TimeOffset: "1x"}},
+							},
+						},
+					},
+					execute: func(chaos *CompositeChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "valid faults",
+					chaos: CompositeChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: CompositeChaosSpec{
+							ContainerSelector: ContainerSelector{
+								PodSelector: PodSelector{
+									Mode: AllMode,
+								},
+							},
+							Faults: []CompositeFault{
+								{
+									Name: "delay",
+									Network: &CompositeNetworkFault{
+										Action: DelayAction,
+										TcParameter: TcParameter{
+											Delay: &DelaySpec{Latency: "100ms"},
+										},
+									},
+								},
+								{Name: "cpu", Stress: &CompositeStressFault{StressngStressors: "--cpu 1"}},
+							},
+						},
+					},
+					execute: func(chaos *CompositeChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	})
+})
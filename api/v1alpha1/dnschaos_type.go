@@ -70,6 +70,11 @@ type DNSChaosSpec struct {
 	// Duration represents the duration of the chaos action
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
 	// Choose which domain names to take effect, support the placeholder ? and wildcard *, or the Specified domain name.
 	// Note:
 	//      1. The wildcard * must be at the end of the string. For example, chaos-*.org is invalid.
@@ -83,6 +88,16 @@ type DNSChaosSpec struct {
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
 }
 
 // DNSChaosStatus defines the observed state of DNSChaos
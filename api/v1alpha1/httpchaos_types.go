@@ -45,9 +45,23 @@ type HTTPChaosSpec struct {
 
 	PodHttpChaosActions `json:",inline"`
 
+	// Direction is the direction of the traffic to be injected, <Inbound|Outbound>.
+	// The inbound traffic is received by the selected pods, and the outbound traffic is sent
+	// by the selected pods to their dependencies.
+	// +optional
+	// +kubebuilder:validation:Enum=Inbound;Outbound
+	// +kubebuilder:default=Inbound
+	Direction HTTPChaosDirection `json:"direction,omitempty" webhook:"HTTPChaosDirection"`
+
 	// Port represents the target port to be proxy of.
+	// For the outbound traffic, it's the port of the destination.
 	Port int32 `json:"port,omitempty" webhook:"Port"`
 
+	// Host is a rule to select target by the host in http request, which is the destination of
+	// the outbound traffic.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
 	// Path is a rule to select target by uri path in http request.
 	// +optional
 	Path *string `json:"path,omitempty"`
@@ -70,6 +84,22 @@ type HTTPChaosSpec struct {
 	// +optional
 	ResponseHeaders map[string]string `json:"response_headers,omitempty"`
 
+	// Percent is the percentage of the selected requests or responses to be injected, in [1, 100].
+	// All of them are injected if it's not set.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	Percent *int32 `json:"percent,omitempty" webhook:"HTTPChaosPercent"`
+
+	// Times is the maximum times to inject, the rule stops injecting once it's reached.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Times *int64 `json:"times,omitempty" webhook:"HTTPChaosTimes"`
+
+	// Rate limits the injections with a token bucket.
+	// +optional
+	Rate *PodHttpChaosRate `json:"rate,omitempty"`
+
 	// TLS is the tls config,
 	// will override PodHttpChaos if there are multiple HTTPChaos experiments are applied
 	// +optional
@@ -79,9 +109,24 @@ type HTTPChaosSpec struct {
 	// +optional
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
 }
 
 type HTTPChaosStatus struct {
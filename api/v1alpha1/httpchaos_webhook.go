@@ -83,9 +83,66 @@ func (in *PodHttpChaosTarget) Validate(root interface{}, path *field.Path) field
 	return allErrs
 }
 
+func (in *HTTPChaosDirection) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch *in {
+	case "", HTTPChaosInbound:
+	case HTTPChaosOutbound:
+		// the bundled chaos-tproxy doesn't proxy the outbound traffic redirected by chaos daemon, see
+		// tproxyconfig.FeatureEgress
+		allErrs = append(allErrs, field.Forbidden(path, "the outbound traffic is not supported by the bundled chaos-tproxy yet"))
+		if root.(*HTTPChaos).Spec.TLS != nil {
+			allErrs = append(allErrs, field.Invalid(path, in, "tls is not supported for the outbound traffic"))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(path, in, fmt.Sprintf("direction %s is not supported", *in)))
+	}
+	return allErrs
+}
+
+// errRuleLimitsNotSupported rejects the percent, times and rate of rules, as the bundled chaos-tproxy would
+// ignore them and inject all the selected requests, see tproxyconfig.FeatureRuleLimits
+func errRuleLimitsNotSupported(path *field.Path) *field.Error {
+	return field.Forbidden(path, "it is not supported by the bundled chaos-tproxy yet")
+}
+
+type HTTPChaosPercent int32
+
+func (in *HTTPChaosPercent) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{errRuleLimitsNotSupported(path)}
+	if *in < 1 || *in > 100 {
+		allErrs = append(allErrs, field.Invalid(path, in, "percent should be in [1, 100]"))
+	}
+	return allErrs
+}
+
+type HTTPChaosTimes int64
+
+func (in *HTTPChaosTimes) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{errRuleLimitsNotSupported(path)}
+	if *in <= 0 {
+		allErrs = append(allErrs, field.Invalid(path, in, "times should be positive"))
+	}
+	return allErrs
+}
+
+func (in *PodHttpChaosRate) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{errRuleLimitsNotSupported(path)}
+	if in.Limit <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("limit"), in.Limit, "limit should be positive"))
+	}
+	if in.Burst < 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("burst"), in.Burst, "burst should not be negative"))
+	}
+	return allErrs
+}
+
 func init() {
 	genericwebhook.Register("Delay", reflect.PtrTo(reflect.TypeOf(Delay(""))))
 	genericwebhook.Register("Port", reflect.PtrTo(reflect.TypeOf(Port(0))))
 	genericwebhook.Register("HTTPMethod", reflect.PtrTo(reflect.TypeOf(HTTPMethod(""))))
 	genericwebhook.Register("PodHttpChaosTarget", reflect.PtrTo(reflect.TypeOf(PodHttpChaosTarget(""))))
+	genericwebhook.Register("HTTPChaosDirection", reflect.PtrTo(reflect.TypeOf(HTTPChaosDirection(""))))
+	genericwebhook.Register("HTTPChaosPercent", reflect.PtrTo(reflect.TypeOf(HTTPChaosPercent(0))))
+	genericwebhook.Register("HTTPChaosTimes", reflect.PtrTo(reflect.TypeOf(HTTPChaosTimes(0))))
 }
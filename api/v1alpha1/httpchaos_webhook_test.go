@@ -26,6 +26,8 @@ import (
 var _ = Describe("HTTPChaos Webhook", func() {
 	Context("webhook.Validator of httpchaos", func() {
 		It("Validate", func() {
+			percent, percentOutOfRange := int32(50), int32(101)
+			times, zeroTimes := int64(10), int64(0)
 			type TestCase struct {
 				name    string
 				chaos   HTTPChaos
@@ -391,6 +393,144 @@ var _ = Describe("HTTPChaos Webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "outbound is not supported",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo21",
+						},
+						Spec: HTTPChaosSpec{
+							Port:      8080,
+							Target:    PodHttpResponse,
+							Direction: HTTPChaosOutbound,
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					// the bundled chaos-tproxy doesn't support the outbound traffic yet
+					expect: "error",
+				},
+				{
+					name: "outbound with tls",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo22",
+						},
+						Spec: HTTPChaosSpec{
+							Port:      443,
+							Target:    PodHttpResponse,
+							Direction: HTTPChaosOutbound,
+							TLS:       &PodHttpChaosTLS{},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid direction",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo23",
+						},
+						Spec: HTTPChaosSpec{
+							Port:      80,
+							Target:    PodHttpRequest,
+							Direction: "Both",
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validation for percent, times and rate",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo24",
+						},
+						Spec: HTTPChaosSpec{
+							Port:    80,
+							Target:  PodHttpRequest,
+							Percent: &percent,
+							Times:   &times,
+							Rate:    &PodHttpChaosRate{Limit: 5, Burst: 10},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					// the bundled chaos-tproxy doesn't support them yet
+					expect: "error",
+				},
+				{
+					name: "validation for percent out of range",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo25",
+						},
+						Spec: HTTPChaosSpec{
+							Port:    80,
+							Target:  PodHttpRequest,
+							Percent: &percentOutOfRange,
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validation for non-positive times",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo26",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpRequest,
+							Times:  &zeroTimes,
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validation for rate without limit",
+					chaos: HTTPChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo27",
+						},
+						Spec: HTTPChaosSpec{
+							Port:   80,
+							Target: PodHttpRequest,
+							Rate:   &PodHttpChaosRate{Burst: 1},
+						},
+					},
+					execute: func(chaos *HTTPChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
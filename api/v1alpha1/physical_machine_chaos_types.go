@@ -100,9 +100,24 @@ type PhysicalMachineChaosSpec struct {
 	// +optional
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
 }
 
 // PhysicalMachineChaosStatus defines the observed state of PhysicalMachineChaos
@@ -137,6 +152,14 @@ type PhysicalMachineSelector struct {
 	// IF `RandomMaxPercentMode`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
 	// +optional
 	Value string `json:"value,omitempty"`
+
+	// Rollout injects the chaos into the selected physical machines batch by batch, rather than all at once.
+	// +optional
+	Rollout *RolloutSpec `json:"rollout,omitempty"`
+}
+
+func (in *PhysicalMachineSelector) GetRollout() *RolloutSpec {
+	return in.Rollout
 }
 
 // PhysicalMachineSelectorSpec defines some selectors to select objects.
@@ -149,6 +172,10 @@ type PhysicalMachineSelectorSpec struct {
 	// and each value is a set of physical machine names.
 	// +optional
 	PhysicalMachines map[string][]string `json:"physicalMachines,omitempty"`
+
+	// SkipUnhealthy skips the physical machines whose chaosd is probed to be unreachable.
+	// +optional
+	SkipUnhealthy bool `json:"skipUnhealthy,omitempty"`
 }
 
 func (spec *PhysicalMachineSelectorSpec) Empty() bool {
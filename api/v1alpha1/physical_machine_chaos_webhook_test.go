@@ -170,6 +170,55 @@ var _ = Describe("physicalmachinechaos_webhook", func() {
 					},
 					"one of address or selector should be specified",
 				},
+				{
+					PhysicalMachineChaos{
+						Spec: PhysicalMachineChaosSpec{
+							Action: "stress-cpu",
+							PhysicalMachineSelector: PhysicalMachineSelector{
+								Address: []string{
+									"123.123.123.123:123",
+									"234.234.234.234:234",
+								},
+								Rollout: &RolloutSpec{
+									BatchSize: 0,
+								},
+							},
+							ExpInfo: ExpInfo{
+								UID: "",
+								StressCPU: &StressCPUSpec{
+									Load:    10,
+									Workers: 1,
+								},
+							},
+						},
+					},
+					"batch size must be greater than 0",
+				},
+				{
+					PhysicalMachineChaos{
+						Spec: PhysicalMachineChaosSpec{
+							Action: "stress-cpu",
+							PhysicalMachineSelector: PhysicalMachineSelector{
+								Address: []string{
+									"123.123.123.123:123",
+									"234.234.234.234:234",
+								},
+								Rollout: &RolloutSpec{
+									BatchSize: 1,
+									Interval:  "1x",
+								},
+							},
+							ExpInfo: ExpInfo{
+								UID: "",
+								StressCPU: &StressCPUSpec{
+									Load:    10,
+									Workers: 1,
+								},
+							},
+						},
+					},
+					"parse duration field error",
+				},
 			}
 
 			for _, testCase := range testCases {
@@ -16,10 +16,15 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="address",type=string,JSONPath=`.spec.address`
+// +kubebuilder:printcolumn:name="reachable",type=string,JSONPath=`.status.conditions[?(@.type=="Reachable")].status`
+// +kubebuilder:printcolumn:name="version",type=string,JSONPath=`.status.version`
 // +chaos-mesh:base
 
 // PhysicalMachine is the Schema for the physical machine API
@@ -29,6 +34,10 @@ type PhysicalMachine struct {
 
 	// Spec defines the behavior of a physical machine
 	Spec PhysicalMachineSpec `json:"spec"`
+
+	// Most recently observed status of the physical machine
+	// +optional
+	Status PhysicalMachineStatus `json:"status,omitempty"`
 }
 
 // PhysicalMachineSpec defines the desired state of PhysicalMachine
@@ -38,6 +47,96 @@ type PhysicalMachineSpec struct {
 	Address string `json:"address"`
 }
 
+// PhysicalMachineStatus is the status of chaosd on the physical machine, which is probed periodically
+type PhysicalMachineStatus struct {
+	// Conditions represents the reachability of chaosd
+	// +optional
+	Conditions []PhysicalMachineCondition `json:"conditions,omitempty"`
+
+	// LastHeartbeatTime is the last time chaosd responded to the probe
+	// +optional
+	LastHeartbeatTime *metav1.Time `json:"lastHeartbeatTime,omitempty"`
+
+	// Version is the version of chaosd
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Inventory contains the facts of the physical machine reported by chaosd
+	// +optional
+	Inventory *PhysicalMachineInventory `json:"inventory,omitempty"`
+
+	// Experiments are the experiments reported as active by chaosd
+	// +optional
+	Experiments []PhysicalMachineExperiment `json:"experiments,omitempty"`
+}
+
+type PhysicalMachineConditionType string
+
+const (
+	// PhysicalMachineConditionReachable means chaosd on the physical machine responds to the probe
+	PhysicalMachineConditionReachable PhysicalMachineConditionType = "Reachable"
+)
+
+type PhysicalMachineCondition struct {
+	Type   PhysicalMachineConditionType `json:"type"`
+	Status corev1.ConditionStatus       `json:"status"`
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// +optional
+	Message string `json:"message,omitempty"`
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// PhysicalMachineInventory contains the facts of the physical machine
+type PhysicalMachineInventory struct {
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+	// OS is the name and version of the operating system
+	// +optional
+	OS string `json:"os,omitempty"`
+	// +optional
+	KernelVersion string `json:"kernelVersion,omitempty"`
+	// +optional
+	Arch string `json:"arch,omitempty"`
+}
+
+// PhysicalMachineExperiment is an experiment running in chaosd
+type PhysicalMachineExperiment struct {
+	// UID is the uid of the experiment in chaosd, which is the same as the one in ExpInfo of PhysicalMachineChaos
+	UID string `json:"uid"`
+	// Kind is the kind of the attack, such as network, process and stress
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Action is the action of the attack
+	// +optional
+	Action string `json:"action,omitempty"`
+	// Status is the status of the experiment in chaosd
+	// +optional
+	Status string `json:"status,omitempty"`
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// GetCondition returns the condition of the type, or nil if it's not found
+func (in *PhysicalMachineStatus) GetCondition(conditionType PhysicalMachineConditionType) *PhysicalMachineCondition {
+	for i := range in.Conditions {
+		if in.Conditions[i].Type == conditionType {
+			return &in.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// IsUnhealthy returns true if chaosd on the physical machine is probed to be unreachable.
+// The physical machine which has never been probed is not unhealthy.
+func (in *PhysicalMachine) IsUnhealthy() bool {
+	condition := in.Status.GetCondition(PhysicalMachineConditionReachable)
+	return condition != nil && condition.Status == corev1.ConditionFalse
+}
+
 // +kubebuilder:object:root=true
 
 // PhysicalMachineList contains a list of PhysicalMachine
@@ -89,6 +89,94 @@ var _ = Describe("podchaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "validate the rollout",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: PodChaosSpec{
+							Action: PodKillAction,
+							ContainerSelector: ContainerSelector{
+								PodSelector: PodSelector{
+									Mode:    AllMode,
+									Rollout: &RolloutSpec{BatchSize: 1, Interval: "1x"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the reselect",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: PodChaosSpec{
+							Action: PodKillAction,
+							ContainerSelector: ContainerSelector{
+								PodSelector: PodSelector{
+									Mode:     AllMode,
+									Reselect: &ReselectSpec{Interval: "1x"},
+								},
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the intermittent",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: PodChaosSpec{
+							Action: PodFailureAction,
+							Intermittent: &IntermittentSpec{
+								OnPeriod:  "1m",
+								OffPeriod: "0s",
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "validate the intermittent of one-shot chaos",
+					chaos: PodChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: PodChaosSpec{
+							Action: PodKillAction,
+							Intermittent: &IntermittentSpec{
+								OnPeriod:  "1m",
+								OffPeriod: "1m",
+							},
+						},
+					},
+					execute: func(chaos *PodChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -0,0 +1,165 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:printcolumn:name="action",type=string,JSONPath=`.spec.action`
+// +kubebuilder:printcolumn:name="duration",type=string,JSONPath=`.spec.duration`
+// +chaos-mesh:experiment
+
+// PodFaultChaos is the Schema for the podfaultchaos API. It brings the file, process, Redis and Kafka
+// faults of PhysicalMachineChaos to the containers of Kubernetes pods.
+type PodFaultChaos struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   PodFaultChaosSpec   `json:"spec"`
+	Status PodFaultChaosStatus `json:"status,omitempty"`
+}
+
+var _ InnerObjectWithCustomStatus = (*PodFaultChaos)(nil)
+var _ InnerObjectWithSelector = (*PodFaultChaos)(nil)
+var _ InnerObject = (*PodFaultChaos)(nil)
+
+type PodFaultChaosAction string
+
+const (
+	PodFaultFileCreateAction          PodFaultChaosAction = "file-create"
+	PodFaultFileModifyPrivilegeAction PodFaultChaosAction = "file-modify"
+	PodFaultFileDeleteAction          PodFaultChaosAction = "file-delete"
+	PodFaultFileRenameAction          PodFaultChaosAction = "file-rename"
+	PodFaultFileAppendAction          PodFaultChaosAction = "file-append"
+	PodFaultFileReplaceAction         PodFaultChaosAction = "file-replace"
+	PodFaultProcessAction             PodFaultChaosAction = "process"
+	PodFaultRedisExpirationAction     PodFaultChaosAction = "redis-expiration"
+	PodFaultRedisPenetrationAction    PodFaultChaosAction = "redis-penetration"
+	PodFaultRedisCacheLimitAction     PodFaultChaosAction = "redis-cacheLimit"
+	PodFaultKafkaFillAction           PodFaultChaosAction = "kafka-fill"
+	PodFaultKafkaFloodAction          PodFaultChaosAction = "kafka-flood"
+	PodFaultKafkaIOAction             PodFaultChaosAction = "kafka-io"
+)
+
+// PodFaultChaosSpec defines the desired state of PodFaultChaos
+type PodFaultChaosSpec struct {
+	ContainerSelector `json:",inline"`
+
+	// Action defines the specific fault to be injected into the selected containers.
+	// +kubebuilder:validation:Enum=file-create;file-modify;file-delete;file-rename;file-append;file-replace;process;redis-expiration;redis-penetration;redis-cacheLimit;kafka-fill;kafka-flood;kafka-io
+	Action PodFaultChaosAction `json:"action"`
+
+	PodFaultExpInfo `json:",inline"`
+
+	// Duration represents the duration of the chaos action.
+	// +optional
+	Duration *string `json:"duration,omitempty" webhook:"Duration"`
+
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
+	// RemoteCluster represents the remote cluster where the chaos will be deployed
+	// +optional
+	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
+}
+
+// PodFaultExpInfo is the subset of ExpInfo which could be injected inside a container. The fields share
+// the same names and specs with the ones of PhysicalMachineChaos.
+type PodFaultExpInfo struct {
+	// +ui:form:when=action=='file-create'
+	// +optional
+	FileCreate *FileCreateSpec `json:"file-create,omitempty"`
+
+	// +ui:form:when=action=='file-modify'
+	// +optional
+	FileModifyPrivilege *FileModifyPrivilegeSpec `json:"file-modify,omitempty"`
+
+	// +ui:form:when=action=='file-delete'
+	// +optional
+	FileDelete *FileDeleteSpec `json:"file-delete,omitempty"`
+
+	// +ui:form:when=action=='file-rename'
+	// +optional
+	FileRename *FileRenameSpec `json:"file-rename,omitempty"`
+
+	// +ui:form:when=action=='file-append'
+	// +optional
+	FileAppend *FileAppendSpec `json:"file-append,omitempty"`
+
+	// +ui:form:when=action=='file-replace'
+	// +optional
+	FileReplace *FileReplaceSpec `json:"file-replace,omitempty"`
+
+	// +ui:form:when=action=='process'
+	// +optional
+	Process *ProcessSpec `json:"process,omitempty"`
+
+	// +ui:form:when=action=='redis-expiration'
+	// +optional
+	RedisExpiration *RedisExpirationSpec `json:"redis-expiration,omitempty"`
+
+	// +ui:form:when=action=='redis-penetration'
+	// +optional
+	RedisPenetration *RedisPenetrationSpec `json:"redis-penetration,omitempty"`
+
+	// +ui:form:when=action=='redis-cacheLimit'
+	// +optional
+	RedisCacheLimit *RedisCacheLimitSpec `json:"redis-cacheLimit,omitempty"`
+
+	// +ui:form:when=action=='kafka-fill'
+	// +optional
+	KafkaFill *KafkaFillSpec `json:"kafka-fill,omitempty"`
+
+	// +ui:form:when=action=='kafka-flood'
+	// +optional
+	KafkaFlood *KafkaFloodSpec `json:"kafka-flood,omitempty"`
+
+	// +ui:form:when=action=='kafka-io'
+	// +optional
+	KafkaIO *KafkaIOSpec `json:"kafka-io,omitempty"`
+}
+
+// PodFaultChaosStatus defines the observed state of PodFaultChaos
+type PodFaultChaosStatus struct {
+	ChaosStatus `json:",inline"`
+
+	// Instances records the state returned by chaos-daemon for every injected container, which is
+	// required to recover the fault.
+	// +optional
+	Instances map[string]string `json:"instances,omitempty"`
+}
+
+func (obj *PodFaultChaos) GetSelectorSpecs() map[string]interface{} {
+	return map[string]interface{}{
+		".": &obj.Spec.ContainerSelector,
+	}
+}
+
+func (obj *PodFaultChaos) GetCustomStatus() interface{} {
+	return &obj.Status.Instances
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func (in *PodFaultChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var validateConfigErr error
+	switch in.Action {
+	case PodFaultFileCreateAction:
+		if in.FileCreate == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateFileCreateAction(in.FileCreate)
+	case PodFaultFileModifyPrivilegeAction:
+		if in.FileModifyPrivilege == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateFileModifyPrivilegeAction(in.FileModifyPrivilege)
+	case PodFaultFileDeleteAction:
+		if in.FileDelete == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateFileDeleteAction(in.FileDelete)
+	case PodFaultFileRenameAction:
+		if in.FileRename == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateFileRenameAction(in.FileRename)
+	case PodFaultFileAppendAction:
+		if in.FileAppend == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateFileAppendAction(in.FileAppend)
+	case PodFaultFileReplaceAction:
+		if in.FileReplace == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateFileReplaceAction(in.FileReplace)
+	case PodFaultProcessAction:
+		if in.Process == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateProcessAction(in.Process)
+	case PodFaultRedisExpirationAction:
+		if in.RedisExpiration == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateRedisExpirationAction(in.RedisExpiration)
+	case PodFaultRedisPenetrationAction:
+		if in.RedisPenetration == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateRedisPenetrationAction(in.RedisPenetration)
+	case PodFaultRedisCacheLimitAction:
+		if in.RedisCacheLimit == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validateRedisCacheLimitAction(in.RedisCacheLimit)
+	case PodFaultKafkaFillAction:
+		if in.KafkaFill == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validatePodFaultKafkaFillAction(in.KafkaFill)
+	case PodFaultKafkaFloodAction:
+		if in.KafkaFlood == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validatePodFaultKafkaFloodAction(in.KafkaFlood)
+	case PodFaultKafkaIOAction:
+		if in.KafkaIO == nil {
+			validateConfigErr = errMissingPodFaultConfig(in.Action)
+			break
+		}
+		validateConfigErr = validatePodFaultKafkaIOAction(in.KafkaIO)
+	default:
+	}
+
+	if validateConfigErr != nil {
+		allErrs = append(allErrs,
+			field.Invalid(path.Child(string(in.Action)), in.Action,
+				validateConfigErr.Error()))
+	}
+
+	return allErrs
+}
+
+func errMissingPodFaultConfig(action PodFaultChaosAction) error {
+	return errors.Errorf("the configuration of %s action is required", action)
+}
+
+// validatePodFaultKafkaFillAction requires the topic and the size of messages additionally, because they are
+// passed to the producer in the container.
+func validatePodFaultKafkaFillAction(spec *KafkaFillSpec) error {
+	if err := validateKafkaFillAction(spec); err != nil {
+		return err
+	}
+
+	return validatePodFaultKafkaMessages(&spec.KafkaCommonSpec, spec.MessageSize)
+}
+
+func validatePodFaultKafkaFloodAction(spec *KafkaFloodSpec) error {
+	if err := validateKafkaFloodAction(spec); err != nil {
+		return err
+	}
+
+	return validatePodFaultKafkaMessages(&spec.KafkaCommonSpec, spec.MessageSize)
+}
+
+func validatePodFaultKafkaMessages(spec *KafkaCommonSpec, messageSize uint) error {
+	if len(spec.Topic) == 0 {
+		return errors.New("topic is required")
+	}
+
+	if messageSize == 0 {
+		return errors.New("message size is required")
+	}
+
+	return nil
+}
+
+// validatePodFaultKafkaIOAction requires the topic and the config file additionally, because they could
+// not be discovered from the outside of the container.
+func validatePodFaultKafkaIOAction(spec *KafkaIOSpec) error {
+	if err := validateKafkaIOAction(spec); err != nil {
+		return err
+	}
+
+	if len(spec.Topic) == 0 {
+		return errors.New("topic is required")
+	}
+
+	if len(spec.ConfigFile) == 0 {
+		return errors.New("configFile is required")
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("podfaultchaos_webhook", func() {
+	Context("webhook.Validator of podfaultchaos", func() {
+		It("Validate", func() {
+
+			type TestCase struct {
+				name    string
+				chaos   PodFaultChaos
+				execute func(chaos *PodFaultChaos) error
+				expect  string
+			}
+			errorDuration := "400S"
+
+			tcs := []TestCase{
+				{
+					name: "simple ValidateCreate",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo1",
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateUpdate",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo2",
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateUpdate(chaos)
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "simple ValidateDelete",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo3",
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateDelete()
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "parse the duration error",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo4",
+						},
+						Spec: PodFaultChaosSpec{
+							Duration: &errorDuration,
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "missing the configuration of the action",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo5",
+						},
+						Spec: PodFaultChaosSpec{
+							Action: PodFaultFileCreateAction,
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "invalid configuration of the action",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: PodFaultChaosSpec{
+							Action: PodFaultProcessAction,
+							PodFaultExpInfo: PodFaultExpInfo{
+								Process: &ProcessSpec{
+									Process: "nginx",
+								},
+							},
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "kafka-io without the config file",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: PodFaultChaosSpec{
+							Action: PodFaultKafkaIOAction,
+							PodFaultExpInfo: PodFaultExpInfo{
+								KafkaIO: &KafkaIOSpec{
+									Topic:       "test",
+									NonWritable: true,
+								},
+							},
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "valid file-append",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: PodFaultChaosSpec{
+							Action: PodFaultFileAppendAction,
+							PodFaultExpInfo: PodFaultExpInfo{
+								FileAppend: &FileAppendSpec{
+									FileName: "/var/log/app.log",
+									Data:     "chaos",
+									Count:    3,
+								},
+							},
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "kafka-fill without the message size",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo9",
+						},
+						Spec: PodFaultChaosSpec{
+							Action: PodFaultKafkaFillAction,
+							PodFaultExpInfo: PodFaultExpInfo{
+								KafkaFill: &KafkaFillSpec{
+									KafkaCommonSpec: KafkaCommonSpec{Topic: "test", Host: "localhost", Port: 9092},
+									MaxBytes:        1 << 30,
+									ReloadCommand:   "kill -HUP 1",
+								},
+							},
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "kafka-flood without the topic",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo10",
+						},
+						Spec: PodFaultChaosSpec{
+							Action: PodFaultKafkaFloodAction,
+							PodFaultExpInfo: PodFaultExpInfo{
+								KafkaFlood: &KafkaFloodSpec{
+									KafkaCommonSpec: KafkaCommonSpec{Host: "localhost", Port: 9092},
+									MessageSize:     1024,
+									Threads:         2,
+								},
+							},
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "valid kafka-flood",
+					chaos: PodFaultChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo11",
+						},
+						Spec: PodFaultChaosSpec{
+							Action: PodFaultKafkaFloodAction,
+							PodFaultExpInfo: PodFaultExpInfo{
+								KafkaFlood: &KafkaFloodSpec{
+									KafkaCommonSpec: KafkaCommonSpec{Topic: "test", Host: "localhost", Port: 9092},
+									MessageSize:     1024,
+									Threads:         2,
+								},
+							},
+						},
+					},
+					execute: func(chaos *PodFaultChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "",
+				},
+			}
+
+			for _, tc := range tcs {
+				err := tc.execute(&tc.chaos)
+				if tc.expect == "error" {
+					Expect(err).To(HaveOccurred())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			}
+		})
+	})
+})
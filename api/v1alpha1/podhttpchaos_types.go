@@ -44,6 +44,25 @@ type PodHttpChaosStatus struct {
 
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Rules contains the counters of the rules, in the same order as the rules in spec.
+	// +optional
+	Rules []PodHttpChaosRuleStatus `json:"rules,omitempty"`
+}
+
+// PodHttpChaosRuleStatus represents the counters of a rule reported by the tproxy.
+type PodHttpChaosRuleStatus struct {
+	// Source represents the source of the rule
+	Source string `json:"source,omitempty"`
+
+	// Port represents the target port of the rule.
+	Port int32 `json:"port"`
+
+	// Matched is the number of requests or responses selected by the rule.
+	Matched int64 `json:"matched"`
+
+	// Injected is the number of requests or responses injected by the rule.
+	Injected int64 `json:"injected"`
 }
 
 // PodHttpChaosRule defines the injection rule for http.
@@ -62,11 +81,38 @@ type PodHttpChaosBaseRule struct {
 	// Target is the object to be selected and injected, <Request|Response>.
 	Target PodHttpChaosTarget `json:"target"`
 
+	// Direction is the direction of the traffic to be injected, <Inbound|Outbound>.
+	// +optional
+	Direction HTTPChaosDirection `json:"direction,omitempty"`
+
 	// Selector contains the rules to select target.
 	Selector PodHttpChaosSelector `json:"selector"`
 
 	// Actions contains rules to inject target.
 	Actions PodHttpChaosActions `json:"actions"`
+
+	// Percent is the percentage of the selected requests or responses to be injected, in [1, 100].
+	// All of them are injected if it's not set.
+	// +optional
+	Percent *int32 `json:"percent,omitempty"`
+
+	// Times is the maximum times to inject, the rule stops injecting once it's reached.
+	// +optional
+	Times *int64 `json:"times,omitempty"`
+
+	// Rate limits the injections with a token bucket.
+	// +optional
+	Rate *PodHttpChaosRate `json:"rate,omitempty"`
+}
+
+// PodHttpChaosRate is a token bucket to limit the rate of injections.
+type PodHttpChaosRate struct {
+	// Limit is the number of injections allowed per second.
+	Limit int32 `json:"limit"`
+
+	// Burst is the maximum number of injections allowed at once, it's the same as Limit if not set.
+	// +optional
+	Burst int32 `json:"burst,omitempty"`
 }
 
 type PodHttpChaosSelector struct {
@@ -78,6 +124,10 @@ type PodHttpChaosSelector struct {
 	// +optional
 	Path *string `json:"path,omitempty"`
 
+	// Host is a rule to select target by the host in http request.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
 	// Method is a rule to select target by http method in request.
 	// +optional
 	Method *string `json:"method,omitempty"`
@@ -186,6 +236,17 @@ const (
 	PodHttpResponse PodHttpChaosTarget = "Response"
 )
 
+// HTTPChaosDirection represents the direction of the traffic to be injected
+type HTTPChaosDirection string
+
+const (
+	// HTTPChaosInbound represents the traffic received by the pod
+	HTTPChaosInbound HTTPChaosDirection = "Inbound"
+
+	// HTTPChaosOutbound represents the traffic sent by the pod to its dependencies
+	HTTPChaosOutbound HTTPChaosDirection = "Outbound"
+)
+
 // PodHttpChaosTLS contains the tls config for HTTPChaos
 type PodHttpChaosTLS struct {
 	// SecretName represents the name of required secret resource
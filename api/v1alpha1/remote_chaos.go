@@ -0,0 +1,188 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// aggregatedConditionTypes are the conditions of a fanned out chaos, which are aggregated from the
+// conditions of the chaos in every remote cluster
+var aggregatedConditionTypes = []ChaosConditionType{
+	ConditionSelected,
+	ConditionAllInjected,
+	ConditionAllRecovered,
+	ConditionPaused,
+}
+
+// IsRemoteChaos returns whether the chaos is deployed in remote clusters rather than the current cluster
+func IsRemoteChaos(obj RemoteObject) bool {
+	return obj.GetRemoteCluster() != "" || IsFannedOutChaos(obj)
+}
+
+// IsFannedOutChaos returns whether the chaos is fanned out to several remote clusters
+func IsFannedOutChaos(obj RemoteObject) bool {
+	return len(obj.GetRemoteClusters()) > 0 || obj.GetRemoteClusterSelector() != nil
+}
+
+// SetRemoteClusters keeps the states of the given remote clusters, and removes the states and records of
+// the other remote clusters.
+func (in *ChaosStatus) SetRemoteClusters(clusters []string) {
+	kept := sets.New(clusters...)
+	expected := sets.New(clusters...)
+
+	var remoteClusters []RemoteClusterChaosStatus
+	for _, status := range in.RemoteClusters {
+		if expected.Has(status.Name) {
+			remoteClusters = append(remoteClusters, status)
+			expected.Delete(status.Name)
+		}
+	}
+	for name := range expected {
+		remoteClusters = append(remoteClusters, RemoteClusterChaosStatus{Name: name})
+	}
+	sort.Slice(remoteClusters, func(i, j int) bool {
+		return remoteClusters[i].Name < remoteClusters[j].Name
+	})
+	in.RemoteClusters = remoteClusters
+
+	var records []*Record
+	for _, record := range in.Experiment.Records {
+		if record.RemoteCluster == "" || kept.Has(record.RemoteCluster) {
+			records = append(records, record)
+		}
+	}
+	in.Experiment.Records = records
+
+	in.aggregateRemoteClusters()
+}
+
+// SetRemoteClusterStatus sets the state of the chaos in the remote cluster, which should have been kept by
+// SetRemoteClusters, and aggregates the conditions and records of all the remote clusters.
+func (in *ChaosStatus) SetRemoteClusterStatus(cluster string, status ChaosStatus) {
+	for i := range in.RemoteClusters {
+		if in.RemoteClusters[i].Name != cluster {
+			continue
+		}
+		in.RemoteClusters[i].Conditions = append([]ChaosCondition(nil), status.Conditions...)
+		in.RemoteClusters[i].DesiredPhase = status.Experiment.DesiredPhase
+		in.RemoteClusters[i].Error = ""
+
+		var records []*Record
+		for _, record := range in.Experiment.Records {
+			if record.RemoteCluster != cluster {
+				records = append(records, record)
+			}
+		}
+		for _, record := range status.Experiment.Records {
+			record := record.DeepCopy()
+			record.RemoteCluster = cluster
+			records = append(records, record)
+		}
+		in.Experiment.Records = records
+
+		in.aggregateRemoteClusters()
+		return
+	}
+}
+
+// SetRemoteClusterError records the reason why the chaos could not be created in the remote cluster
+func (in *ChaosStatus) SetRemoteClusterError(cluster string, err string) {
+	for i := range in.RemoteClusters {
+		if in.RemoteClusters[i].Name == cluster {
+			in.RemoteClusters[i].Error = err
+		}
+	}
+}
+
+// aggregateRemoteClusters sets a condition to true only if it's true in all the remote clusters, and sets the
+// desired phase to Run if the chaos is running in any remote cluster.
+func (in *ChaosStatus) aggregateRemoteClusters() {
+	var conditions []ChaosCondition
+	for _, conditionType := range aggregatedConditionTypes {
+		status := corev1.ConditionTrue
+		if len(in.RemoteClusters) == 0 {
+			status = corev1.ConditionFalse
+		}
+		for _, remoteCluster := range in.RemoteClusters {
+			if !conditionIsTrue(remoteCluster.Conditions, conditionType) {
+				status = corev1.ConditionFalse
+				break
+			}
+		}
+		conditions = append(conditions, ChaosCondition{Type: conditionType, Status: status})
+	}
+	in.Conditions = conditions
+
+	var desiredPhase DesiredPhase
+	for _, remoteCluster := range in.RemoteClusters {
+		if remoteCluster.DesiredPhase == RunningPhase {
+			desiredPhase = RunningPhase
+			break
+		}
+		if remoteCluster.DesiredPhase == StoppedPhase {
+			desiredPhase = StoppedPhase
+		}
+	}
+	in.Experiment.DesiredPhase = desiredPhase
+}
+
+func conditionIsTrue(conditions []ChaosCondition, conditionType ChaosConditionType) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// validateRemoteClusters validates the remote clusters where the chaos is deployed
+func validateRemoteClusters(obj RemoteObject) field.ErrorList {
+	var allErrs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	if obj.GetRemoteCluster() != "" && IsFannedOutChaos(obj) {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("remoteCluster"), obj.GetRemoteCluster(),
+			"remoteCluster could not be used together with remoteClusters or remoteClusterSelector"))
+	}
+
+	names := sets.New[string]()
+	for i, name := range obj.GetRemoteClusters() {
+		path := specPath.Child("remoteClusters").Index(i)
+		if name == "" {
+			allErrs = append(allErrs, field.Required(path, "the name of remote cluster is required"))
+			continue
+		}
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(path, name))
+			continue
+		}
+		names.Insert(name)
+	}
+
+	if selector := obj.GetRemoteClusterSelector(); selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("remoteClusterSelector"), selector, err.Error()))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,124 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func remoteChaosStatus(phase DesiredPhase, injected corev1.ConditionStatus, records ...*Record) ChaosStatus {
+	return ChaosStatus{
+		Conditions: []ChaosCondition{
+			{Type: ConditionSelected, Status: corev1.ConditionTrue},
+			{Type: ConditionAllInjected, Status: injected},
+		},
+		Experiment: ExperimentStatus{
+			DesiredPhase: phase,
+			Records:      records,
+		},
+	}
+}
+
+func Test_ChaosStatus_SetRemoteClusterStatus(t *testing.T) {
+	status := ChaosStatus{}
+	status.SetRemoteClusters([]string{"cluster-b", "cluster-a"})
+	if status.RemoteClusters[0].Name != "cluster-a" || status.RemoteClusters[1].Name != "cluster-b" {
+		t.Fatalf("SetRemoteClusters() = %v, want sorted remote clusters", status.RemoteClusters)
+	}
+
+	status.SetRemoteClusterStatus("cluster-a", remoteChaosStatus(RunningPhase, corev1.ConditionTrue,
+		&Record{Id: "app/pod-a", Phase: Injected}))
+	if status.Experiment.DesiredPhase != RunningPhase {
+		t.Errorf("DesiredPhase = %v, want %v", status.Experiment.DesiredPhase, RunningPhase)
+	}
+	if conditionIsTrue(status.Conditions, ConditionSelected) {
+		t.Errorf("condition Selected should be false, since cluster-b has not been selected")
+	}
+
+	status.SetRemoteClusterStatus("cluster-b", remoteChaosStatus(RunningPhase, corev1.ConditionFalse,
+		&Record{Id: "app/pod-b", Phase: NotInjected}))
+	if !conditionIsTrue(status.Conditions, ConditionSelected) {
+		t.Errorf("condition Selected should be true in all clusters")
+	}
+	if conditionIsTrue(status.Conditions, ConditionAllInjected) {
+		t.Errorf("condition AllInjected should be false, since cluster-b has not been injected")
+	}
+
+	status.SetRemoteClusterStatus("cluster-b", remoteChaosStatus(RunningPhase, corev1.ConditionTrue,
+		&Record{Id: "app/pod-b", Phase: Injected}))
+	if !conditionIsTrue(status.Conditions, ConditionAllInjected) {
+		t.Errorf("condition AllInjected should be true in all clusters")
+	}
+	want := []*Record{
+		{Id: "app/pod-a", Phase: Injected, RemoteCluster: "cluster-a"},
+		{Id: "app/pod-b", Phase: Injected, RemoteCluster: "cluster-b"},
+	}
+	if !reflect.DeepEqual(status.Experiment.Records, want) {
+		t.Errorf("Records = %v, want %v", status.Experiment.Records, want)
+	}
+
+	status.SetRemoteClusters([]string{"cluster-a"})
+	if len(status.RemoteClusters) != 1 || !reflect.DeepEqual(status.Experiment.Records, want[:1]) {
+		t.Errorf("the state and records of cluster-b should be removed, got %v and %v", status.RemoteClusters, status.Experiment.Records)
+	}
+}
+
+func Test_validateRemoteClusters(t *testing.T) {
+	specPath := field.NewPath("spec")
+	tests := []struct {
+		name string
+		spec PodChaosSpec
+		want field.ErrorList
+	}{
+		{
+			name: "fanned out by names and selector",
+			spec: PodChaosSpec{
+				RemoteClusters:        []string{"cluster-a", "cluster-b"},
+				RemoteClusterSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "us-west"}},
+			},
+		}, {
+			name: "remote cluster with remote clusters",
+			spec: PodChaosSpec{
+				RemoteCluster:  "cluster-a",
+				RemoteClusters: []string{"cluster-b"},
+			},
+			want: field.ErrorList{
+				field.Invalid(specPath.Child("remoteCluster"), "cluster-a", "remoteCluster could not be used together with remoteClusters or remoteClusterSelector"),
+			},
+		}, {
+			name: "empty and duplicated names",
+			spec: PodChaosSpec{
+				RemoteClusters: []string{"", "cluster-a", "cluster-a"},
+			},
+			want: field.ErrorList{
+				field.Required(specPath.Child("remoteClusters").Index(0), "the name of remote cluster is required"),
+				field.Duplicate(specPath.Child("remoteClusters").Index(2), "cluster-a"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateRemoteClusters(&PodChaos{Spec: tt.spec}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateRemoteClusters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -67,6 +67,16 @@ type RemoteClusterSecretRef struct {
 type RemoteClusterStatus struct {
 	CurrentVersion string `json:"currentVersion"`
 
+	// ObservedVersion is the version of chaos mesh running in the remote cluster, which is observed by the
+	// health probe. The chaos will not be dispatched to the remote cluster, if it's incompatible with CurrentVersion.
+	// +optional
+	ObservedVersion string `json:"observedVersion,omitempty"`
+
+	// KubeConfigHash is the hash of the kubeconfig used to connect the remote cluster. The controllers watching
+	// the remote cluster will be restarted once the kubeconfig is rotated.
+	// +optional
+	KubeConfigHash string `json:"kubeConfigHash,omitempty"`
+
 	// Conditions represents the current condition of the remote cluster
 	// +optional
 	Conditions         []RemoteClusterCondition `json:"conditions,omitempty"`
@@ -78,6 +88,9 @@ type RemoteClusterConditionType string
 var (
 	RemoteClusterConditionInstalled RemoteClusterConditionType = "Installed"
 	RemoteClusterConditionReady     RemoteClusterConditionType = "Ready"
+	// RemoteClusterConditionVersionCompatible means the version of chaos mesh running in the remote cluster is
+	// compatible with the installed one
+	RemoteClusterConditionVersionCompatible RemoteClusterConditionType = "VersionCompatible"
 )
 
 type RemoteClusterCondition struct {
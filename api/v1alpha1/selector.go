@@ -38,6 +38,57 @@ const (
 	RandomMaxPercentMode SelectorMode = "random-max-percent"
 )
 
+// RolloutSpec describes how to inject the chaos into the selected targets progressively, batch by batch,
+// to widen the blast radius gradually.
+type RolloutSpec struct {
+	// BatchSize is the number of targets to be injected in every batch.
+	// +kubebuilder:validation:Minimum=1
+	BatchSize int `json:"batchSize"`
+
+	// Interval is the duration to wait after a batch has been injected, before injecting the next batch.
+	// +optional
+	Interval string `json:"interval,omitempty" webhook:"Duration"`
+
+	// StopOnFailure stops injecting the following batches once a target of the current batch fails to be
+	// injected.
+	// +optional
+	StopOnFailure bool `json:"stopOnFailure,omitempty"`
+
+	// StatusCheck is the name of a StatusCheck in the namespace of the chaos. If it's set, the next batch is
+	// injected only if the status check hasn't failed, and the rollout is halted once the status check fails.
+	// A `Synchronous` status check has to be completed before injecting the next batch.
+	// +optional
+	StatusCheck string `json:"statusCheck,omitempty"`
+}
+
+// +kubebuilder:object:generate=false
+
+// SelectorWithRollout is implemented by the selectors whose targets could be injected progressively
+type SelectorWithRollout interface {
+	GetRollout() *RolloutSpec
+}
+
+// ReselectSpec describes how to select the targets again while the chaos is running, so that the targets
+// created during the chaos, e.g. the new pods of a rolling Deployment, are injected too.
+type ReselectSpec struct {
+	// Interval is the period to select the targets again.
+	// +optional
+	// +kubebuilder:default="1m"
+	Interval string `json:"interval,omitempty" default:"1m" webhook:"Duration"`
+}
+
+// +kubebuilder:object:generate=false
+
+// SelectorWithReselect is implemented by the selectors whose targets could be selected again while the chaos
+// is running
+type SelectorWithReselect interface {
+	GetReselect() *ReselectSpec
+	// GetMode returns the mode and the value of the selector
+	GetMode() (SelectorMode, string)
+	// WithAllMode returns a copy of the selector, which selects all the matched targets
+	WithAllMode() interface{}
+}
+
 // GenericSelectorSpec defines some selectors to select objects.
 type GenericSelectorSpec struct {
 	// Namespaces is a set of namespace to which objects belong.
@@ -113,6 +164,34 @@ type PodSelector struct {
 	// IF `RandomMaxPercentMode`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
 	// +optional
 	Value string `json:"value,omitempty"`
+
+	// Rollout injects the chaos into the selected pods batch by batch, rather than all at once.
+	// +optional
+	Rollout *RolloutSpec `json:"rollout,omitempty"`
+
+	// Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new
+	// matched pods and drops the pods which are gone, keeping the number of pods required by the mode.
+	// +optional
+	Reselect *ReselectSpec `json:"reselect,omitempty"`
+}
+
+func (in *PodSelector) GetRollout() *RolloutSpec {
+	return in.Rollout
+}
+
+func (in *PodSelector) GetReselect() *ReselectSpec {
+	return in.Reselect
+}
+
+func (in *PodSelector) GetMode() (SelectorMode, string) {
+	return in.Mode, in.Value
+}
+
+func (in *PodSelector) WithAllMode() interface{} {
+	selector := in.DeepCopy()
+	selector.Mode = AllMode
+	selector.Value = ""
+	return selector
 }
 
 type ContainerSelector struct {
@@ -124,6 +203,13 @@ type ContainerSelector struct {
 	ContainerNames []string `json:"containerNames,omitempty"`
 }
 
+func (in *ContainerSelector) WithAllMode() interface{} {
+	selector := in.DeepCopy()
+	selector.Mode = AllMode
+	selector.Value = ""
+	return selector
+}
+
 // ClusterScoped returns true if the selector selects Pods in the cluster
 func (in PodSelectorSpec) ClusterScoped() bool {
 	// in fact, this will never happened, will add namespace if it is empty, so len(s.Namespaces) can not be 0,
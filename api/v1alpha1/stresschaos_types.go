@@ -17,7 +17,11 @@ package v1alpha1
 
 import (
 	"fmt"
+	"strconv"
 
+	"github.com/docker/go-units"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -63,13 +67,33 @@ type StressChaosSpec struct {
 	// +optional
 	StressngStressors string `json:"stressngStressors,omitempty"`
 
+	// Throttle limits the resources of the target containers through the cgroup v2 interfaces, rather than
+	// stressing them out. It could be used together with the stressors, and requires the nodes to use cgroup v2.
+	// +optional
+	Throttle *CgroupThrottle `json:"throttle,omitempty"`
+
 	// Duration represents the duration of the chaos action
 	// +optional
 	Duration *string `json:"duration,omitempty" webhook:"Duration"`
 
+	// Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it
+	// injected all the time.
+	// +optional
+	Intermittent *IntermittentSpec `json:"intermittent,omitempty"`
+
 	// RemoteCluster represents the remote cluster where the chaos will be deployed
 	// +optional
 	RemoteCluster string `json:"remoteCluster,omitempty"`
+
+	// RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+	// used together with RemoteCluster
+	// +optional
+	RemoteClusters []string `json:"remoteClusters,omitempty"`
+
+	// RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+	// it could be used together with RemoteClusters
+	// +optional
+	RemoteClusterSelector *metav1.LabelSelector `json:"remoteClusterSelector,omitempty"`
 }
 
 // StressChaosStatus defines the observed state of StressChaos
@@ -94,6 +118,125 @@ type StressInstance struct {
 	// MemoryStartTime specifies when the memStress starts
 	// +optional
 	MemoryStartTime *metav1.Time `json:"memoryStartTime,omitempty"`
+	// CgroupPath is the effective cgroup of the container, where the stresses are accounted and the throttle
+	// is applied. For cgroup v1, it's the cgroup of the memory subsystem.
+	// +optional
+	CgroupPath string `json:"cgroupPath,omitempty"`
+	// CgroupDriver is the cgroup driver of the node, which is "systemd" or "cgroupfs"
+	// +optional
+	CgroupDriver string `json:"cgroupDriver,omitempty"`
+	// OriginalThrottle is the values of the cgroup before throttling, which are restored when recovering
+	// +optional
+	OriginalThrottle *CgroupThrottleValues `json:"originalThrottle,omitempty"`
+}
+
+// CgroupThrottle defines how to limit the resources of a container through the cgroup v2 interfaces.
+// At least one of the limits should be specified.
+type CgroupThrottle struct {
+	// CPU limits the CPU time of the container through cpu.max, e.g. "500m" limits the container to
+	// half of a CPU.
+	// +optional
+	CPU string `json:"cpu,omitempty"`
+
+	// MemoryHigh sets memory.high of the container, over which the container is throttled and its
+	// memory is reclaimed heavily. It's in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. "256MiB".
+	// +optional
+	MemoryHigh string `json:"memoryHigh,omitempty"`
+
+	// IO limits the IO of the container on the block devices through io.max
+	// +optional
+	IO []IOThrottle `json:"io,omitempty"`
+}
+
+// IOThrottle defines the IO limits of a block device
+type IOThrottle struct {
+	// Device is the block device, either the path in the container like "/dev/sda", or "<major>:<minor>"
+	Device string `json:"device"`
+
+	// ReadBytesPerSecond limits the read bandwidth, in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. "10MiB"
+	// +optional
+	ReadBytesPerSecond string `json:"readBytesPerSecond,omitempty"`
+
+	// WriteBytesPerSecond limits the write bandwidth, in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. "10MiB"
+	// +optional
+	WriteBytesPerSecond string `json:"writeBytesPerSecond,omitempty"`
+
+	// ReadIOPS limits the read operations per second
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ReadIOPS uint64 `json:"readIOPS,omitempty"`
+
+	// WriteIOPS limits the write operations per second
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WriteIOPS uint64 `json:"writeIOPS,omitempty"`
+}
+
+// CgroupThrottleValues is the values of the cgroup v2 interface files
+type CgroupThrottleValues struct {
+	// CPUMax is the content of cpu.max
+	// +optional
+	CPUMax string `json:"cpuMax,omitempty"`
+	// MemoryHigh is the content of memory.high
+	// +optional
+	MemoryHigh string `json:"memoryHigh,omitempty"`
+	// IOMax are the lines of io.max
+	// +optional
+	IOMax []string `json:"ioMax,omitempty"`
+}
+
+// cpuMaxPeriod is the period of cpu.max in microseconds, which is the default period of the CFS bandwidth control
+const cpuMaxPeriod = 100000
+
+// minCPUMaxQuota is the minimum quota of cpu.max in microseconds
+const minCPUMaxQuota = 1000
+
+// Values converts the throttle to the values of the cgroup v2 interface files. The throttle should have been
+// validated.
+func (in *CgroupThrottle) Values() (*CgroupThrottleValues, error) {
+	values := &CgroupThrottleValues{}
+
+	if in.CPU != "" {
+		cpu, err := resource.ParseQuantity(in.CPU)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse cpu %s", in.CPU)
+		}
+		values.CPUMax = fmt.Sprintf("%d %d", cpu.MilliValue()*cpuMaxPeriod/1000, cpuMaxPeriod)
+	}
+
+	if in.MemoryHigh != "" {
+		memory, err := units.RAMInBytes(in.MemoryHigh)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse memory high %s", in.MemoryHigh)
+		}
+		values.MemoryHigh = strconv.FormatInt(memory, 10)
+	}
+
+	for _, io := range in.IO {
+		line := io.Device
+		for _, limit := range []struct {
+			key   string
+			bytes string
+		}{{"rbps", io.ReadBytesPerSecond}, {"wbps", io.WriteBytesPerSecond}} {
+			if limit.bytes == "" {
+				continue
+			}
+			bytes, err := units.RAMInBytes(limit.bytes)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parse %s of device %s", limit.key, io.Device)
+			}
+			line += fmt.Sprintf(" %s=%d", limit.key, bytes)
+		}
+		if io.ReadIOPS > 0 {
+			line += fmt.Sprintf(" riops=%d", io.ReadIOPS)
+		}
+		if io.WriteIOPS > 0 {
+			line += fmt.Sprintf(" wiops=%d", io.WriteIOPS)
+		}
+		values.IOMax = append(values.IOMax, line)
+	}
+
+	return values, nil
 }
 
 // Stressors defines plenty of stressors supported to stress system components out.
@@ -66,4 +66,25 @@ var _ = Describe("StressChaos", func() {
 		})
 	})
 
+	Context("CgroupThrottle", func() {
+		It("Should convert to the values of cgroup v2", func() {
+			throttle := &CgroupThrottle{
+				CPU:        "1500m",
+				MemoryHigh: "256MiB",
+				IO: []IOThrottle{
+					{Device: "/dev/sda", ReadBytesPerSecond: "1MiB", WriteIOPS: 100},
+					{Device: "8:16", WriteBytesPerSecond: "2MiB", ReadIOPS: 50},
+				},
+			}
+
+			values, err := throttle.Values()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(&CgroupThrottleValues{
+				CPUMax:     "150000 100000",
+				MemoryHigh: "268435456",
+				IOMax:      []string{"/dev/sda rbps=1048576 wiops=100", "8:16 wbps=2097152 riops=50"},
+			}))
+		})
+	})
+
 })
@@ -22,6 +22,7 @@ import (
 
 	"github.com/docker/go-units"
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
@@ -29,7 +30,7 @@ import (
 
 // Validate validates the scheduler and duration
 func (in *StressChaosSpec) Validate(root interface{}, path *field.Path) field.ErrorList {
-	if len(in.StressngStressors) == 0 && in.Stressors == nil {
+	if len(in.StressngStressors) == 0 && in.Stressors == nil && in.Throttle == nil {
 		return field.ErrorList{
 			field.Invalid(path, in, "missing stressors"),
 		}
@@ -37,6 +38,58 @@ func (in *StressChaosSpec) Validate(root interface{}, path *field.Path) field.Er
 	return nil
 }
 
+// Validate validates whether the limits of the throttle are well defined
+func (in *CgroupThrottle) Validate(root interface{}, path *field.Path) field.ErrorList {
+	if in == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+	if in.CPU == "" && in.MemoryHigh == "" && len(in.IO) == 0 {
+		allErrs = append(allErrs, field.Invalid(path, in, "missing limits"))
+	}
+
+	if in.CPU != "" {
+		cpu, err := resource.ParseQuantity(in.CPU)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("cpu"), in.CPU, fmt.Sprintf("incorrect cpu format: %s", err.Error())))
+		} else if cpu.MilliValue()*cpuMaxPeriod/1000 < minCPUMaxQuota {
+			// the quota of cpu.max is at least 1ms
+			allErrs = append(allErrs, field.Invalid(path.Child("cpu"), in.CPU, "cpu should be at least 10m"))
+		}
+	}
+	allErrs = append(allErrs, validatePositiveBytes(path.Child("memoryHigh"), in.MemoryHigh)...)
+
+	for i, io := range in.IO {
+		ioPath := path.Child("io").Index(i)
+		if io.Device == "" {
+			allErrs = append(allErrs, field.Required(ioPath.Child("device"), "the block device is required"))
+		}
+		if io.ReadBytesPerSecond == "" && io.WriteBytesPerSecond == "" && io.ReadIOPS == 0 && io.WriteIOPS == 0 {
+			allErrs = append(allErrs, field.Invalid(ioPath, io, "missing limits"))
+		}
+		allErrs = append(allErrs, validatePositiveBytes(ioPath.Child("readBytesPerSecond"), io.ReadBytesPerSecond)...)
+		allErrs = append(allErrs, validatePositiveBytes(ioPath.Child("writeBytesPerSecond"), io.WriteBytesPerSecond)...)
+	}
+
+	return allErrs
+}
+
+func validatePositiveBytes(path *field.Path, size string) field.ErrorList {
+	if size == "" {
+		return nil
+	}
+
+	bytes, err := units.RAMInBytes(size)
+	if err != nil {
+		return field.ErrorList{field.Invalid(path, size, fmt.Sprintf("incorrect bytes format: %s", err.Error()))}
+	}
+	if bytes <= 0 {
+		return field.ErrorList{field.Invalid(path, size, "bytes should be greater than 0")}
+	}
+	return nil
+}
+
 // Validate validates whether the Stressors are all well defined
 func (in *Stressors) Validate(root interface{}, path *field.Path) field.ErrorList {
 	if in == nil {
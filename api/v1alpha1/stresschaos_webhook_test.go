@@ -111,6 +111,63 @@ var _ = Describe("stresschaos_webhook", func() {
 					},
 					expect: "error",
 				},
+				{
+					name: "throttle without stressors",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo6",
+						},
+						Spec: StressChaosSpec{
+							Throttle: &CgroupThrottle{
+								CPU:        "500m",
+								MemoryHigh: "256MiB",
+								IO:         []IOThrottle{{Device: "/dev/sda", ReadBytesPerSecond: "10MiB"}},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "",
+				},
+				{
+					name: "throttle without limits",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo7",
+						},
+						Spec: StressChaosSpec{
+							Throttle: &CgroupThrottle{},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
+				{
+					name: "throttle with invalid io",
+					chaos: StressChaos{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: metav1.NamespaceDefault,
+							Name:      "foo8",
+						},
+						Spec: StressChaosSpec{
+							Throttle: &CgroupThrottle{
+								IO: []IOThrottle{{Device: "/dev/sda", WriteBytesPerSecond: "fast"}},
+							},
+						},
+					},
+					execute: func(chaos *StressChaos) error {
+						_, err := chaos.ValidateCreate()
+						return err
+					},
+					expect: "error",
+				},
 			}
 
 			for _, tc := range tcs {
@@ -45,8 +45,79 @@ func (in *Workflow) GetObjectMeta() *metav1.ObjectMeta {
 const KindWorkflow = "Workflow"
 
 type WorkflowSpec struct {
-	Entry     string     `json:"entry"`
+	// Entry is the name of the template to start with, it's required unless WorkflowTemplateRef is specified.
+	// +optional
+	Entry string `json:"entry"`
+	// Templates describes the templates of workflow, they are required unless WorkflowTemplateRef is specified.
+	// +optional
 	Templates []Template `json:"templates"`
+	// WorkflowTemplateRef refers to a reusable template. If Templates is empty, the entry, templates and
+	// parameters of the referenced template are resolved into the workflow before it's scheduled, and the
+	// parameters specified in the workflow override the ones of the template.
+	// +optional
+	WorkflowTemplateRef *WorkflowTemplateRef `json:"workflowTemplateRef,omitempty"`
+	// Parameters describes the parameters of workflow, they could be referenced as `parameters.<name>`
+	// in the placeholders of the string fields of the chaos, schedule and status check in templates,
+	// and the deadline of templates.
+	// +optional
+	Parameters []WorkflowParameter `json:"parameters,omitempty"`
+}
+
+type WorkflowParameter struct {
+	// Name is the name of parameter, it should be a valid identifier.
+	Name string `json:"name"`
+	// Description describes the usage of parameter.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// Default is the default value of parameter, it's used when Value is not specified.
+	// +optional
+	Default *string `json:"default,omitempty"`
+	// Value is the value of parameter.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// ResolvedValue returns the value of parameter, or the default value if the value is not specified.
+func (in *WorkflowParameter) ResolvedValue() (string, bool) {
+	if in.Value != nil {
+		return *in.Value, true
+	}
+	if in.Default != nil {
+		return *in.Default, true
+	}
+	return "", false
+}
+
+// ResolveParameters returns the resolved values of all the parameters, indexed by name.
+func (in *WorkflowSpec) ResolveParameters() (map[string]string, error) {
+	result := make(map[string]string, len(in.Parameters))
+	for _, parameter := range in.Parameters {
+		value, ok := parameter.ResolvedValue()
+		if !ok {
+			return nil, errors.Errorf("the value of parameter %s is required", parameter.Name)
+		}
+		result[parameter.Name] = value
+	}
+	return result, nil
+}
+
+// SetParameterValues sets the values of the parameters, it returns error if the parameter is not declared.
+func (in *WorkflowSpec) SetParameterValues(values map[string]string) error {
+	for name, value := range values {
+		found := false
+		for i := range in.Parameters {
+			if in.Parameters[i].Name == name {
+				value := value
+				in.Parameters[i].Value = &value
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("parameter %s is not declared in workflow", name)
+		}
+	}
+	return nil
 }
 
 type WorkflowStatus struct {
@@ -86,8 +157,16 @@ const (
 	TypeSuspend     TemplateType = "Suspend"
 	TypeSchedule    TemplateType = "Schedule"
 	TypeStatusCheck TemplateType = "StatusCheck"
+	TypeRetry       TemplateType = "Retry"
+	TypeLoop        TemplateType = "Loop"
+	TypeForEach     TemplateType = "ForEach"
 )
 
+// IsIterationTemplateType returns whether the template instantiates its only child repeatedly
+func IsIterationTemplateType(target TemplateType) bool {
+	return target == TypeRetry || target == TypeLoop || target == TypeForEach
+}
+
 func IsChaosTemplateType(target TemplateType) bool {
 	return contains(allChaosTemplateType, target)
 }
@@ -109,7 +188,8 @@ type Template struct {
 	// Task describes the behavior of the custom task. Only used when Type is TypeTask.
 	// +optional
 	Task *Task `json:"task,omitempty"`
-	// Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel.
+	// Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial, TypeParallel,
+	// TypeRetry, TypeLoop or TypeForEach. The last three types accept exactly one child.
 	// +optional
 	Children []string `json:"children,omitempty"`
 	// ConditionalBranches describes the conditional branches of custom tasks. Only used when Type is TypeTask.
@@ -128,6 +208,73 @@ type Template struct {
 	// Only used when Type is TypeStatusCheck.
 	// +optional
 	AbortWithStatusCheck bool `json:"abortWithStatusCheck,omitempty"`
+	// Retry describes the behavior of Retry. Only used when Type is TypeRetry.
+	// +optional
+	Retry *RetrySpec `json:"retry,omitempty"`
+	// Loop describes the behavior of Loop. Only used when Type is TypeLoop.
+	// +optional
+	Loop *LoopSpec `json:"loop,omitempty"`
+	// ForEach describes the behavior of ForEach. Only used when Type is TypeForEach.
+	// +optional
+	ForEach *ForEachSpec `json:"forEach,omitempty"`
+}
+
+// RetrySpec describes a node which instantiates its child until the child succeeds, or the attempts are exhausted.
+type RetrySpec struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	// +kubebuilder:validation:Minimum=1
+	MaxAttempts int `json:"maxAttempts"`
+
+	// Backoff is the duration to wait before the first retry.
+	// +optional
+	Backoff *string `json:"backoff,omitempty"`
+
+	// Factor is the multiplier applied to the backoff after each retry.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Factor *int `json:"factor,omitempty"`
+
+	// MaxBackoff is the upper limit of the backoff.
+	// +optional
+	MaxBackoff *string `json:"maxBackoff,omitempty"`
+
+	// Expression decides whether an attempt succeeds, the outputs of nodes and the parameters are available in it.
+	// If it's empty, an attempt succeeds unless it's aborted, it's a task exiting with non-zero code, or it's
+	// a status check exceeding the failure threshold.
+	// +optional
+	Expression string `json:"expression,omitempty"`
+}
+
+// LoopSpec describes a node which instantiates its child repeatedly, until the count is reached
+// or the expression is evaluated as true.
+type LoopSpec struct {
+	// Count is the maximum number of iterations.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Count *int `json:"count,omitempty"`
+
+	// Until is the expression evaluated after each iteration, the loop stops when it's evaluated as true.
+	// The outputs of nodes, the parameters and the index of iteration are available in it.
+	// +optional
+	Until string `json:"until,omitempty"`
+
+	// Interval is the duration to wait between iterations.
+	// +optional
+	Interval *string `json:"interval,omitempty"`
+}
+
+// ForEachSpec describes a node which instantiates its child for each item of a list.
+type ForEachSpec struct {
+	// Items is the expression evaluated as the list of items, like `parameters.namespaces` or `outputs.pick.pods`.
+	// A string result is parsed as a JSON list, or split by comma. The item and its index are available as `item`
+	// and `index` in the templated fields of the child and its descendants.
+	Items string `json:"items"`
+
+	// Parallelism is the maximum number of children running at the same time. All the children run
+	// at the same time if it's not specified.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Parallelism *int `json:"parallelism,omitempty"`
 }
 
 // ChaosOnlyScheduleSpec is very similar with ScheduleSpec, but it could not schedule Workflow
@@ -162,7 +309,43 @@ type Task struct {
 	// +patchMergeKey=name
 	Volumes []corev1.Volume `json:"volumes,omitempty" patchStrategy:"merge" patchMergeKey:"name"`
 
-	// TODO: maybe we could specify parameters in other ways, like loading context from file
+	// Outputs describes the named outputs published by the task, they could be referenced as
+	// `outputs.<template name>.<output name>` in the expressions of conditional branches and
+	// the templated fields of the following nodes.
+	// +optional
+	Outputs []TaskOutput `json:"outputs,omitempty"`
+}
+
+type TaskOutputSource string
+
+const (
+	// TaskOutputFromStdout means the output is parsed from the stdout of the container.
+	TaskOutputFromStdout TaskOutputSource = "Stdout"
+	// TaskOutputFromFile means the output is parsed from a file written by the container.
+	TaskOutputFromFile TaskOutputSource = "File"
+)
+
+type TaskOutput struct {
+	// Name is the name of the output.
+	Name string `json:"name"`
+
+	// From is the source of the output, the content of the source is parsed as JSON,
+	// or used as a plain string if it's not a valid JSON.
+	// +optional
+	// +kubebuilder:validation:Enum=Stdout;File
+	// +kubebuilder:default=Stdout
+	From TaskOutputSource `json:"from,omitempty"`
+
+	// Path is the path of the file which the output is read from. Only used when From is File.
+	// The file is used as the termination message of the container, so all the outputs
+	// of a task share the same file, and its size should not exceed 4096 bytes.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Key selects the value with the key from the parsed JSON object.
+	// The whole parsed content is used if it's empty.
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -16,9 +16,12 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -39,8 +42,29 @@ var _ webhook.Validator = &Workflow{}
 func (in *Workflow) ValidateCreate() (admission.Warnings, error) {
 	var allErrs field.ErrorList
 	specPath := field.NewPath("spec")
+	if in.Spec.WorkflowTemplateRef != nil {
+		if len(in.Spec.WorkflowTemplateRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("workflowTemplateRef", "name"), "name of the referenced template is required"))
+		}
+		// the entry and templates are resolved from the referenced template later, and the parameters
+		// only override the ones of the template. The resolved workflow is validated by the auth webhook.
+		if len(in.Spec.Templates) == 0 {
+			allErrs = append(allErrs, validateParameterNames(specPath.Child("parameters"), in.Spec.Parameters)...)
+			if len(allErrs) > 0 {
+				return nil, errors.New(allErrs.ToAggregate().Error())
+			}
+			return nil, nil
+		}
+	}
 	allErrs = append(allErrs, entryMustExists(specPath.Child("entry"), in.Spec.Entry, in.Spec.Templates)...)
-	allErrs = append(allErrs, validateTemplates(specPath.Child("templates"), in.Spec.Templates)...)
+	allErrs = append(allErrs, validateParameters(specPath.Child("parameters"), in.Spec.Parameters)...)
+	allErrs = append(allErrs, parametersMustBeDeclared(specPath.Child("templates"), in.Spec.Templates, in.Spec.Parameters)...)
+	templates, err := renderParameters(in.Spec.Templates, in.Spec.Parameters)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(specPath.Child("templates"), err))
+		templates = in.Spec.Templates
+	}
+	allErrs = append(allErrs, validateTemplates(specPath.Child("templates"), templates)...)
 	if len(allErrs) > 0 {
 		return nil, errors.New(allErrs.ToAggregate().Error())
 	}
@@ -118,6 +142,7 @@ func validateTemplate(path *field.Path, template Template, allTemplates []Templa
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoIteration(path, template)...)
 	case templateType == TypeSerial, templateType == TypeParallel:
 		for i, item := range template.Children {
 			result = append(result, templateMustExists(item, path.Child("children").Index(i), allTemplates)...)
@@ -126,15 +151,21 @@ func validateTemplate(path *field.Path, template Template, allTemplates []Templa
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoIteration(path, template)...)
 	case templateType == TypeSchedule:
 		result = append(result, shouldBeNoTask(path, template)...)
 		result = append(result, shouldBeNoChildren(path, template)...)
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoIteration(path, template)...)
 	case templateType == TypeTask:
+		if template.Task != nil {
+			result = append(result, validateTaskOutputs(path.Child("task", "outputs"), template.Task.Outputs)...)
+		}
 		result = append(result, shouldBeNoChildren(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoIteration(path, template)...)
 	case IsChaosTemplateType(templateType):
 		result = append(result, shouldNotSetupDurationInTheChaos(path, template)...)
 
@@ -142,14 +173,33 @@ func validateTemplate(path *field.Path, template Template, allTemplates []Templa
 		result = append(result, shouldBeNoChildren(path, template)...)
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoIteration(path, template)...)
 
-		result = append(result, template.EmbedChaos.Validate(path, string(templateType))...)
+		// the chaos which still contains placeholders (like the outputs of other nodes) could only be
+		// validated after it's rendered at node creation
+		if !containsPlaceholder(template.EmbedChaos) {
+			result = append(result, template.EmbedChaos.Validate(path, string(templateType))...)
+		}
 	case templateType == TypeStatusCheck:
 		result = append(result, shouldBeNoTask(path, template)...)
 		result = append(result, shouldBeNoChildren(path, template)...)
 		result = append(result, shouldBeNoConditionalBranches(path, template)...)
 		result = append(result, shouldBeNoEmbedChaos(path, template)...)
 		result = append(result, shouldBeNoSchedule(path, template)...)
+		result = append(result, shouldBeNoIteration(path, template)...)
+	case IsIterationTemplateType(templateType):
+		if len(template.Children) != 1 {
+			result = append(result, field.Invalid(path.Child("children"), template.Children, fmt.Sprintf("template with type %s should contain exactly one child", templateType)))
+		}
+		for i, item := range template.Children {
+			result = append(result, templateMustExists(item, path.Child("children").Index(i), allTemplates)...)
+		}
+		result = append(result, validateIteration(path, template)...)
+		result = append(result, shouldBeNoIteration(path, template)...)
+		result = append(result, shouldBeNoTask(path, template)...)
+		result = append(result, shouldBeNoConditionalBranches(path, template)...)
+		result = append(result, shouldBeNoEmbedChaos(path, template)...)
+		result = append(result, shouldBeNoSchedule(path, template)...)
 	default:
 		result = append(result, field.Invalid(path.Child("templateType"), template.Type, fmt.Sprintf("unrecognized template type: %s", template.Type)))
 	}
@@ -157,6 +207,199 @@ func validateTemplate(path *field.Path, template Template, allTemplates []Templa
 	return result
 }
 
+var (
+	parameterNamePattern      = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	placeholderPattern        = regexp.MustCompile(`\{\{\s*(.+?)\s*\}\}`)
+	parameterReferencePattern = regexp.MustCompile(`\bparameters\.([A-Za-z_][A-Za-z0-9_]*)`)
+	parameterPlaceholder      = regexp.MustCompile(`\{\{\s*parameters\.([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+)
+
+func validateParameters(path *field.Path, parameters []WorkflowParameter) field.ErrorList {
+	return validateParameterList(path, parameters, true)
+}
+
+// validateParameterNames checks the names of parameters are valid identifiers, and not duplicated.
+func validateParameterNames(path *field.Path, parameters []WorkflowParameter) field.ErrorList {
+	return validateParameterList(path, parameters, false)
+}
+
+func validateParameterList(path *field.Path, parameters []WorkflowParameter, valueRequired bool) field.ErrorList {
+	var result field.ErrorList
+	names := make(map[string]struct{})
+	for i, parameter := range parameters {
+		itemPath := path.Index(i)
+		if !parameterNamePattern.MatchString(parameter.Name) {
+			result = append(result, field.Invalid(itemPath.Child("name"), parameter.Name, "name of parameter must be a valid identifier, which matches "+parameterNamePattern.String()))
+		} else if _, ok := names[parameter.Name]; ok {
+			result = append(result, field.Duplicate(itemPath.Child("name"), parameter.Name))
+		}
+		names[parameter.Name] = struct{}{}
+
+		if _, ok := parameter.ResolvedValue(); valueRequired && !ok {
+			result = append(result, field.Required(itemPath.Child("value"), fmt.Sprintf("the value of parameter %s is required, since it has no default value", parameter.Name)))
+		}
+	}
+	return result
+}
+
+// parametersMustBeDeclared checks all the parameters referenced by the placeholders in templates are declared.
+func parametersMustBeDeclared(path *field.Path, templates []Template, parameters []WorkflowParameter) field.ErrorList {
+	var result field.ErrorList
+	declared := make(map[string]struct{})
+	for _, parameter := range parameters {
+		declared[parameter.Name] = struct{}{}
+	}
+	for i, template := range templates {
+		data, err := json.Marshal(template)
+		if err != nil {
+			result = append(result, field.InternalError(path.Index(i), err))
+			continue
+		}
+		for _, placeholder := range placeholderPattern.FindAllStringSubmatch(string(data), -1) {
+			for _, reference := range parameterReferencePattern.FindAllStringSubmatch(placeholder[1], -1) {
+				if _, ok := declared[reference[1]]; !ok {
+					result = append(result, field.Invalid(path.Index(i), placeholder[0], fmt.Sprintf("parameter %s is not declared", reference[1])))
+				}
+			}
+		}
+	}
+	return result
+}
+
+// renderParameters replaces the simple placeholders like `{{ parameters.<name> }}` in templates with the values of parameters,
+// other placeholders are left untouched.
+func renderParameters(templates []Template, parameters []WorkflowParameter) ([]Template, error) {
+	if len(parameters) == 0 {
+		return templates, nil
+	}
+	values := make(map[string]string)
+	for _, parameter := range parameters {
+		if value, ok := parameter.ResolvedValue(); ok {
+			values[parameter.Name] = value
+		}
+	}
+
+	var result []Template
+	for _, template := range templates {
+		data, err := json.Marshal(template)
+		if err != nil {
+			return nil, err
+		}
+		rendered := parameterPlaceholder.ReplaceAllStringFunc(string(data), func(matched string) string {
+			value, ok := values[parameterPlaceholder.FindStringSubmatch(matched)[1]]
+			if !ok {
+				return matched
+			}
+			// the value is placed in a JSON string
+			escaped, _ := json.Marshal(value)
+			return string(escaped[1 : len(escaped)-1])
+		})
+		var renderedTemplate Template
+		if err := json.Unmarshal([]byte(rendered), &renderedTemplate); err != nil {
+			return nil, err
+		}
+		result = append(result, renderedTemplate)
+	}
+	return result, nil
+}
+
+func containsPlaceholder(obj interface{}) bool {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return false
+	}
+	return placeholderPattern.Match(data)
+}
+
+func validateTaskOutputs(path *field.Path, outputs []TaskOutput) field.ErrorList {
+	var result field.ErrorList
+	names := make(map[string]struct{})
+	outputFile := ""
+	for i, output := range outputs {
+		itemPath := path.Index(i)
+		if len(output.Name) == 0 {
+			result = append(result, field.Required(itemPath.Child("name"), "name of output is required"))
+		} else if _, ok := names[output.Name]; ok {
+			result = append(result, field.Duplicate(itemPath.Child("name"), output.Name))
+		}
+		names[output.Name] = struct{}{}
+
+		switch output.From {
+		case TaskOutputFromFile:
+			if len(output.Path) == 0 {
+				result = append(result, field.Required(itemPath.Child("path"), "path of output is required when it's read from file"))
+			} else if len(outputFile) == 0 {
+				outputFile = output.Path
+			} else if outputFile != output.Path {
+				result = append(result, field.Invalid(itemPath.Child("path"), output.Path, fmt.Sprintf("all the outputs of a task should be read from the same file %s", outputFile)))
+			}
+		case TaskOutputFromStdout, "":
+			if len(output.Path) != 0 {
+				result = append(result, field.Invalid(itemPath.Child("path"), output.Path, "path of output is only used when it's read from file"))
+			}
+		default:
+			result = append(result, field.Invalid(itemPath.Child("from"), output.From, fmt.Sprintf("unrecognized output source: %s", output.From)))
+		}
+	}
+	return result
+}
+
+// validateIteration validates the spec of Retry, Loop or ForEach
+func validateIteration(path *field.Path, template Template) field.ErrorList {
+	var result field.ErrorList
+	switch template.Type {
+	case TypeRetry:
+		retryPath := path.Child("retry")
+		if template.Retry == nil {
+			return append(result, field.Required(retryPath, "retry is required when the type of template is Retry"))
+		}
+		if template.Retry.MaxAttempts < 1 {
+			result = append(result, field.Invalid(retryPath.Child("maxAttempts"), template.Retry.MaxAttempts, "maxAttempts should be greater than 0"))
+		}
+		if template.Retry.Factor != nil && *template.Retry.Factor < 1 {
+			result = append(result, field.Invalid(retryPath.Child("factor"), *template.Retry.Factor, "factor should be greater than 0"))
+		}
+		result = append(result, validateIterationDuration(retryPath.Child("backoff"), template.Retry.Backoff)...)
+		result = append(result, validateIterationDuration(retryPath.Child("maxBackoff"), template.Retry.MaxBackoff)...)
+	case TypeLoop:
+		loopPath := path.Child("loop")
+		if template.Loop == nil {
+			return append(result, field.Required(loopPath, "loop is required when the type of template is Loop"))
+		}
+		if template.Loop.Count == nil && len(template.Loop.Until) == 0 {
+			result = append(result, field.Required(loopPath, "at least one of count and until is required"))
+		}
+		if template.Loop.Count != nil && *template.Loop.Count < 1 {
+			result = append(result, field.Invalid(loopPath.Child("count"), *template.Loop.Count, "count should be greater than 0"))
+		}
+		result = append(result, validateIterationDuration(loopPath.Child("interval"), template.Loop.Interval)...)
+	case TypeForEach:
+		forEachPath := path.Child("forEach")
+		if template.ForEach == nil {
+			return append(result, field.Required(forEachPath, "forEach is required when the type of template is ForEach"))
+		}
+		if len(template.ForEach.Items) == 0 {
+			result = append(result, field.Required(forEachPath.Child("items"), "items of forEach is required"))
+		}
+		if template.ForEach.Parallelism != nil && *template.ForEach.Parallelism < 1 {
+			result = append(result, field.Invalid(forEachPath.Child("parallelism"), *template.ForEach.Parallelism, "parallelism should be greater than 0"))
+		}
+	}
+	return result
+}
+
+func validateIterationDuration(path *field.Path, duration *string) field.ErrorList {
+	if duration == nil {
+		return nil
+	}
+	if _, err := time.ParseDuration(*duration); err != nil {
+		return field.ErrorList{
+			field.Invalid(path, *duration, fmt.Sprintf("parse duration field error: %s", err)),
+		}
+	}
+	return nil
+}
+
 func namesCouldNotBeDuplicated(templatesPath *field.Path, names []string) field.ErrorList {
 	nameCounter := make(map[string]int)
 	for _, name := range names {
@@ -275,6 +518,20 @@ func shouldBeNoSchedule(path *field.Path, template Template) field.ErrorList {
 	return nil
 }
 
+func shouldBeNoIteration(path *field.Path, template Template) field.ErrorList {
+	var result field.ErrorList
+	if template.Type != TypeRetry && template.Retry != nil {
+		result = append(result, field.Invalid(path, template.Retry, "this template should not contain Retry"))
+	}
+	if template.Type != TypeLoop && template.Loop != nil {
+		result = append(result, field.Invalid(path, template.Loop, "this template should not contain Loop"))
+	}
+	if template.Type != TypeForEach && template.ForEach != nil {
+		result = append(result, field.Invalid(path, template.ForEach, "this template should not contain ForEach"))
+	}
+	return result
+}
+
 func (in *Workflow) Default() {
 	gw.Default(in)
 }
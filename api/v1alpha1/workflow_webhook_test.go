@@ -411,6 +411,231 @@ func Test_namesCouldNotBeDuplicated(t *testing.T) {
 	}
 }
 
+func Test_validateTaskOutputs(t *testing.T) {
+	outputsPath := field.NewPath("spec", "templates").Index(0).Child("task", "outputs")
+	type args struct {
+		path    *field.Path
+		outputs []TaskOutput
+	}
+	tests := []struct {
+		name string
+		args args
+		want field.ErrorList
+	}{
+		{
+			name: "valid outputs",
+			args: args{
+				path: outputsPath,
+				outputs: []TaskOutput{
+					{Name: "victim", Key: "pod"},
+					{Name: "namespace", From: TaskOutputFromFile, Path: "/tmp/outputs.json", Key: "namespace"},
+					{Name: "all", From: TaskOutputFromFile, Path: "/tmp/outputs.json"},
+				},
+			},
+			want: nil,
+		}, {
+			name: "duplicated names and missing path",
+			args: args{
+				path: outputsPath,
+				outputs: []TaskOutput{
+					{Name: "victim"},
+					{Name: "victim", From: TaskOutputFromFile},
+				},
+			},
+			want: field.ErrorList{
+				field.Duplicate(outputsPath.Index(1).Child("name"), "victim"),
+				field.Required(outputsPath.Index(1).Child("path"), "path of output is required when it's read from file"),
+			},
+		}, {
+			name: "outputs read from different files",
+			args: args{
+				path: outputsPath,
+				outputs: []TaskOutput{
+					{Name: "a", From: TaskOutputFromFile, Path: "/tmp/a"},
+					{Name: "b", From: TaskOutputFromFile, Path: "/tmp/b"},
+					{Name: "c", Path: "/tmp/c"},
+				},
+			},
+			want: field.ErrorList{
+				field.Invalid(outputsPath.Index(1).Child("path"), "/tmp/b", "all the outputs of a task should be read from the same file /tmp/a"),
+				field.Invalid(outputsPath.Index(2).Child("path"), "/tmp/c", "path of output is only used when it's read from file"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateTaskOutputs(tt.args.path, tt.args.outputs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateTaskOutputs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateIteration(t *testing.T) {
+	templatePath := field.NewPath("spec", "templates").Index(0)
+	zero := 0
+	invalidDuration := "1x"
+	tests := []struct {
+		name     string
+		template Template
+		want     field.ErrorList
+	}{
+		{
+			name: "valid retry",
+			template: Template{
+				Type:  TypeRetry,
+				Retry: &RetrySpec{MaxAttempts: 3, Backoff: &[]string{"10s"}[0]},
+			},
+			want: nil,
+		}, {
+			name:     "retry is required",
+			template: Template{Type: TypeRetry},
+			want: field.ErrorList{
+				field.Required(templatePath.Child("retry"), "retry is required when the type of template is Retry"),
+			},
+		}, {
+			name: "invalid retry",
+			template: Template{
+				Type:  TypeRetry,
+				Retry: &RetrySpec{MaxBackoff: &invalidDuration},
+			},
+			want: field.ErrorList{
+				field.Invalid(templatePath.Child("retry", "maxAttempts"), 0, "maxAttempts should be greater than 0"),
+				field.Invalid(templatePath.Child("retry", "maxBackoff"), "1x", `parse duration field error: time: unknown unit "x" in duration "1x"`),
+			},
+		}, {
+			name: "loop without count and until",
+			template: Template{
+				Type: TypeLoop,
+				Loop: &LoopSpec{},
+			},
+			want: field.ErrorList{
+				field.Required(templatePath.Child("loop"), "at least one of count and until is required"),
+			},
+		}, {
+			name: "invalid for-each",
+			template: Template{
+				Type:    TypeForEach,
+				ForEach: &ForEachSpec{Parallelism: &zero},
+			},
+			want: field.ErrorList{
+				field.Required(templatePath.Child("forEach", "items"), "items of forEach is required"),
+				field.Invalid(templatePath.Child("forEach", "parallelism"), 0, "parallelism should be greater than 0"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateIteration(templatePath, tt.template); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateIteration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_validateIterationTemplate(t *testing.T) {
+	templatesPath := field.NewPath("spec", "templates")
+	templates := []Template{
+		{Name: "retry", Type: TypeRetry, Retry: &RetrySpec{MaxAttempts: 3}, Children: []string{"check", "suspend"}},
+		{Name: "check", Type: TypeTask, Task: &Task{}, Loop: &LoopSpec{}},
+		{Name: "suspend", Type: TypeSuspend, Deadline: &[]string{"1m"}[0]},
+	}
+	got := validateTemplates(templatesPath, templates)
+	want := field.ErrorList{
+		field.Invalid(templatesPath.Index(0).Child("children"), []string{"check", "suspend"}, "template with type Retry should contain exactly one child"),
+		field.Invalid(templatesPath.Index(1), &LoopSpec{}, "this template should not contain Loop"),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("validateTemplates() = %v, want %v", got, want)
+	}
+}
+
+func Test_validateParameters(t *testing.T) {
+	parametersPath := field.NewPath("spec", "parameters")
+	value := "default"
+	tests := []struct {
+		name       string
+		parameters []WorkflowParameter
+		want       field.ErrorList
+	}{
+		{
+			name: "valid parameters",
+			parameters: []WorkflowParameter{
+				{Name: "namespace", Default: &value},
+				{Name: "latency_ms", Value: &value},
+			},
+			want: nil,
+		}, {
+			name: "invalid name and missing value",
+			parameters: []WorkflowParameter{
+				{Name: "target-namespace", Default: &value},
+				{Name: "latency"},
+				{Name: "latency", Value: &value},
+			},
+			want: field.ErrorList{
+				field.Invalid(parametersPath.Index(0).Child("name"), "target-namespace", "name of parameter must be a valid identifier, which matches ^[A-Za-z_][A-Za-z0-9_]*$"),
+				field.Required(parametersPath.Index(1).Child("value"), "the value of parameter latency is required, since it has no default value"),
+				field.Duplicate(parametersPath.Index(2).Child("name"), "latency"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := validateParameters(parametersPath, tt.parameters); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("validateParameters() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_renderParameters(t *testing.T) {
+	namespace := "app"
+	latency := "100ms"
+	deadline := "{{ parameters.latency }}"
+	parameters := []WorkflowParameter{
+		{Name: "namespace", Default: &namespace},
+		{Name: "latency", Default: &namespace, Value: &latency},
+	}
+	templates := []Template{
+		{
+			Name:     "network-delay",
+			Type:     TypeNetworkChaos,
+			Deadline: &deadline,
+			EmbedChaos: &EmbedChaos{
+				NetworkChaos: &NetworkChaosSpec{
+					PodSelector: PodSelector{
+						Selector: PodSelectorSpec{
+							GenericSelectorSpec: GenericSelectorSpec{
+								Namespaces: []string{"{{parameters.namespace}}", "{{ outputs.pick.namespace }}"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	rendered, err := renderParameters(templates, parameters)
+	if err != nil {
+		t.Fatalf("renderParameters() error = %v", err)
+	}
+	if got := *rendered[0].Deadline; got != latency {
+		t.Errorf("renderParameters() deadline = %v, want %v", got, latency)
+	}
+	want := []string{"app", "{{ outputs.pick.namespace }}"}
+	if got := rendered[0].EmbedChaos.NetworkChaos.Selector.Namespaces; !reflect.DeepEqual(got, want) {
+		t.Errorf("renderParameters() namespaces = %v, want %v", got, want)
+	}
+	if *templates[0].Deadline != "{{ parameters.latency }}" {
+		t.Errorf("renderParameters() should not modify the original templates")
+	}
+
+	errs := parametersMustBeDeclared(field.NewPath("spec", "templates"), templates, parameters[:1])
+	if len(errs) != 1 {
+		t.Errorf("parametersMustBeDeclared() = %v, want 1 error", errs)
+	}
+}
+
 func Test_shouldNotSetupDurationInTheChaos(t *testing.T) {
 	templatesPath := field.NewPath("spec", "templates")
 	duration20sString := "20s"
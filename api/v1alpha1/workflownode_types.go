@@ -68,6 +68,24 @@ type WorkflowNodeSpec struct {
 	// Only used when Type is TypeStatusCheck.
 	// +optional
 	AbortWithStatusCheck bool `json:"abortWithStatusCheck,omitempty"`
+	// +optional
+	Retry *RetrySpec `json:"retry,omitempty"`
+	// +optional
+	Loop *LoopSpec `json:"loop,omitempty"`
+	// +optional
+	ForEach *ForEachSpec `json:"forEach,omitempty"`
+	// Iteration records the index and the item of the iteration which instantiates this node, it's
+	// inherited by the descendant nodes.
+	// +optional
+	Iteration *Iteration `json:"iteration,omitempty"`
+}
+
+type Iteration struct {
+	// Index is the index of the iteration, starts from 0.
+	Index int `json:"index"`
+	// Item is the item of ForEach, encoded in JSON.
+	// +optional
+	Item string `json:"item,omitempty"`
 }
 
 type WorkflowNodeStatus struct {
@@ -80,6 +98,14 @@ type WorkflowNodeStatus struct {
 	// +optional
 	ConditionalBranchesStatus *ConditionalBranchesStatus `json:"conditionalBranchesStatus,omitempty"`
 
+	// Outputs records the outputs published by the node, each value is encoded in JSON.
+	// +optional
+	Outputs map[string]string `json:"outputs,omitempty"`
+
+	// NextIterationTime is the time to instantiate the next iteration of Retry or Loop node.
+	// +optional
+	NextIterationTime *metav1.Time `json:"nextIterationTime,omitempty"`
+
 	// ActiveChildren means the created children node
 	// +optional
 	ActiveChildren []corev1.LocalObjectReference `json:"activeChildren,omitempty"`
@@ -145,6 +171,8 @@ func init() {
 const (
 	EntryCreated                         string = "EntryCreated"
 	InvalidEntry                         string = "InvalidEntry"
+	WorkflowTemplateResolved             string = "WorkflowTemplateResolved"
+	WorkflowTemplateResolveFailed        string = "WorkflowTemplateResolveFailed"
 	WorkflowAccomplished                 string = "WorkflowAccomplished"
 	NodeAccomplished                     string = "NodeAccomplished"
 	NodesCreated                         string = "NodesCreated"
@@ -160,6 +188,7 @@ const (
 	TaskPodSpawned                       string = "TaskPodSpawned"
 	TaskPodSpawnFailed                   string = "TaskPodSpawnFailed"
 	TaskPodPodCompleted                  string = "TaskPodPodCompleted"
+	TaskOutputsInvalid                   string = "TaskOutputsInvalid"
 	ConditionalBranchesSelected          string = "ConditionalBranchesSelected"
 	RerunBySpecChanged                   string = "RerunBySpecChanged"
 	StatusCheckCreated                   string = "StatusCheckCreated"
@@ -169,6 +198,8 @@ const (
 	StatusCheckCompleted                 string = "StatusCheckCompleted"
 	StatusCheckNotExceedSuccessThreshold string = "StatusCheckNotExceedSuccessThreshold"
 	ParentNodeAborted                    string = "ParentNodeAborted"
+	IterationScheduled                   string = "IterationScheduled"
+	RetryExhausted                       string = "RetryExhausted"
 	WorkflowAborted                      string = "WorkflowAborted"
 )
 
@@ -0,0 +1,138 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KindWorkflowTemplate        = "WorkflowTemplate"
+	KindClusterWorkflowTemplate = "ClusterWorkflowTemplate"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=wftmpl
+
+// WorkflowTemplate is a reusable chaos scenario in a namespace, which could be referenced by the
+// workflows in the same namespace.
+type WorkflowTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the scenario of the template
+	Spec WorkflowTemplateSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowTemplateList contains a list of WorkflowTemplate
+type WorkflowTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowTemplate `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=cwftmpl
+
+// ClusterWorkflowTemplate is a reusable chaos scenario, which could be referenced by the workflows in
+// all namespaces.
+type ClusterWorkflowTemplate struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the scenario of the template
+	Spec WorkflowTemplateSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterWorkflowTemplateList contains a list of ClusterWorkflowTemplate
+type ClusterWorkflowTemplateList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterWorkflowTemplate `json:"items"`
+}
+
+// WorkflowTemplateSpec defines the entry, templates and parameters shared by the workflows
+// referencing the template.
+type WorkflowTemplateSpec struct {
+	// Description describes the scenario of the template.
+	// +optional
+	Description string     `json:"description,omitempty"`
+	Entry       string     `json:"entry"`
+	Templates   []Template `json:"templates"`
+	// Parameters describes the parameters of the template, they could be overridden by the
+	// parameters of the workflows referencing the template.
+	// +optional
+	Parameters []WorkflowParameter `json:"parameters,omitempty"`
+}
+
+// WorkflowTemplateRef refers to a WorkflowTemplate in the namespace of workflow, or a ClusterWorkflowTemplate.
+type WorkflowTemplateRef struct {
+	// Name is the name of the referenced template.
+	Name string `json:"name"`
+	// ClusterScope means the referenced template is a ClusterWorkflowTemplate.
+	// +optional
+	ClusterScope bool `json:"clusterScope,omitempty"`
+}
+
+// ApplyTemplate uses the entry, templates and parameters of the template in the workflow. The parameters
+// specified in the workflow override the ones of the template with the same names, and they should be
+// declared in the template.
+func (in *WorkflowSpec) ApplyTemplate(template WorkflowTemplateSpec) error {
+	resolved := WorkflowSpec{
+		Entry:               template.Entry,
+		Templates:           template.Templates,
+		Parameters:          template.Parameters,
+		WorkflowTemplateRef: in.WorkflowTemplateRef,
+	}
+	resolved = *resolved.DeepCopy()
+
+	for _, parameter := range in.Parameters {
+		found := false
+		for i := range resolved.Parameters {
+			if resolved.Parameters[i].Name != parameter.Name {
+				continue
+			}
+			found = true
+			if parameter.Value != nil {
+				resolved.Parameters[i].Value = parameter.Value
+			} else if parameter.Default != nil {
+				resolved.Parameters[i].Default = parameter.Default
+			}
+			break
+		}
+		if !found {
+			return errors.Errorf("parameter %s is not declared in template %s", parameter.Name, in.WorkflowTemplateRef.Name)
+		}
+	}
+
+	*in = resolved
+	return nil
+}
+
+// RenderTemplates returns the templates with the placeholders of parameters replaced by their values.
+func (in *WorkflowSpec) RenderTemplates() ([]Template, error) {
+	return renderParameters(in.Templates, in.Parameters)
+}
+
+func init() {
+	SchemeBuilder.Register(&WorkflowTemplate{}, &WorkflowTemplateList{})
+	SchemeBuilder.Register(&ClusterWorkflowTemplate{}, &ClusterWorkflowTemplateList{})
+}
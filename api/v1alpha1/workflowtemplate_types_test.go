@@ -0,0 +1,94 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWorkflowSpec_ApplyTemplate(t *testing.T) {
+	defaultLatency := "100ms"
+	latency := "200ms"
+	namespace := "app"
+	template := WorkflowTemplateSpec{
+		Description: "network delay on the app",
+		Entry:       "entry",
+		Templates: []Template{
+			{Name: "entry", Type: TypeSerial, Children: []string{"network-delay"}},
+		},
+		Parameters: []WorkflowParameter{
+			{Name: "namespace"},
+			{Name: "latency", Default: &defaultLatency},
+		},
+	}
+	ref := &WorkflowTemplateRef{Name: "network-delay"}
+
+	tests := []struct {
+		name    string
+		spec    WorkflowSpec
+		want    WorkflowSpec
+		wantErr bool
+	}{
+		{
+			name: "parameters are overridden",
+			spec: WorkflowSpec{
+				WorkflowTemplateRef: ref,
+				Parameters: []WorkflowParameter{
+					{Name: "namespace", Value: &namespace},
+					{Name: "latency", Default: &latency},
+				},
+			},
+			want: WorkflowSpec{
+				Entry:               "entry",
+				Templates:           template.Templates,
+				WorkflowTemplateRef: ref,
+				Parameters: []WorkflowParameter{
+					{Name: "namespace", Value: &namespace},
+					{Name: "latency", Default: &latency},
+				},
+			},
+		}, {
+			name: "undeclared parameter",
+			spec: WorkflowSpec{
+				WorkflowTemplateRef: ref,
+				Parameters: []WorkflowParameter{
+					{Name: "duration", Value: &latency},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := tt.spec
+			err := spec.ApplyTemplate(template)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ApplyTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(spec, tt.want) {
+				t.Errorf("ApplyTemplate() = %+v, want %+v", spec, tt.want)
+			}
+		})
+	}
+
+	if template.Parameters[1].Default != &defaultLatency {
+		t.Errorf("ApplyTemplate() should not modify the template")
+	}
+}
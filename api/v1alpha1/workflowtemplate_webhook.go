@@ -0,0 +1,82 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gw "github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
+)
+
+var _ webhook.Validator = &WorkflowTemplate{}
+
+func (in *WorkflowTemplate) ValidateCreate() (admission.Warnings, error) {
+	return nil, in.Spec.validate(field.NewPath("spec"))
+}
+
+func (in *WorkflowTemplate) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	return in.ValidateCreate()
+}
+
+func (in *WorkflowTemplate) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (in *WorkflowTemplate) Default() {
+	gw.Default(in)
+}
+
+var _ webhook.Validator = &ClusterWorkflowTemplate{}
+
+func (in *ClusterWorkflowTemplate) ValidateCreate() (admission.Warnings, error) {
+	return nil, in.Spec.validate(field.NewPath("spec"))
+}
+
+func (in *ClusterWorkflowTemplate) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	return in.ValidateCreate()
+}
+
+func (in *ClusterWorkflowTemplate) ValidateDelete() (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (in *ClusterWorkflowTemplate) Default() {
+	gw.Default(in)
+}
+
+// validate validates the template like a workflow, the templates are validated after the parameters
+// are rendered with their default values. Unlike workflow, a parameter without default value is allowed,
+// its value should be specified by the workflows referencing the template.
+func (in *WorkflowTemplateSpec) validate(specPath *field.Path) error {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, entryMustExists(specPath.Child("entry"), in.Entry, in.Templates)...)
+	allErrs = append(allErrs, validateParameterNames(specPath.Child("parameters"), in.Parameters)...)
+	allErrs = append(allErrs, parametersMustBeDeclared(specPath.Child("templates"), in.Templates, in.Parameters)...)
+	templates, err := renderParameters(in.Templates, in.Parameters)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(specPath.Child("templates"), err))
+		templates = in.Templates
+	}
+	allErrs = append(allErrs, validateTemplates(specPath.Child("templates"), templates)...)
+	if len(allErrs) > 0 {
+		return errors.New(allErrs.ToAggregate().Error())
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestWorkflowTemplateSpec_validate(t *testing.T) {
+	deadline := "{{ parameters.duration }}"
+	duration := "30s"
+	tests := []struct {
+		name    string
+		spec    WorkflowTemplateSpec
+		wantErr bool
+	}{
+		{
+			name: "parameter without default value",
+			spec: WorkflowTemplateSpec{
+				Entry: "entry",
+				Templates: []Template{
+					{Name: "entry", Type: TypeSuspend, Deadline: &deadline},
+				},
+				Parameters: []WorkflowParameter{
+					{Name: "duration", Default: &duration},
+					{Name: "namespace"},
+				},
+			},
+			wantErr: false,
+		}, {
+			name: "missing entry",
+			spec: WorkflowTemplateSpec{
+				Entry: "entry",
+				Templates: []Template{
+					{Name: "suspend", Type: TypeSuspend, Deadline: &duration},
+				},
+			},
+			wantErr: true,
+		}, {
+			name: "undeclared parameter",
+			spec: WorkflowTemplateSpec{
+				Entry: "entry",
+				Templates: []Template{
+					{Name: "entry", Type: TypeSuspend, Deadline: &deadline},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.spec.validate(field.NewPath("spec")); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -77,6 +77,21 @@ func (in *AWSChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *AWSChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *AWSChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *AWSChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *AWSChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -168,6 +183,7 @@ var _ webhook.Validator = &AWSChaos{}
 
 func (in *AWSChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -219,6 +235,21 @@ func (in *AzureChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *AzureChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *AzureChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *AzureChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *AzureChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -310,6 +341,7 @@ var _ webhook.Validator = &AzureChaos{}
 
 func (in *AzureChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -361,6 +393,21 @@ func (in *BlockChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *BlockChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *BlockChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *BlockChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *BlockChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -448,6 +495,7 @@ var _ webhook.Validator = &BlockChaos{}
 
 func (in *BlockChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -457,6 +505,196 @@ func (in *BlockChaos) Default() {
 	gw.Default(in)
 }
 
+const KindChaosPolicy = "ChaosPolicy"
+
+var ChaosPolicyWebhookLog = logf.Log.WithName("ChaosPolicy-resource")
+
+func (in *ChaosPolicy) ValidateCreate() (admission.Warnings, error) {
+	ChaosPolicyWebhookLog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosPolicy) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	ChaosPolicyWebhookLog.Info("validate update", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *ChaosPolicy) ValidateDelete() (admission.Warnings, error) {
+	ChaosPolicyWebhookLog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil, nil
+}
+
+var _ webhook.Validator = &ChaosPolicy{}
+
+func (in *ChaosPolicy) Validate() ([]string, error) {
+	errs := gw.Validate(in)
+	return nil, gw.Aggregate(errs)
+}
+
+var _ webhook.Defaulter = &ChaosPolicy{}
+
+func (in *ChaosPolicy) Default() {
+	gw.Default(in)
+}
+
+const KindCompositeChaos = "CompositeChaos"
+
+// IsDeleted returns whether this resource has been deleted
+func (in *CompositeChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *CompositeChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetObjectMeta would return the ObjectMeta for chaos
+func (in *CompositeChaos) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// GetDuration would return the duration for chaos
+func (in *CompositeChaosSpec) GetDuration() (*time.Duration, error) {
+	if in.Duration == nil {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(string(*in.Duration))
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+// GetStatus returns the status
+func (in *CompositeChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// GetRemoteCluster returns the remoteCluster
+func (in *CompositeChaos) GetRemoteCluster() string {
+	return in.Spec.RemoteCluster
+}
+
+// GetRemoteClusters returns the remoteClusters
+func (in *CompositeChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *CompositeChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *CompositeChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
+// GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
+func (in *CompositeChaos) GetSpecAndMetaString() (string, error) {
+	spec, err := json.Marshal(in.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	meta := in.ObjectMeta.DeepCopy()
+	meta.SetResourceVersion("")
+	meta.SetGeneration(0)
+
+	return string(spec) + meta.String(), nil
+}
+
+// +kubebuilder:object:root=true
+
+// CompositeChaosList contains a list of CompositeChaos
+type CompositeChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompositeChaos `json:"items"`
+}
+
+func (in *CompositeChaosList) DeepCopyList() GenericChaosList {
+	return in.DeepCopy()
+}
+
+// ListChaos returns a list of chaos
+func (in *CompositeChaosList) ListChaos() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
+
+func (in *CompositeChaos) DurationExceeded(now time.Time) (bool, time.Duration, error) {
+	duration, err := in.Spec.GetDuration()
+	if err != nil {
+		return false, 0, err
+	}
+
+	if duration != nil {
+		stopTime := in.GetCreationTimestamp().Add(*duration)
+		if stopTime.Before(now) {
+			return true, 0, nil
+		}
+
+		return false, stopTime.Sub(now), nil
+	}
+
+	return false, 0, nil
+}
+
+func (in *CompositeChaos) IsOneShot() bool {
+	return false
+}
+
+var CompositeChaosWebhookLog = logf.Log.WithName("CompositeChaos-resource")
+
+func (in *CompositeChaos) ValidateCreate() (admission.Warnings, error) {
+	CompositeChaosWebhookLog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *CompositeChaos) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	CompositeChaosWebhookLog.Info("validate update", "name", in.Name)
+	if !reflect.DeepEqual(in.Spec, old.(*CompositeChaos).Spec) {
+		return nil, ErrCanNotUpdateChaos
+	}
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *CompositeChaos) ValidateDelete() (admission.Warnings, error) {
+	CompositeChaosWebhookLog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil, nil
+}
+
+var _ webhook.Validator = &CompositeChaos{}
+
+func (in *CompositeChaos) Validate() ([]string, error) {
+	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
+	return nil, gw.Aggregate(errs)
+}
+
+var _ webhook.Defaulter = &CompositeChaos{}
+
+func (in *CompositeChaos) Default() {
+	gw.Default(in)
+}
+
 const KindDNSChaos = "DNSChaos"
 
 // IsDeleted returns whether this resource has been deleted
@@ -499,6 +737,21 @@ func (in *DNSChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *DNSChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *DNSChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *DNSChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *DNSChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -586,6 +839,7 @@ var _ webhook.Validator = &DNSChaos{}
 
 func (in *DNSChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -637,6 +891,21 @@ func (in *GCPChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *GCPChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *GCPChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *GCPChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *GCPChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -728,6 +997,7 @@ var _ webhook.Validator = &GCPChaos{}
 
 func (in *GCPChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -779,6 +1049,21 @@ func (in *HTTPChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *HTTPChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *HTTPChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *HTTPChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *HTTPChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -866,6 +1151,7 @@ var _ webhook.Validator = &HTTPChaos{}
 
 func (in *HTTPChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -917,6 +1203,21 @@ func (in *IOChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *IOChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *IOChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *IOChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *IOChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -1004,6 +1305,7 @@ var _ webhook.Validator = &IOChaos{}
 
 func (in *IOChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -1055,6 +1357,21 @@ func (in *JVMChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *JVMChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *JVMChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *JVMChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *JVMChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -1142,6 +1459,7 @@ var _ webhook.Validator = &JVMChaos{}
 
 func (in *JVMChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -1193,6 +1511,21 @@ func (in *KernelChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *KernelChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *KernelChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *KernelChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *KernelChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -1280,6 +1613,7 @@ var _ webhook.Validator = &KernelChaos{}
 
 func (in *KernelChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -1331,6 +1665,21 @@ func (in *NetworkChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *NetworkChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *NetworkChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *NetworkChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *NetworkChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -1418,6 +1767,7 @@ var _ webhook.Validator = &NetworkChaos{}
 
 func (in *NetworkChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -1469,6 +1819,21 @@ func (in *PhysicalMachineChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *PhysicalMachineChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *PhysicalMachineChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *PhysicalMachineChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *PhysicalMachineChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -1556,6 +1921,7 @@ var _ webhook.Validator = &PhysicalMachineChaos{}
 
 func (in *PhysicalMachineChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -1646,6 +2012,21 @@ func (in *PodChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *PodChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *PodChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *PodChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *PodChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -1737,6 +2118,7 @@ var _ webhook.Validator = &PodChaos{}
 
 func (in *PodChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -1746,6 +2128,160 @@ func (in *PodChaos) Default() {
 	gw.Default(in)
 }
 
+const KindPodFaultChaos = "PodFaultChaos"
+
+// IsDeleted returns whether this resource has been deleted
+func (in *PodFaultChaos) IsDeleted() bool {
+	return !in.DeletionTimestamp.IsZero()
+}
+
+// IsPaused returns whether this resource has been paused
+func (in *PodFaultChaos) IsPaused() bool {
+	if in.Annotations == nil || in.Annotations[PauseAnnotationKey] != "true" {
+		return false
+	}
+	return true
+}
+
+// GetObjectMeta would return the ObjectMeta for chaos
+func (in *PodFaultChaos) GetObjectMeta() *metav1.ObjectMeta {
+	return &in.ObjectMeta
+}
+
+// GetDuration would return the duration for chaos
+func (in *PodFaultChaosSpec) GetDuration() (*time.Duration, error) {
+	if in.Duration == nil {
+		return nil, nil
+	}
+	duration, err := time.ParseDuration(string(*in.Duration))
+	if err != nil {
+		return nil, err
+	}
+	return &duration, nil
+}
+
+// GetStatus returns the status
+func (in *PodFaultChaos) GetStatus() *ChaosStatus {
+	return &in.Status.ChaosStatus
+}
+
+// GetRemoteCluster returns the remoteCluster
+func (in *PodFaultChaos) GetRemoteCluster() string {
+	return in.Spec.RemoteCluster
+}
+
+// GetRemoteClusters returns the remoteClusters
+func (in *PodFaultChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *PodFaultChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *PodFaultChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
+// GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
+func (in *PodFaultChaos) GetSpecAndMetaString() (string, error) {
+	spec, err := json.Marshal(in.Spec)
+	if err != nil {
+		return "", err
+	}
+
+	meta := in.ObjectMeta.DeepCopy()
+	meta.SetResourceVersion("")
+	meta.SetGeneration(0)
+
+	return string(spec) + meta.String(), nil
+}
+
+// +kubebuilder:object:root=true
+
+// PodFaultChaosList contains a list of PodFaultChaos
+type PodFaultChaosList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PodFaultChaos `json:"items"`
+}
+
+func (in *PodFaultChaosList) DeepCopyList() GenericChaosList {
+	return in.DeepCopy()
+}
+
+// ListChaos returns a list of chaos
+func (in *PodFaultChaosList) ListChaos() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
+
+func (in *PodFaultChaos) DurationExceeded(now time.Time) (bool, time.Duration, error) {
+	duration, err := in.Spec.GetDuration()
+	if err != nil {
+		return false, 0, err
+	}
+
+	if duration != nil {
+		stopTime := in.GetCreationTimestamp().Add(*duration)
+		if stopTime.Before(now) {
+			return true, 0, nil
+		}
+
+		return false, stopTime.Sub(now), nil
+	}
+
+	return false, 0, nil
+}
+
+func (in *PodFaultChaos) IsOneShot() bool {
+	return false
+}
+
+var PodFaultChaosWebhookLog = logf.Log.WithName("PodFaultChaos-resource")
+
+func (in *PodFaultChaos) ValidateCreate() (admission.Warnings, error) {
+	PodFaultChaosWebhookLog.Info("validate create", "name", in.Name)
+	return in.Validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (in *PodFaultChaos) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+	PodFaultChaosWebhookLog.Info("validate update", "name", in.Name)
+	if !reflect.DeepEqual(in.Spec, old.(*PodFaultChaos).Spec) {
+		return nil, ErrCanNotUpdateChaos
+	}
+	return in.Validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (in *PodFaultChaos) ValidateDelete() (admission.Warnings, error) {
+	PodFaultChaosWebhookLog.Info("validate delete", "name", in.Name)
+
+	// Nothing to do?
+	return nil, nil
+}
+
+var _ webhook.Validator = &PodFaultChaos{}
+
+func (in *PodFaultChaos) Validate() ([]string, error) {
+	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
+	return nil, gw.Aggregate(errs)
+}
+
+var _ webhook.Defaulter = &PodFaultChaos{}
+
+func (in *PodFaultChaos) Default() {
+	gw.Default(in)
+}
+
 const KindPodHttpChaos = "PodHttpChaos"
 
 var PodHttpChaosWebhookLog = logf.Log.WithName("PodHttpChaos-resource")
@@ -1974,6 +2510,21 @@ func (in *StressChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *StressChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *StressChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *StressChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *StressChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -2061,6 +2612,7 @@ var _ webhook.Validator = &StressChaos{}
 
 func (in *StressChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -2112,6 +2664,21 @@ func (in *TimeChaos) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *TimeChaos) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *TimeChaos) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *TimeChaos) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *TimeChaos) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -2199,6 +2766,7 @@ var _ webhook.Validator = &TimeChaos{}
 
 func (in *TimeChaos) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	errs = append(errs, validateRemoteClusters(in)...)
 	return nil, gw.Aggregate(errs)
 }
 
@@ -2228,6 +2796,14 @@ func init() {
 		list:  &BlockChaosList{},
 	})
 
+	SchemeBuilder.Register(&ChaosPolicy{}, &ChaosPolicyList{})
+
+	SchemeBuilder.Register(&CompositeChaos{}, &CompositeChaosList{})
+	all.register(KindCompositeChaos, &ChaosKind{
+		chaos: &CompositeChaos{},
+		list:  &CompositeChaosList{},
+	})
+
 	SchemeBuilder.Register(&DNSChaos{}, &DNSChaosList{})
 	all.register(KindDNSChaos, &ChaosKind{
 		chaos: &DNSChaos{},
@@ -2284,6 +2860,12 @@ func init() {
 		list:  &PodChaosList{},
 	})
 
+	SchemeBuilder.Register(&PodFaultChaos{}, &PodFaultChaosList{})
+	all.register(KindPodFaultChaos, &ChaosKind{
+		chaos: &PodFaultChaos{},
+		list:  &PodFaultChaosList{},
+	})
+
 	SchemeBuilder.Register(&PodHttpChaos{}, &PodHttpChaosList{})
 
 	SchemeBuilder.Register(&PodIOChaos{}, &PodIOChaosList{})
@@ -2322,6 +2904,11 @@ func init() {
 		list:  &BlockChaosList{},
 	})
 
+	allScheduleItem.register(KindCompositeChaos, &ChaosKind{
+		chaos: &CompositeChaos{},
+		list:  &CompositeChaosList{},
+	})
+
 	allScheduleItem.register(KindDNSChaos, &ChaosKind{
 		chaos: &DNSChaos{},
 		list:  &DNSChaosList{},
@@ -2367,6 +2954,11 @@ func init() {
 		list:  &PodChaosList{},
 	})
 
+	allScheduleItem.register(KindPodFaultChaos, &ChaosKind{
+		chaos: &PodFaultChaos{},
+		list:  &PodFaultChaosList{},
+	})
+
 	allScheduleItem.register(KindStressChaos, &ChaosKind{
 		chaos: &StressChaos{},
 		list:  &StressChaosList{},
@@ -21,7 +21,8 @@ package v1alpha1
 
 import (
 	"encoding/json"
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"net/http"
 )
@@ -93,12 +94,27 @@ func (in *AWSChaosSpec) DeepCopyInto(out *AWSChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
 	if in.SecretName != nil {
 		in, out := &in.SecretName, &out.SecretName
 		*out = new(string)
 		**out = **in
 	}
 	in.AWSSelector.DeepCopyInto(&out.AWSSelector)
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSChaosSpec.
@@ -299,6 +315,11 @@ func (in *AzureChaosSpec) DeepCopyInto(out *AzureChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
 	in.AzureSelector.DeepCopyInto(&out.AzureSelector)
 }
 
@@ -346,6 +367,16 @@ func (in *AzureSelector) DeepCopyInto(out *AzureSelector) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSelector.
@@ -383,6 +414,21 @@ func (in *BandwidthSpec) DeepCopy() *BandwidthSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutWindow) DeepCopyInto(out *BlackoutWindow) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutWindow.
+func (in *BlackoutWindow) DeepCopy() *BlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockChaos) DeepCopyInto(out *BlockChaos) {
 	*out = *in
@@ -456,6 +502,21 @@ func (in *BlockChaosSpec) DeepCopyInto(out *BlockChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlockChaosSpec.
@@ -532,6 +593,46 @@ func (in *CPUStressor) DeepCopy() *CPUStressor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CgroupThrottle) DeepCopyInto(out *CgroupThrottle) {
+	*out = *in
+	if in.IO != nil {
+		in, out := &in.IO, &out.IO
+		*out = make([]IOThrottle, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CgroupThrottle.
+func (in *CgroupThrottle) DeepCopy() *CgroupThrottle {
+	if in == nil {
+		return nil
+	}
+	out := new(CgroupThrottle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CgroupThrottleValues) DeepCopyInto(out *CgroupThrottleValues) {
+	*out = *in
+	if in.IOMax != nil {
+		in, out := &in.IOMax, &out.IOMax
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CgroupThrottleValues.
+func (in *CgroupThrottleValues) DeepCopy() *CgroupThrottleValues {
+	if in == nil {
+		return nil
+	}
+	out := new(CgroupThrottleValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChaosCondition) DeepCopyInto(out *ChaosCondition) {
 	*out = *in
@@ -569,184 +670,274 @@ func (in *ChaosOnlyScheduleSpec) DeepCopy() *ChaosOnlyScheduleSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ChaosStatus) DeepCopyInto(out *ChaosStatus) {
+func (in *ChaosPolicy) DeepCopyInto(out *ChaosPolicy) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]ChaosCondition, len(*in))
-		copy(*out, *in)
-	}
-	in.Experiment.DeepCopyInto(&out.Experiment)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosStatus.
-func (in *ChaosStatus) DeepCopy() *ChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicy.
+func (in *ChaosPolicy) DeepCopy() *ChaosPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ChaosStatus)
+	out := new(ChaosPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CidrAndPort) DeepCopyInto(out *CidrAndPort) {
+func (in *ChaosPolicyList) DeepCopyInto(out *ChaosPolicyList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ChaosPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CidrAndPort.
-func (in *CidrAndPort) DeepCopy() *CidrAndPort {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicyList.
+func (in *ChaosPolicyList) DeepCopy() *ChaosPolicyList {
 	if in == nil {
 		return nil
 	}
-	out := new(CidrAndPort)
+	out := new(ChaosPolicyList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ChaosPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in ClockIds) DeepCopyInto(out *ClockIds) {
-	{
-		in := &in
-		*out = make(ClockIds, len(*in))
+func (in *ChaosPolicyRule) DeepCopyInto(out *ChaosPolicyRule) {
+	*out = *in
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockIds.
-func (in ClockIds) DeepCopy() ClockIds {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicyRule.
+func (in *ChaosPolicyRule) DeepCopy() *ChaosPolicyRule {
 	if in == nil {
 		return nil
 	}
-	out := new(ClockIds)
+	out := new(ChaosPolicyRule)
 	in.DeepCopyInto(out)
-	return *out
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClockSpec) DeepCopyInto(out *ClockSpec) {
+func (in *ChaosPolicySpec) DeepCopyInto(out *ChaosPolicySpec) {
 	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelectors != nil {
+		in, out := &in.NamespaceSelectors, &out.NamespaceSelectors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]ChaosPolicyRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MaxConcurrent != nil {
+		in, out := &in.MaxConcurrent, &out.MaxConcurrent
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxDuration != nil {
+		in, out := &in.MaxDuration, &out.MaxDuration
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxPodsPercent != nil {
+		in, out := &in.MaxPodsPercent, &out.MaxPodsPercent
+		*out = new(int)
+		**out = **in
+	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]BlackoutWindow, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockSpec.
-func (in *ClockSpec) DeepCopy() *ClockSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosPolicySpec.
+func (in *ChaosPolicySpec) DeepCopy() *ChaosPolicySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ClockSpec)
+	out := new(ChaosPolicySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConditionalBranch) DeepCopyInto(out *ConditionalBranch) {
+func (in *ChaosStatus) DeepCopyInto(out *ChaosStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ChaosCondition, len(*in))
+		copy(*out, *in)
+	}
+	in.Experiment.DeepCopyInto(&out.Experiment)
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]RemoteClusterChaosStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionalBranch.
-func (in *ConditionalBranch) DeepCopy() *ConditionalBranch {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChaosStatus.
+func (in *ChaosStatus) DeepCopy() *ChaosStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ConditionalBranch)
+	out := new(ChaosStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConditionalBranchStatus) DeepCopyInto(out *ConditionalBranchStatus) {
+func (in *CidrAndPort) DeepCopyInto(out *CidrAndPort) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionalBranchStatus.
-func (in *ConditionalBranchStatus) DeepCopy() *ConditionalBranchStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CidrAndPort.
+func (in *CidrAndPort) DeepCopy() *CidrAndPort {
 	if in == nil {
 		return nil
 	}
-	out := new(ConditionalBranchStatus)
+	out := new(CidrAndPort)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConditionalBranchesStatus) DeepCopyInto(out *ConditionalBranchesStatus) {
-	*out = *in
-	if in.Branches != nil {
-		in, out := &in.Branches, &out.Branches
-		*out = make([]ConditionalBranchStatus, len(*in))
-		copy(*out, *in)
-	}
-	if in.Context != nil {
-		in, out := &in.Context, &out.Context
-		*out = make([]string, len(*in))
+func (in ClockIds) DeepCopyInto(out *ClockIds) {
+	{
+		in := &in
+		*out = make(ClockIds, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionalBranchesStatus.
-func (in *ConditionalBranchesStatus) DeepCopy() *ConditionalBranchesStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockIds.
+func (in ClockIds) DeepCopy() ClockIds {
 	if in == nil {
 		return nil
 	}
-	out := new(ConditionalBranchesStatus)
+	out := new(ClockIds)
 	in.DeepCopyInto(out)
-	return out
+	return *out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerNodeVolumePathSelector) DeepCopyInto(out *ContainerNodeVolumePathSelector) {
+func (in *ClockSpec) DeepCopyInto(out *ClockSpec) {
 	*out = *in
-	in.ContainerSelector.DeepCopyInto(&out.ContainerSelector)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerNodeVolumePathSelector.
-func (in *ContainerNodeVolumePathSelector) DeepCopy() *ContainerNodeVolumePathSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClockSpec.
+func (in *ClockSpec) DeepCopy() *ClockSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerNodeVolumePathSelector)
+	out := new(ClockSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ContainerSelector) DeepCopyInto(out *ContainerSelector) {
+func (in *ClusterWorkflowTemplate) DeepCopyInto(out *ClusterWorkflowTemplate) {
 	*out = *in
-	in.PodSelector.DeepCopyInto(&out.PodSelector)
-	if in.ContainerNames != nil {
-		in, out := &in.ContainerNames, &out.ContainerNames
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSelector.
-func (in *ContainerSelector) DeepCopy() *ContainerSelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowTemplate.
+func (in *ClusterWorkflowTemplate) DeepCopy() *ClusterWorkflowTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(ContainerSelector)
+	out := new(ClusterWorkflowTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkflowTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *CorruptSpec) DeepCopyInto(out *CorruptSpec) {
+func (in *ClusterWorkflowTemplateList) DeepCopyInto(out *ClusterWorkflowTemplateList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterWorkflowTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CorruptSpec.
-func (in *CorruptSpec) DeepCopy() *CorruptSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterWorkflowTemplateList.
+func (in *ClusterWorkflowTemplateList) DeepCopy() *ClusterWorkflowTemplateList {
 	if in == nil {
 		return nil
 	}
-	out := new(CorruptSpec)
+	out := new(ClusterWorkflowTemplateList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterWorkflowTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSChaos) DeepCopyInto(out *DNSChaos) {
+func (in *CompositeChaos) DeepCopyInto(out *CompositeChaos) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -754,18 +945,18 @@ func (in *DNSChaos) DeepCopyInto(out *DNSChaos) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaos.
-func (in *DNSChaos) DeepCopy() *DNSChaos {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeChaos.
+func (in *CompositeChaos) DeepCopy() *CompositeChaos {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSChaos)
+	out := new(CompositeChaos)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DNSChaos) DeepCopyObject() runtime.Object {
+func (in *CompositeChaos) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -773,31 +964,31 @@ func (in *DNSChaos) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSChaosList) DeepCopyInto(out *DNSChaosList) {
+func (in *CompositeChaosList) DeepCopyInto(out *CompositeChaosList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DNSChaos, len(*in))
+		*out = make([]CompositeChaos, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosList.
-func (in *DNSChaosList) DeepCopy() *DNSChaosList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeChaosList.
+func (in *CompositeChaosList) DeepCopy() *CompositeChaosList {
 	if in == nil {
 		return nil
 	}
-	out := new(DNSChaosList)
+	out := new(CompositeChaosList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DNSChaosList) DeepCopyObject() runtime.Object {
+func (in *CompositeChaosList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -805,19 +996,434 @@ func (in *DNSChaosList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DNSChaosSpec) DeepCopyInto(out *DNSChaosSpec) {
+func (in *CompositeChaosSpec) DeepCopyInto(out *CompositeChaosSpec) {
 	*out = *in
 	in.ContainerSelector.DeepCopyInto(&out.ContainerSelector)
+	if in.Faults != nil {
+		in, out := &in.Faults, &out.Faults
+		*out = make([]CompositeFault, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeChaosSpec.
+func (in *CompositeChaosSpec) DeepCopy() *CompositeChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeChaosStatus) DeepCopyInto(out *CompositeChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]CompositeInstance, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeChaosStatus.
+func (in *CompositeChaosStatus) DeepCopy() *CompositeChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeFault) DeepCopyInto(out *CompositeFault) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(CompositeNetworkFault)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Stress != nil {
+		in, out := &in.Stress, &out.Stress
+		*out = new(CompositeStressFault)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IO != nil {
+		in, out := &in.IO, &out.IO
+		*out = new(CompositeIOFault)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = new(CompositeTimeFault)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeFault.
+func (in *CompositeFault) DeepCopy() *CompositeFault {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeFault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeFaultInstance) DeepCopyInto(out *CompositeFaultInstance) {
+	*out = *in
+	if in.Stress != nil {
+		in, out := &in.Stress, &out.Stress
+		*out = new(StressInstance)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeFaultInstance.
+func (in *CompositeFaultInstance) DeepCopy() *CompositeFaultInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeFaultInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeIOFault) DeepCopyInto(out *CompositeIOFault) {
+	*out = *in
+	if in.Attr != nil {
+		in, out := &in.Attr, &out.Attr
+		*out = new(AttrOverrideSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mistake != nil {
+		in, out := &in.Mistake, &out.Mistake
+		*out = new(MistakeSpec)
+		**out = **in
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]IoMethod, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeIOFault.
+func (in *CompositeIOFault) DeepCopy() *CompositeIOFault {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeIOFault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeInstance) DeepCopyInto(out *CompositeInstance) {
+	*out = *in
+	if in.Faults != nil {
+		in, out := &in.Faults, &out.Faults
+		*out = make([]CompositeFaultInstance, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeInstance.
+func (in *CompositeInstance) DeepCopy() *CompositeInstance {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeInstance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeNetworkFault) DeepCopyInto(out *CompositeNetworkFault) {
+	*out = *in
+	in.TcParameter.DeepCopyInto(&out.TcParameter)
+	if in.ExternalTargets != nil {
+		in, out := &in.ExternalTargets, &out.ExternalTargets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeNetworkFault.
+func (in *CompositeNetworkFault) DeepCopy() *CompositeNetworkFault {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeNetworkFault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeStressFault) DeepCopyInto(out *CompositeStressFault) {
+	*out = *in
+	if in.Stressors != nil {
+		in, out := &in.Stressors, &out.Stressors
+		*out = new(Stressors)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeStressFault.
+func (in *CompositeStressFault) DeepCopy() *CompositeStressFault {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeStressFault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompositeTimeFault) DeepCopyInto(out *CompositeTimeFault) {
+	*out = *in
+	if in.ClockIds != nil {
+		in, out := &in.ClockIds, &out.ClockIds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompositeTimeFault.
+func (in *CompositeTimeFault) DeepCopy() *CompositeTimeFault {
+	if in == nil {
+		return nil
+	}
+	out := new(CompositeTimeFault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionalBranch) DeepCopyInto(out *ConditionalBranch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionalBranch.
+func (in *ConditionalBranch) DeepCopy() *ConditionalBranch {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionalBranch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionalBranchStatus) DeepCopyInto(out *ConditionalBranchStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionalBranchStatus.
+func (in *ConditionalBranchStatus) DeepCopy() *ConditionalBranchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionalBranchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionalBranchesStatus) DeepCopyInto(out *ConditionalBranchesStatus) {
+	*out = *in
+	if in.Branches != nil {
+		in, out := &in.Branches, &out.Branches
+		*out = make([]ConditionalBranchStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Context != nil {
+		in, out := &in.Context, &out.Context
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionalBranchesStatus.
+func (in *ConditionalBranchesStatus) DeepCopy() *ConditionalBranchesStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionalBranchesStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerNodeVolumePathSelector) DeepCopyInto(out *ContainerNodeVolumePathSelector) {
+	*out = *in
+	in.ContainerSelector.DeepCopyInto(&out.ContainerSelector)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerNodeVolumePathSelector.
+func (in *ContainerNodeVolumePathSelector) DeepCopy() *ContainerNodeVolumePathSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerNodeVolumePathSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerSelector) DeepCopyInto(out *ContainerSelector) {
+	*out = *in
+	in.PodSelector.DeepCopyInto(&out.PodSelector)
+	if in.ContainerNames != nil {
+		in, out := &in.ContainerNames, &out.ContainerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerSelector.
+func (in *ContainerSelector) DeepCopy() *ContainerSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CorruptSpec) DeepCopyInto(out *CorruptSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CorruptSpec.
+func (in *CorruptSpec) DeepCopy() *CorruptSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CorruptSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSChaos) DeepCopyInto(out *DNSChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaos.
+func (in *DNSChaos) DeepCopy() *DNSChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSChaosList) DeepCopyInto(out *DNSChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DNSChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosList.
+func (in *DNSChaosList) DeepCopy() *DNSChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DNSChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSChaosSpec) DeepCopyInto(out *DNSChaosSpec) {
+	*out = *in
+	in.ContainerSelector.DeepCopyInto(&out.ContainerSelector)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
 	if in.DomainNamePatterns != nil {
 		in, out := &in.DomainNamePatterns, &out.DomainNamePatterns
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSChaosSpec.
@@ -946,6 +1552,11 @@ func (in *EmbedChaos) DeepCopyInto(out *EmbedChaos) {
 		*out = new(BlockChaosSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CompositeChaos != nil {
+		in, out := &in.CompositeChaos, &out.CompositeChaos
+		*out = new(CompositeChaosSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DNSChaos != nil {
 		in, out := &in.DNSChaos, &out.DNSChaos
 		*out = new(DNSChaosSpec)
@@ -991,6 +1602,11 @@ func (in *EmbedChaos) DeepCopyInto(out *EmbedChaos) {
 		*out = new(PodChaosSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PodFaultChaos != nil {
+		in, out := &in.PodFaultChaos, &out.PodFaultChaos
+		*out = new(PodFaultChaosSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.StressChaos != nil {
 		in, out := &in.StressChaos, &out.StressChaos
 		*out = new(StressChaosSpec)
@@ -1277,6 +1893,22 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 			}
 		}
 	}
+	if in.Rollouts != nil {
+		in, out := &in.Rollouts, &out.Rollouts
+		*out = make([]RolloutStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastSelectedTime != nil {
+		in, out := &in.LastSelectedTime, &out.LastSelectedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentStatus.
@@ -1424,6 +2056,26 @@ func (in *Filter) DeepCopy() *Filter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ForEachSpec) DeepCopyInto(out *ForEachSpec) {
+	*out = *in
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ForEachSpec.
+func (in *ForEachSpec) DeepCopy() *ForEachSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ForEachSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Frame) DeepCopyInto(out *Frame) {
 	*out = *in
@@ -1506,12 +2158,27 @@ func (in *GCPChaosSpec) DeepCopyInto(out *GCPChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
 	if in.SecretName != nil {
 		in, out := &in.SecretName, &out.SecretName
 		*out = new(string)
 		**out = **in
 	}
 	in.GCPSelector.DeepCopyInto(&out.GCPSelector)
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCPChaosSpec.
@@ -1712,6 +2379,11 @@ func (in *HTTPChaosSpec) DeepCopyInto(out *HTTPChaosSpec) {
 	*out = *in
 	in.PodSelector.DeepCopyInto(&out.PodSelector)
 	in.PodHttpChaosActions.DeepCopyInto(&out.PodHttpChaosActions)
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
 	if in.Path != nil {
 		in, out := &in.Path, &out.Path
 		*out = new(string)
@@ -1741,6 +2413,21 @@ func (in *HTTPChaosSpec) DeepCopyInto(out *HTTPChaosSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Times != nil {
+		in, out := &in.Times, &out.Times
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(PodHttpChaosRate)
+		**out = **in
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(PodHttpChaosTLS)
@@ -1751,6 +2438,21 @@ func (in *HTTPChaosSpec) DeepCopyInto(out *HTTPChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPChaosSpec.
@@ -2013,6 +2715,21 @@ func (in *IOChaosSpec) DeepCopyInto(out *IOChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOChaosSpec.
@@ -2048,6 +2765,52 @@ func (in *IOChaosStatus) DeepCopy() *IOChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOThrottle) DeepCopyInto(out *IOThrottle) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOThrottle.
+func (in *IOThrottle) DeepCopy() *IOThrottle {
+	if in == nil {
+		return nil
+	}
+	out := new(IOThrottle)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntermittentSpec) DeepCopyInto(out *IntermittentSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntermittentSpec.
+func (in *IntermittentSpec) DeepCopy() *IntermittentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IntermittentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IntermittentStatus) DeepCopyInto(out *IntermittentStatus) {
+	*out = *in
+	in.PeriodEndTime.DeepCopyInto(&out.PeriodEndTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IntermittentStatus.
+func (in *IntermittentStatus) DeepCopy() *IntermittentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IntermittentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IoFault) DeepCopyInto(out *IoFault) {
 	*out = *in
@@ -2063,6 +2826,21 @@ func (in *IoFault) DeepCopy() *IoFault {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Iteration) DeepCopyInto(out *Iteration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Iteration.
+func (in *Iteration) DeepCopy() *Iteration {
+	if in == nil {
+		return nil
+	}
+	out := new(Iteration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JVMChaos) DeepCopyInto(out *JVMChaos) {
 	*out = *in
@@ -2131,7 +2909,22 @@ func (in *JVMChaosSpec) DeepCopyInto(out *JVMChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
 	out.JVMParameter = in.JVMParameter
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JVMChaosSpec.
@@ -2469,6 +3262,21 @@ func (in *KernelChaosSpec) DeepCopyInto(out *KernelChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelChaosSpec.
@@ -2518,6 +3326,31 @@ func (in LabelSelectorRequirements) DeepCopy() LabelSelectorRequirements {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoopSpec) DeepCopyInto(out *LoopSpec) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoopSpec.
+func (in *LoopSpec) DeepCopy() *LoopSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoopSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LossSpec) DeepCopyInto(out *LossSpec) {
 	*out = *in
@@ -2662,6 +3495,11 @@ func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
 	in.TcParameter.DeepCopyInto(&out.TcParameter)
 	if in.Target != nil {
 		in, out := &in.Target, &out.Target
@@ -2673,6 +3511,16 @@ func (in *NetworkChaosSpec) DeepCopyInto(out *NetworkChaosSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkChaosSpec.
@@ -2870,6 +3718,7 @@ func (in *PhysicalMachine) DeepCopyInto(out *PhysicalMachine) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachine.
@@ -2931,58 +3780,130 @@ func (in *PhysicalMachineChaosList) DeepCopyInto(out *PhysicalMachineChaosList)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosList.
-func (in *PhysicalMachineChaosList) DeepCopy() *PhysicalMachineChaosList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosList.
+func (in *PhysicalMachineChaosList) DeepCopy() *PhysicalMachineChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalMachineChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PhysicalMachineChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalMachineChaosSpec) DeepCopyInto(out *PhysicalMachineChaosSpec) {
+	*out = *in
+	in.PhysicalMachineSelector.DeepCopyInto(&out.PhysicalMachineSelector)
+	in.ExpInfo.DeepCopyInto(&out.ExpInfo)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosSpec.
+func (in *PhysicalMachineChaosSpec) DeepCopy() *PhysicalMachineChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalMachineChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalMachineChaosStatus) DeepCopyInto(out *PhysicalMachineChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosStatus.
+func (in *PhysicalMachineChaosStatus) DeepCopy() *PhysicalMachineChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalMachineChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalMachineCondition) DeepCopyInto(out *PhysicalMachineCondition) {
+	*out = *in
+	if in.LastProbeTime != nil {
+		in, out := &in.LastProbeTime, &out.LastProbeTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineCondition.
+func (in *PhysicalMachineCondition) DeepCopy() *PhysicalMachineCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(PhysicalMachineChaosList)
+	out := new(PhysicalMachineCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PhysicalMachineChaosList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PhysicalMachineChaosSpec) DeepCopyInto(out *PhysicalMachineChaosSpec) {
+func (in *PhysicalMachineExperiment) DeepCopyInto(out *PhysicalMachineExperiment) {
 	*out = *in
-	in.PhysicalMachineSelector.DeepCopyInto(&out.PhysicalMachineSelector)
-	in.ExpInfo.DeepCopyInto(&out.ExpInfo)
-	if in.Duration != nil {
-		in, out := &in.Duration, &out.Duration
-		*out = new(string)
-		**out = **in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosSpec.
-func (in *PhysicalMachineChaosSpec) DeepCopy() *PhysicalMachineChaosSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineExperiment.
+func (in *PhysicalMachineExperiment) DeepCopy() *PhysicalMachineExperiment {
 	if in == nil {
 		return nil
 	}
-	out := new(PhysicalMachineChaosSpec)
+	out := new(PhysicalMachineExperiment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PhysicalMachineChaosStatus) DeepCopyInto(out *PhysicalMachineChaosStatus) {
+func (in *PhysicalMachineInventory) DeepCopyInto(out *PhysicalMachineInventory) {
 	*out = *in
-	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineChaosStatus.
-func (in *PhysicalMachineChaosStatus) DeepCopy() *PhysicalMachineChaosStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineInventory.
+func (in *PhysicalMachineInventory) DeepCopy() *PhysicalMachineInventory {
 	if in == nil {
 		return nil
 	}
-	out := new(PhysicalMachineChaosStatus)
+	out := new(PhysicalMachineInventory)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3028,6 +3949,11 @@ func (in *PhysicalMachineSelector) DeepCopyInto(out *PhysicalMachineSelector) {
 		copy(*out, *in)
 	}
 	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineSelector.
@@ -3087,6 +4013,44 @@ func (in *PhysicalMachineSpec) DeepCopy() *PhysicalMachineSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PhysicalMachineStatus) DeepCopyInto(out *PhysicalMachineStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]PhysicalMachineCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastHeartbeatTime != nil {
+		in, out := &in.LastHeartbeatTime, &out.LastHeartbeatTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(PhysicalMachineInventory)
+		**out = **in
+	}
+	if in.Experiments != nil {
+		in, out := &in.Experiments, &out.Experiments
+		*out = make([]PhysicalMachineExperiment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhysicalMachineStatus.
+func (in *PhysicalMachineStatus) DeepCopy() *PhysicalMachineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PhysicalMachineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodChaos) DeepCopyInto(out *PodChaos) {
 	*out = *in
@@ -3155,6 +4119,21 @@ func (in *PodChaosSpec) DeepCopyInto(out *PodChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodChaosSpec.
@@ -3183,13 +4162,212 @@ func (in *PodChaosStatus) DeepCopy() *PodChaosStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodFaultChaos) DeepCopyInto(out *PodFaultChaos) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodFaultChaos.
+func (in *PodFaultChaos) DeepCopy() *PodFaultChaos {
+	if in == nil {
+		return nil
+	}
+	out := new(PodFaultChaos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodFaultChaos) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodFaultChaosList) DeepCopyInto(out *PodFaultChaosList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodFaultChaos, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodFaultChaosList.
+func (in *PodFaultChaosList) DeepCopy() *PodFaultChaosList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodFaultChaosList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodFaultChaosList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodFaultChaosSpec) DeepCopyInto(out *PodFaultChaosSpec) {
+	*out = *in
+	in.ContainerSelector.DeepCopyInto(&out.ContainerSelector)
+	in.PodFaultExpInfo.DeepCopyInto(&out.PodFaultExpInfo)
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodFaultChaosSpec.
+func (in *PodFaultChaosSpec) DeepCopy() *PodFaultChaosSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodFaultChaosSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodFaultChaosStatus) DeepCopyInto(out *PodFaultChaosStatus) {
+	*out = *in
+	in.ChaosStatus.DeepCopyInto(&out.ChaosStatus)
+	if in.Instances != nil {
+		in, out := &in.Instances, &out.Instances
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodFaultChaosStatus.
+func (in *PodFaultChaosStatus) DeepCopy() *PodFaultChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodFaultChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodFaultExpInfo) DeepCopyInto(out *PodFaultExpInfo) {
+	*out = *in
+	if in.FileCreate != nil {
+		in, out := &in.FileCreate, &out.FileCreate
+		*out = new(FileCreateSpec)
+		**out = **in
+	}
+	if in.FileModifyPrivilege != nil {
+		in, out := &in.FileModifyPrivilege, &out.FileModifyPrivilege
+		*out = new(FileModifyPrivilegeSpec)
+		**out = **in
+	}
+	if in.FileDelete != nil {
+		in, out := &in.FileDelete, &out.FileDelete
+		*out = new(FileDeleteSpec)
+		**out = **in
+	}
+	if in.FileRename != nil {
+		in, out := &in.FileRename, &out.FileRename
+		*out = new(FileRenameSpec)
+		**out = **in
+	}
+	if in.FileAppend != nil {
+		in, out := &in.FileAppend, &out.FileAppend
+		*out = new(FileAppendSpec)
+		**out = **in
+	}
+	if in.FileReplace != nil {
+		in, out := &in.FileReplace, &out.FileReplace
+		*out = new(FileReplaceSpec)
+		**out = **in
+	}
+	if in.Process != nil {
+		in, out := &in.Process, &out.Process
+		*out = new(ProcessSpec)
+		**out = **in
+	}
+	if in.RedisExpiration != nil {
+		in, out := &in.RedisExpiration, &out.RedisExpiration
+		*out = new(RedisExpirationSpec)
+		**out = **in
+	}
+	if in.RedisPenetration != nil {
+		in, out := &in.RedisPenetration, &out.RedisPenetration
+		*out = new(RedisPenetrationSpec)
+		**out = **in
+	}
+	if in.RedisCacheLimit != nil {
+		in, out := &in.RedisCacheLimit, &out.RedisCacheLimit
+		*out = new(RedisCacheLimitSpec)
+		**out = **in
+	}
+	if in.KafkaFill != nil {
+		in, out := &in.KafkaFill, &out.KafkaFill
+		*out = new(KafkaFillSpec)
+		**out = **in
+	}
+	if in.KafkaFlood != nil {
+		in, out := &in.KafkaFlood, &out.KafkaFlood
+		*out = new(KafkaFloodSpec)
+		**out = **in
+	}
+	if in.KafkaIO != nil {
+		in, out := &in.KafkaIO, &out.KafkaIO
+		*out = new(KafkaIOSpec)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodFaultExpInfo.
+func (in *PodFaultExpInfo) DeepCopy() *PodFaultExpInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(PodFaultExpInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodHttpChaos) DeepCopyInto(out *PodHttpChaos) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaos.
@@ -3250,6 +4428,21 @@ func (in *PodHttpChaosBaseRule) DeepCopyInto(out *PodHttpChaosBaseRule) {
 	*out = *in
 	in.Selector.DeepCopyInto(&out.Selector)
 	in.Actions.DeepCopyInto(&out.Actions)
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Times != nil {
+		in, out := &in.Times, &out.Times
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Rate != nil {
+		in, out := &in.Rate, &out.Rate
+		*out = new(PodHttpChaosRate)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosBaseRule.
@@ -3331,22 +4524,37 @@ func (in *PodHttpChaosPatchActions) DeepCopy() *PodHttpChaosPatchActions {
 	if in == nil {
 		return nil
 	}
-	out := new(PodHttpChaosPatchActions)
+	out := new(PodHttpChaosPatchActions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHttpChaosPatchBodyAction) DeepCopyInto(out *PodHttpChaosPatchBodyAction) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosPatchBodyAction.
+func (in *PodHttpChaosPatchBodyAction) DeepCopy() *PodHttpChaosPatchBodyAction {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHttpChaosPatchBodyAction)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PodHttpChaosPatchBodyAction) DeepCopyInto(out *PodHttpChaosPatchBodyAction) {
+func (in *PodHttpChaosRate) DeepCopyInto(out *PodHttpChaosRate) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosPatchBodyAction.
-func (in *PodHttpChaosPatchBodyAction) DeepCopy() *PodHttpChaosPatchBodyAction {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosRate.
+func (in *PodHttpChaosRate) DeepCopy() *PodHttpChaosRate {
 	if in == nil {
 		return nil
 	}
-	out := new(PodHttpChaosPatchBodyAction)
+	out := new(PodHttpChaosRate)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3416,6 +4624,21 @@ func (in *PodHttpChaosRule) DeepCopy() *PodHttpChaosRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodHttpChaosRuleStatus) DeepCopyInto(out *PodHttpChaosRuleStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosRuleStatus.
+func (in *PodHttpChaosRuleStatus) DeepCopy() *PodHttpChaosRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodHttpChaosRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodHttpChaosSelector) DeepCopyInto(out *PodHttpChaosSelector) {
 	*out = *in
@@ -3429,6 +4652,11 @@ func (in *PodHttpChaosSelector) DeepCopyInto(out *PodHttpChaosSelector) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Host != nil {
+		in, out := &in.Host, &out.Host
+		*out = new(string)
+		**out = **in
+	}
 	if in.Method != nil {
 		in, out := &in.Method, &out.Method
 		*out = new(string)
@@ -3495,6 +4723,11 @@ func (in *PodHttpChaosSpec) DeepCopy() *PodHttpChaosSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodHttpChaosStatus) DeepCopyInto(out *PodHttpChaosStatus) {
 	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PodHttpChaosRuleStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodHttpChaosStatus.
@@ -3742,6 +4975,16 @@ func (in *PodNetworkChaosStatus) DeepCopy() *PodNetworkChaosStatus {
 func (in *PodSelector) DeepCopyInto(out *PodSelector) {
 	*out = *in
 	in.Selector.DeepCopyInto(&out.Selector)
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutSpec)
+		**out = **in
+	}
+	if in.Reselect != nil {
+		in, out := &in.Reselect, &out.Reselect
+		*out = new(ReselectSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodSelector.
@@ -4079,6 +5322,26 @@ func (in *RemoteCluster) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoteClusterChaosStatus) DeepCopyInto(out *RemoteClusterChaosStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ChaosCondition, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoteClusterChaosStatus.
+func (in *RemoteClusterChaosStatus) DeepCopy() *RemoteClusterChaosStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoteClusterChaosStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteClusterCondition) DeepCopyInto(out *RemoteClusterCondition) {
 	*out = *in
@@ -4213,6 +5476,85 @@ func (in *ReorderSpec) DeepCopy() *ReorderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReselectSpec) DeepCopyInto(out *ReselectSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReselectSpec.
+func (in *ReselectSpec) DeepCopy() *ReselectSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ReselectSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetrySpec) DeepCopyInto(out *RetrySpec) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(string)
+		**out = **in
+	}
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetrySpec.
+func (in *RetrySpec) DeepCopy() *RetrySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RetrySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutSpec.
+func (in *RolloutSpec) DeepCopy() *RolloutSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.NextBatchTime != nil {
+		in, out := &in.NextBatchTime, &out.NextBatchTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Schedule) DeepCopyInto(out *Schedule) {
 	*out = *in
@@ -4319,7 +5661,7 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 	*out = *in
 	if in.Active != nil {
 		in, out := &in.Active, &out.Active
-		*out = make([]v1.ObjectReference, len(*in))
+		*out = make([]corev1.ObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	in.LastScheduleTime.DeepCopyInto(&out.LastScheduleTime)
@@ -4586,11 +5928,31 @@ func (in *StressChaosSpec) DeepCopyInto(out *StressChaosSpec) {
 		*out = new(Stressors)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Throttle != nil {
+		in, out := &in.Throttle, &out.Throttle
+		*out = new(CgroupThrottle)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Duration != nil {
 		in, out := &in.Duration, &out.Duration
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StressChaosSpec.
@@ -4637,6 +5999,11 @@ func (in *StressInstance) DeepCopyInto(out *StressInstance) {
 		in, out := &in.MemoryStartTime, &out.MemoryStartTime
 		*out = (*in).DeepCopy()
 	}
+	if in.OriginalThrottle != nil {
+		in, out := &in.OriginalThrottle, &out.OriginalThrottle
+		*out = new(CgroupThrottleValues)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StressInstance.
@@ -4714,16 +6081,21 @@ func (in *Task) DeepCopyInto(out *Task) {
 	*out = *in
 	if in.Container != nil {
 		in, out := &in.Container, &out.Container
-		*out = new(v1.Container)
+		*out = new(corev1.Container)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
-		*out = make([]v1.Volume, len(*in))
+		*out = make([]corev1.Volume, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make([]TaskOutput, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Task.
@@ -4736,6 +6108,21 @@ func (in *Task) DeepCopy() *Task {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TaskOutput) DeepCopyInto(out *TaskOutput) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TaskOutput.
+func (in *TaskOutput) DeepCopy() *TaskOutput {
+	if in == nil {
+		return nil
+	}
+	out := new(TaskOutput)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TcParameter) DeepCopyInto(out *TcParameter) {
 	*out = *in
@@ -4819,6 +6206,21 @@ func (in *Template) DeepCopyInto(out *Template) {
 		*out = new(StatusCheckSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(LoopSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForEach != nil {
+		in, out := &in.ForEach, &out.ForEach
+		*out = new(ForEachSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Template.
@@ -4904,6 +6306,21 @@ func (in *TimeChaosSpec) DeepCopyInto(out *TimeChaosSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Intermittent != nil {
+		in, out := &in.Intermittent, &out.Intermittent
+		*out = new(IntermittentSpec)
+		**out = **in
+	}
+	if in.RemoteClusters != nil {
+		in, out := &in.RemoteClusters, &out.RemoteClusters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteClusterSelector != nil {
+		in, out := &in.RemoteClusterSelector, &out.RemoteClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TimeChaosSpec.
@@ -5170,6 +6587,26 @@ func (in *WorkflowNodeSpec) DeepCopyInto(out *WorkflowNodeSpec) {
 		*out = new(StatusCheckSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(RetrySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Loop != nil {
+		in, out := &in.Loop, &out.Loop
+		*out = new(LoopSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForEach != nil {
+		in, out := &in.ForEach, &out.ForEach
+		*out = new(ForEachSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Iteration != nil {
+		in, out := &in.Iteration, &out.Iteration
+		*out = new(Iteration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowNodeSpec.
@@ -5187,7 +6624,7 @@ func (in *WorkflowNodeStatus) DeepCopyInto(out *WorkflowNodeStatus) {
 	*out = *in
 	if in.ChaosResource != nil {
 		in, out := &in.ChaosResource, &out.ChaosResource
-		*out = new(v1.TypedLocalObjectReference)
+		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ConditionalBranchesStatus != nil {
@@ -5195,14 +6632,25 @@ func (in *WorkflowNodeStatus) DeepCopyInto(out *WorkflowNodeStatus) {
 		*out = new(ConditionalBranchesStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Outputs != nil {
+		in, out := &in.Outputs, &out.Outputs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.NextIterationTime != nil {
+		in, out := &in.NextIterationTime, &out.NextIterationTime
+		*out = (*in).DeepCopy()
+	}
 	if in.ActiveChildren != nil {
 		in, out := &in.ActiveChildren, &out.ActiveChildren
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.FinishedChildren != nil {
 		in, out := &in.FinishedChildren, &out.FinishedChildren
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.Conditions != nil {
@@ -5222,6 +6670,31 @@ func (in *WorkflowNodeStatus) DeepCopy() *WorkflowNodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowParameter) DeepCopyInto(out *WorkflowParameter) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(string)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowParameter.
+func (in *WorkflowParameter) DeepCopy() *WorkflowParameter {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 	*out = *in
@@ -5232,6 +6705,18 @@ func (in *WorkflowSpec) DeepCopyInto(out *WorkflowSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WorkflowTemplateRef != nil {
+		in, out := &in.WorkflowTemplateRef, &out.WorkflowTemplateRef
+		*out = new(WorkflowTemplateRef)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]WorkflowParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowSpec.
@@ -5278,3 +6763,105 @@ func (in *WorkflowStatus) DeepCopy() *WorkflowStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplate) DeepCopyInto(out *WorkflowTemplate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplate.
+func (in *WorkflowTemplate) DeepCopy() *WorkflowTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTemplate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateList) DeepCopyInto(out *WorkflowTemplateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowTemplate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateList.
+func (in *WorkflowTemplateList) DeepCopy() *WorkflowTemplateList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowTemplateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateRef) DeepCopyInto(out *WorkflowTemplateRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateRef.
+func (in *WorkflowTemplateRef) DeepCopy() *WorkflowTemplateRef {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowTemplateSpec) DeepCopyInto(out *WorkflowTemplateSpec) {
+	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]Template, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]WorkflowParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowTemplateSpec.
+func (in *WorkflowTemplateSpec) DeepCopy() *WorkflowTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
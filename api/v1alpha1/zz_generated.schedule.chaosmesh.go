@@ -27,6 +27,7 @@ const (
 	ScheduleTypeAWSChaos ScheduleTemplateType = "AWSChaos"
 	ScheduleTypeAzureChaos ScheduleTemplateType = "AzureChaos"
 	ScheduleTypeBlockChaos ScheduleTemplateType = "BlockChaos"
+	ScheduleTypeCompositeChaos ScheduleTemplateType = "CompositeChaos"
 	ScheduleTypeDNSChaos ScheduleTemplateType = "DNSChaos"
 	ScheduleTypeGCPChaos ScheduleTemplateType = "GCPChaos"
 	ScheduleTypeHTTPChaos ScheduleTemplateType = "HTTPChaos"
@@ -36,6 +37,7 @@ const (
 	ScheduleTypeNetworkChaos ScheduleTemplateType = "NetworkChaos"
 	ScheduleTypePhysicalMachineChaos ScheduleTemplateType = "PhysicalMachineChaos"
 	ScheduleTypePodChaos ScheduleTemplateType = "PodChaos"
+	ScheduleTypePodFaultChaos ScheduleTemplateType = "PodFaultChaos"
 	ScheduleTypeStressChaos ScheduleTemplateType = "StressChaos"
 	ScheduleTypeTimeChaos ScheduleTemplateType = "TimeChaos"
 	ScheduleTypeWorkflow ScheduleTemplateType = "Workflow"
@@ -46,6 +48,7 @@ var allScheduleTemplateType = []ScheduleTemplateType{
 	ScheduleTypeAWSChaos,
 	ScheduleTypeAzureChaos,
 	ScheduleTypeBlockChaos,
+	ScheduleTypeCompositeChaos,
 	ScheduleTypeDNSChaos,
 	ScheduleTypeGCPChaos,
 	ScheduleTypeHTTPChaos,
@@ -55,6 +58,7 @@ var allScheduleTemplateType = []ScheduleTemplateType{
 	ScheduleTypeNetworkChaos,
 	ScheduleTypePhysicalMachineChaos,
 	ScheduleTypePodChaos,
+	ScheduleTypePodFaultChaos,
 	ScheduleTypeStressChaos,
 	ScheduleTypeTimeChaos,
 	ScheduleTypeWorkflow,
@@ -75,6 +79,10 @@ func (it *ScheduleItem) SpawnNewObject(templateType ScheduleTemplateType) (Gener
 		result := BlockChaos{}
 		result.Spec = *it.BlockChaos
 		return &result, nil
+	case ScheduleTypeCompositeChaos:
+		result := CompositeChaos{}
+		result.Spec = *it.CompositeChaos
+		return &result, nil
 	case ScheduleTypeDNSChaos:
 		result := DNSChaos{}
 		result.Spec = *it.DNSChaos
@@ -111,6 +119,10 @@ func (it *ScheduleItem) SpawnNewObject(templateType ScheduleTemplateType) (Gener
 		result := PodChaos{}
 		result.Spec = *it.PodChaos
 		return &result, nil
+	case ScheduleTypePodFaultChaos:
+		result := PodFaultChaos{}
+		result.Spec = *it.PodFaultChaos
+		return &result, nil
 	case ScheduleTypeStressChaos:
 		result := StressChaos{}
 		result.Spec = *it.StressChaos
@@ -140,6 +152,9 @@ func (it *ScheduleItem) RestoreChaosSpec(root interface{}) error {
 	case *BlockChaos:
 		*it.BlockChaos = chaos.Spec
 		return nil
+	case *CompositeChaos:
+		*it.CompositeChaos = chaos.Spec
+		return nil
 	case *DNSChaos:
 		*it.DNSChaos = chaos.Spec
 		return nil
@@ -167,6 +182,9 @@ func (it *ScheduleItem) RestoreChaosSpec(root interface{}) error {
 	case *PodChaos:
 		*it.PodChaos = chaos.Spec
 		return nil
+	case *PodFaultChaos:
+		*it.PodFaultChaos = chaos.Spec
+		return nil
 	case *StressChaos:
 		*it.StressChaos = chaos.Spec
 		return nil
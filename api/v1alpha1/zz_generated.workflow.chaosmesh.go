@@ -27,6 +27,7 @@ const (
 	TypeAWSChaos TemplateType = "AWSChaos"
 	TypeAzureChaos TemplateType = "AzureChaos"
 	TypeBlockChaos TemplateType = "BlockChaos"
+	TypeCompositeChaos TemplateType = "CompositeChaos"
 	TypeDNSChaos TemplateType = "DNSChaos"
 	TypeGCPChaos TemplateType = "GCPChaos"
 	TypeHTTPChaos TemplateType = "HTTPChaos"
@@ -36,6 +37,7 @@ const (
 	TypeNetworkChaos TemplateType = "NetworkChaos"
 	TypePhysicalMachineChaos TemplateType = "PhysicalMachineChaos"
 	TypePodChaos TemplateType = "PodChaos"
+	TypePodFaultChaos TemplateType = "PodFaultChaos"
 	TypeStressChaos TemplateType = "StressChaos"
 	TypeTimeChaos TemplateType = "TimeChaos"
 
@@ -46,6 +48,7 @@ var allChaosTemplateType = []TemplateType{
 	TypeAWSChaos,
 	TypeAzureChaos,
 	TypeBlockChaos,
+	TypeCompositeChaos,
 	TypeDNSChaos,
 	TypeGCPChaos,
 	TypeHTTPChaos,
@@ -55,6 +58,7 @@ var allChaosTemplateType = []TemplateType{
 	TypeNetworkChaos,
 	TypePhysicalMachineChaos,
 	TypePodChaos,
+	TypePodFaultChaos,
 	TypeStressChaos,
 	TypeTimeChaos,
 
@@ -68,6 +72,8 @@ type EmbedChaos struct {
 	// +optional
 	BlockChaos *BlockChaosSpec `json:"blockChaos,omitempty"`
 	// +optional
+	CompositeChaos *CompositeChaosSpec `json:"compositeChaos,omitempty"`
+	// +optional
 	DNSChaos *DNSChaosSpec `json:"dnsChaos,omitempty"`
 	// +optional
 	GCPChaos *GCPChaosSpec `json:"gcpChaos,omitempty"`
@@ -86,6 +92,8 @@ type EmbedChaos struct {
 	// +optional
 	PodChaos *PodChaosSpec `json:"podChaos,omitempty"`
 	// +optional
+	PodFaultChaos *PodFaultChaosSpec `json:"podfaultChaos,omitempty"`
+	// +optional
 	StressChaos *StressChaosSpec `json:"stressChaos,omitempty"`
 	// +optional
 	TimeChaos *TimeChaosSpec `json:"timeChaos,omitempty"`
@@ -106,6 +114,10 @@ func (it *EmbedChaos) SpawnNewObject(templateType TemplateType) (GenericChaos, e
 		result := BlockChaos{}
 		result.Spec = *it.BlockChaos
 		return &result, nil
+	case TypeCompositeChaos:
+		result := CompositeChaos{}
+		result.Spec = *it.CompositeChaos
+		return &result, nil
 	case TypeDNSChaos:
 		result := DNSChaos{}
 		result.Spec = *it.DNSChaos
@@ -142,6 +154,10 @@ func (it *EmbedChaos) SpawnNewObject(templateType TemplateType) (GenericChaos, e
 		result := PodChaos{}
 		result.Spec = *it.PodChaos
 		return &result, nil
+	case TypePodFaultChaos:
+		result := PodFaultChaos{}
+		result.Spec = *it.PodFaultChaos
+		return &result, nil
 	case TypeStressChaos:
 		result := StressChaos{}
 		result.Spec = *it.StressChaos
@@ -167,6 +183,9 @@ func (it *EmbedChaos) RestoreChaosSpec(root interface{}) error {
 	case *BlockChaos:
 		*it.BlockChaos = chaos.Spec
 		return nil
+	case *CompositeChaos:
+		*it.CompositeChaos = chaos.Spec
+		return nil
 	case *DNSChaos:
 		*it.DNSChaos = chaos.Spec
 		return nil
@@ -194,6 +213,9 @@ func (it *EmbedChaos) RestoreChaosSpec(root interface{}) error {
 	case *PodChaos:
 		*it.PodChaos = chaos.Spec
 		return nil
+	case *PodFaultChaos:
+		*it.PodFaultChaos = chaos.Spec
+		return nil
 	case *StressChaos:
 		*it.StressChaos = chaos.Spec
 		return nil
@@ -217,6 +239,9 @@ func (it *EmbedChaos) SpawnNewList(templateType TemplateType) (GenericChaosList,
 	case TypeBlockChaos:
 		result := BlockChaosList{}
 		return &result, nil
+	case TypeCompositeChaos:
+		result := CompositeChaosList{}
+		return &result, nil
 	case TypeDNSChaos:
 		result := DNSChaosList{}
 		return &result, nil
@@ -244,6 +269,9 @@ func (it *EmbedChaos) SpawnNewList(templateType TemplateType) (GenericChaosList,
 	case TypePodChaos:
 		result := PodChaosList{}
 		return &result, nil
+	case TypePodFaultChaos:
+		result := PodFaultChaosList{}
+		return &result, nil
 	case TypeStressChaos:
 		result := StressChaosList{}
 		return &result, nil
@@ -280,6 +308,14 @@ func (in *BlockChaosList) GetItems() []GenericChaos {
 	}
 	return result
 }
+func (in *CompositeChaosList) GetItems() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
 func (in *DNSChaosList) GetItems() []GenericChaos {
 	var result []GenericChaos
 	for _, item := range in.Items {
@@ -352,6 +388,14 @@ func (in *PodChaosList) GetItems() []GenericChaos {
 	}
 	return result
 }
+func (in *PodFaultChaosList) GetItems() []GenericChaos {
+	var result []GenericChaos
+	for _, item := range in.Items {
+		item := item
+		result = append(result, &item)
+	}
+	return result
+}
 func (in *StressChaosList) GetItems() []GenericChaos {
 	var result []GenericChaos
 	for _, item := range in.Items {
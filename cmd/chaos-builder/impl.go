@@ -83,6 +83,21 @@ func (in *{{.Type}}) GetRemoteCluster() string {
 	return in.Spec.RemoteCluster
 }
 
+// GetRemoteClusters returns the remoteClusters
+func (in *{{.Type}}) GetRemoteClusters() []string {
+	return in.Spec.RemoteClusters
+}
+
+// GetRemoteClusterSelector returns the remoteClusterSelector
+func (in *{{.Type}}) GetRemoteClusterSelector() *metav1.LabelSelector {
+	return in.Spec.RemoteClusterSelector
+}
+
+// GetIntermittent returns the intermittent
+func (in *{{.Type}}) GetIntermittent() *IntermittentSpec {
+	return in.Spec.Intermittent
+}
+
 // GetSpecAndMetaString returns a string including the meta and spec field of this chaos object.
 func (in *{{.Type}}) GetSpecAndMetaString() (string, error) {
 	spec, err := json.Marshal(in.Spec)
@@ -180,6 +195,9 @@ var _ webhook.Validator = &{{.Type}}{}
 
 func (in *{{.Type}}) Validate() ([]string, error) {
 	errs := gw.Validate(in)
+	{{- if .IsExperiment}}
+	errs = append(errs, validateRemoteClusters(in)...)
+	{{- end}}
 	return nil, gw.Aggregate(errs)
 }
 
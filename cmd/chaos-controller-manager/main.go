@@ -30,6 +30,7 @@ import (
 	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	controllermetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
@@ -39,11 +40,13 @@ import (
 	ccfg "github.com/chaos-mesh/chaos-mesh/controllers/config"
 	"github.com/chaos-mesh/chaos-mesh/controllers/types"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaospolicy"
 	ctrlserver "github.com/chaos-mesh/chaos-mesh/pkg/ctrl"
 	grpcUtils "github.com/chaos-mesh/chaos-mesh/pkg/grpc"
 	"github.com/chaos-mesh/chaos-mesh/pkg/log"
 	"github.com/chaos-mesh/chaos-mesh/pkg/metrics"
 	"github.com/chaos-mesh/chaos-mesh/pkg/selector"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/generic"
 	"github.com/chaos-mesh/chaos-mesh/pkg/version"
 	apiWebhook "github.com/chaos-mesh/chaos-mesh/pkg/webhook"
 )
@@ -111,6 +114,8 @@ type RunParams struct {
 	MetricsCollector *metrics.ChaosControllerManagerMetricsCollector
 	// CtrlServer is the graphql server for chaosctl.
 	CtrlServer *handler.Server
+	// Reader is the uncached kubernetes reader. Required for the policy webhook.
+	Reader client.Reader `name:"no-cache"`
 
 	// Objs collects all the kinds of chaos custom resource objects that would be handled by the controller/reconciler.
 	Objs []types.Object `group:"objs"`
@@ -171,6 +176,24 @@ func Run(params RunParams) error {
 		}
 	}
 
+	if ccfg.ShouldStartWebhook("workflowtemplate") {
+		err = ctrl.NewWebhookManagedBy(mgr).
+			For(&v1alpha1.WorkflowTemplate{}).
+			Complete()
+		if err != nil {
+			return err
+		}
+	}
+
+	if ccfg.ShouldStartWebhook("clusterworkflowtemplate") {
+		err = ctrl.NewWebhookManagedBy(mgr).
+			For(&v1alpha1.ClusterWorkflowTemplate{}).
+			Complete()
+		if err != nil {
+			return err
+		}
+	}
+
 	setupLog.Info("Setting up webhook server")
 	hookServer := mgr.GetWebhookServer()
 
@@ -196,13 +219,26 @@ func Run(params RunParams) error {
 	}
 
 	hookServer.Register("/validate-auth", &webhook.Admission{
-		Handler: apiWebhook.NewAuthValidator(ccfg.ControllerCfg.SecurityMode, authCli, mgr.GetScheme(),
+		Handler: apiWebhook.NewAuthValidator(ccfg.ControllerCfg.SecurityMode, authCli, params.Reader, mgr.GetScheme(),
 			ccfg.ControllerCfg.ClusterScoped, ccfg.ControllerCfg.TargetNamespace, ccfg.ControllerCfg.EnableFilterNamespace,
 			params.Logger.WithName("validate-auth"),
 		),
 	},
 	)
 
+	hookServer.Register("/validate-policy", &webhook.Admission{
+		Handler: apiWebhook.NewPolicyValidator(
+			chaospolicy.NewChecker(mgr.GetClient(), params.Reader, generic.Option{
+				ClusterScoped:         ccfg.ControllerCfg.ClusterScoped,
+				TargetNamespace:       ccfg.ControllerCfg.TargetNamespace,
+				EnableFilterNamespace: ccfg.ControllerCfg.EnableFilterNamespace,
+			}),
+			mgr.GetScheme(),
+			params.Logger.WithName("validate-policy"),
+		),
+	},
+	)
+
 	setupLog.Info("Starting manager")
 	if err := mgr.Start(controllerRuntimeSignalHandler); err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -0,0 +1,374 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package compositechaos
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/iochaos"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/iochaos/podiochaosmanager"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/networkchaos/trafficcontrol"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/stresschaos"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/timechaos"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+const (
+	waitForApplySync   v1alpha1.Phase = "Not Injected/Wait"
+	waitForRecoverSync v1alpha1.Phase = "Injected/Wait"
+	// waitForRollback means a fault failed to be injected, and the faults injected before it are being recovered
+	waitForRollback v1alpha1.Phase = "Not Injected/Rollback"
+)
+
+// Impl injects the faults of a CompositeChaos one by one, by delegating every fault to the implementation
+// of the corresponding chaos kind. Every implementation works on an object of its own kind, which is built
+// from the fault and the state of the fault saved in the status of the CompositeChaos.
+type Impl struct {
+	client.Client
+	Log logr.Logger
+
+	network impltypes.ChaosImpl
+	stress  impltypes.ChaosImpl
+	io      impltypes.ChaosImpl
+	time    impltypes.ChaosImpl
+}
+
+// delegate is a fault of a CompositeChaos, together with the implementation to inject it
+type delegate struct {
+	impl impltypes.ChaosImpl
+	obj  v1alpha1.InnerObject
+
+	// save writes the custom status of obj back to the state of the fault
+	save func()
+}
+
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	// The only possible phase to get in here is "Not Injected" or "Not Injected/*"
+
+	compositechaos := obj.(*v1alpha1.CompositeChaos)
+	record := records[index]
+	instance := getInstance(compositechaos, record.Id)
+	defer func() {
+		compositechaos.Status.Instances[record.Id] = instance
+	}()
+
+	if instance.RollingBack {
+		phase, err := impl.recoverFaults(ctx, compositechaos, record, &instance)
+		if err != nil || phase != v1alpha1.NotInjected {
+			return waitForRollback, err
+		}
+
+		// all the injected faults have been recovered, the faults will be injected again in the next round
+		impl.Log.Info("faults have been rolled back", "record", record.Id, "cause", instance.Message)
+		instance.RollingBack = false
+		return v1alpha1.NotInjected, nil
+	}
+
+	for i := range compositechaos.Spec.Faults {
+		fault := &compositechaos.Spec.Faults[i]
+		state := &instance.Faults[i]
+		if state.Phase == v1alpha1.Injected {
+			continue
+		}
+
+		if fault.Network != nil {
+			// the network fault works on the whole pod, so it's injected only once for the containers of a pod
+			if shared := podNetworkPhase(compositechaos, i, record.Id); shared != v1alpha1.NotInjected {
+				if shared != v1alpha1.Injected {
+					return waitForApplySync, nil
+				}
+				state.Phase = v1alpha1.Injected
+				continue
+			}
+		}
+
+		d, err := impl.delegate(compositechaos, fault, record.Id, state)
+		if err != nil {
+			return v1alpha1.NotInjected, err
+		}
+		phase, err := d.impl.Apply(ctx, 0, []*v1alpha1.Record{subRecord(record, state)}, d.obj)
+		d.save()
+		state.Phase = phase
+		if err != nil {
+			err = errors.Wrapf(err, "inject fault %s", fault.Name)
+			if injected(&instance) {
+				impl.Log.Error(err, "rollback the injected faults", "record", record.Id)
+				instance.RollingBack = true
+				instance.Message = err.Error()
+				return waitForRollback, err
+			}
+			return v1alpha1.NotInjected, err
+		}
+
+		if phase != v1alpha1.Injected {
+			return waitForApplySync, nil
+		}
+	}
+
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	// The only possible phase to get in here is "Injected" or "Injected/Wait"
+
+	compositechaos := obj.(*v1alpha1.CompositeChaos)
+	record := records[index]
+	instance := getInstance(compositechaos, record.Id)
+
+	phase, err := impl.recoverFaults(ctx, compositechaos, record, &instance)
+	if err != nil || phase != v1alpha1.NotInjected {
+		compositechaos.Status.Instances[record.Id] = instance
+		return waitForRecoverSync, err
+	}
+
+	delete(compositechaos.Status.Instances, record.Id)
+	return v1alpha1.NotInjected, nil
+}
+
+// recoverFaults recovers the injected faults in the reverse order. It returns "Not Injected" once all the
+// faults have been recovered.
+func (impl *Impl) recoverFaults(ctx context.Context, compositechaos *v1alpha1.CompositeChaos, record *v1alpha1.Record, instance *v1alpha1.CompositeInstance) (v1alpha1.Phase, error) {
+	for i := len(compositechaos.Spec.Faults) - 1; i >= 0; i-- {
+		fault := &compositechaos.Spec.Faults[i]
+		state := &instance.Faults[i]
+		if state.Phase == v1alpha1.NotInjected {
+			continue
+		}
+
+		if fault.Network != nil && podNetworkPhase(compositechaos, i, record.Id) != v1alpha1.NotInjected {
+			// the other containers of the pod still have the network fault, it's recovered with the last of them
+			state.Phase = v1alpha1.NotInjected
+			continue
+		}
+
+		d, err := impl.delegate(compositechaos, fault, record.Id, state)
+		if err != nil {
+			return state.Phase, err
+		}
+		phase, err := d.impl.Recover(ctx, 0, []*v1alpha1.Record{subRecord(record, state)}, d.obj)
+		d.save()
+		state.Phase = phase
+		if err != nil {
+			return phase, errors.Wrapf(err, "recover fault %s", fault.Name)
+		}
+
+		if phase != v1alpha1.NotInjected {
+			return phase, nil
+		}
+	}
+
+	return v1alpha1.NotInjected, nil
+}
+
+// delegate builds the object of the fault for the implementation of its kind. The object shares the
+// namespace with the CompositeChaos, and is named after the CompositeChaos and the fault, so that the faults
+// are distinguished from each other in the PodNetworkChaos, PodIOChaos and chaos-daemon.
+func (impl *Impl) delegate(compositechaos *v1alpha1.CompositeChaos, fault *v1alpha1.CompositeFault, id string, state *v1alpha1.CompositeFaultInstance) (*delegate, error) {
+	meta := *compositechaos.ObjectMeta.DeepCopy()
+	meta.Name = fmt.Sprintf("%s-%s", compositechaos.Name, fault.Name)
+	meta.UID = types.UID(fmt.Sprintf("%s-%s", compositechaos.UID, fault.Name))
+
+	switch {
+	case fault.Network != nil:
+		networkchaos := &v1alpha1.NetworkChaos{
+			ObjectMeta: meta,
+			Spec: v1alpha1.NetworkChaosSpec{
+				Action:          fault.Network.Action,
+				Device:          fault.Network.Device,
+				TcParameter:     fault.Network.TcParameter,
+				Direction:       v1alpha1.To,
+				ExternalTargets: fault.Network.ExternalTargets,
+			},
+			Status: v1alpha1.NetworkChaosStatus{
+				Instances: map[string]int64{id: state.Generation},
+			},
+		}
+		return &delegate{
+			impl: impl.network,
+			obj:  networkchaos,
+			save: func() {
+				state.Generation = networkchaos.Status.Instances[id]
+			},
+		}, nil
+	case fault.Stress != nil:
+		stresschaos := &v1alpha1.StressChaos{
+			ObjectMeta: meta,
+			Spec: v1alpha1.StressChaosSpec{
+				Stressors:         fault.Stress.Stressors,
+				StressngStressors: fault.Stress.StressngStressors,
+			},
+			Status: v1alpha1.StressChaosStatus{
+				Instances: map[string]v1alpha1.StressInstance{},
+			},
+		}
+		if state.Stress != nil {
+			stresschaos.Status.Instances[id] = *state.Stress
+		}
+		return &delegate{
+			impl: impl.stress,
+			obj:  stresschaos,
+			save: func() {
+				state.Stress = nil
+				if instance, ok := stresschaos.Status.Instances[id]; ok {
+					state.Stress = &instance
+				}
+			},
+		}, nil
+	case fault.IO != nil:
+		iochaos := &v1alpha1.IOChaos{
+			ObjectMeta: meta,
+			Spec: v1alpha1.IOChaosSpec{
+				Action:     fault.IO.Action,
+				Delay:      fault.IO.Delay,
+				Errno:      fault.IO.Errno,
+				Attr:       fault.IO.Attr,
+				Mistake:    fault.IO.Mistake,
+				Path:       fault.IO.Path,
+				Methods:    fault.IO.Methods,
+				Percent:    fault.IO.Percent,
+				VolumePath: fault.IO.VolumePath,
+			},
+			Status: v1alpha1.IOChaosStatus{
+				Instances: map[string]int64{id: state.Generation},
+			},
+		}
+		return &delegate{
+			impl: impl.io,
+			obj:  iochaos,
+			save: func() {
+				state.Generation = iochaos.Status.Instances[id]
+			},
+		}, nil
+	case fault.Time != nil:
+		return &delegate{
+			impl: impl.time,
+			obj: &v1alpha1.TimeChaos{
+				ObjectMeta: meta,
+				Spec: v1alpha1.TimeChaosSpec{
+					TimeOffset: fault.Time.TimeOffset,
+					ClockIds:   fault.Time.ClockIds,
+				},
+			},
+			save: func() {},
+		}, nil
+	}
+
+	return nil, errors.Errorf("fault %s is empty", fault.Name)
+}
+
+// getInstance returns the states of the faults in the container, and initializes them if they don't exist
+func getInstance(compositechaos *v1alpha1.CompositeChaos, id string) v1alpha1.CompositeInstance {
+	if compositechaos.Status.Instances == nil {
+		compositechaos.Status.Instances = make(map[string]v1alpha1.CompositeInstance)
+	}
+
+	instance, ok := compositechaos.Status.Instances[id]
+	if ok && len(instance.Faults) == len(compositechaos.Spec.Faults) {
+		return *instance.DeepCopy()
+	}
+
+	instance = v1alpha1.CompositeInstance{}
+	for _, fault := range compositechaos.Spec.Faults {
+		instance.Faults = append(instance.Faults, v1alpha1.CompositeFaultInstance{
+			Name:  fault.Name,
+			Phase: v1alpha1.NotInjected,
+		})
+	}
+	return instance
+}
+
+// podNetworkPhase returns the phase of the network fault at index in the other containers of the pod of the
+// record. It returns "Injected" if any of them has the fault injected, and "Not Injected" if none of them has.
+func podNetworkPhase(compositechaos *v1alpha1.CompositeChaos, index int, id string) v1alpha1.Phase {
+	pod, _, err := controller.ParseNamespacedNameContainer(id)
+	if err != nil {
+		return v1alpha1.NotInjected
+	}
+
+	phase := v1alpha1.NotInjected
+	for otherId, instance := range compositechaos.Status.Instances {
+		if otherId == id || index >= len(instance.Faults) {
+			continue
+		}
+		otherPod, _, err := controller.ParseNamespacedNameContainer(otherId)
+		if err != nil || otherPod != pod {
+			continue
+		}
+
+		switch instance.Faults[index].Phase {
+		case v1alpha1.NotInjected:
+		case v1alpha1.Injected:
+			return v1alpha1.Injected
+		default:
+			phase = instance.Faults[index].Phase
+		}
+	}
+	return phase
+}
+
+// injected returns true if any of the faults has been injected, even partially
+func injected(instance *v1alpha1.CompositeInstance) bool {
+	for _, state := range instance.Faults {
+		if state.Phase != v1alpha1.NotInjected {
+			return true
+		}
+	}
+	return false
+}
+
+func subRecord(record *v1alpha1.Record, state *v1alpha1.CompositeFaultInstance) *v1alpha1.Record {
+	return &v1alpha1.Record{
+		Id:          record.Id,
+		SelectorKey: record.SelectorKey,
+		Phase:       state.Phase,
+	}
+}
+
+func NewImpl(c client.Client, log logr.Logger, decoder *utils.ContainerRecordDecoder, trafficControl *trafficcontrol.Impl, ioBuilder *podiochaosmanager.Builder) *impltypes.ChaosImplPair {
+	return &impltypes.ChaosImplPair{
+		Name:   "compositechaos",
+		Object: &v1alpha1.CompositeChaos{},
+		Impl: &Impl{
+			Client:  c,
+			Log:     log.WithName("compositechaos"),
+			network: trafficControl,
+			stress:  stresschaos.NewImpl(c, log, decoder).Impl,
+			io:      iochaos.NewImpl(c, ioBuilder, log).Impl,
+			time:    timechaos.NewImpl(c, log, decoder).Impl,
+		},
+		ObjectList: &v1alpha1.CompositeChaosList{},
+		Controlls:  []client.Object{&v1alpha1.PodNetworkChaos{}, &v1alpha1.PodIOChaos{}},
+	}
+}
+
+var Module = fx.Provide(
+	fx.Annotated{
+		Group:  "impl",
+		Target: NewImpl,
+	},
+)
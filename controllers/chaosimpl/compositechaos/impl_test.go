@@ -0,0 +1,206 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package compositechaos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// fakeImpl records the calls with the ids of records, fails to apply if fail is set, and waits for the
+// injection if wait is set
+type fakeImpl struct {
+	name  string
+	calls *[]string
+	fail  bool
+	wait  bool
+}
+
+func (f *fakeImpl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	*f.calls = append(*f.calls, "apply "+f.name+" "+records[index].Id)
+	if f.fail {
+		return v1alpha1.NotInjected, errors.New("failed")
+	}
+	if f.wait {
+		return waitForApplySync, nil
+	}
+	return v1alpha1.Injected, nil
+}
+
+func (f *fakeImpl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	*f.calls = append(*f.calls, "recover "+f.name+" "+records[index].Id)
+	return v1alpha1.NotInjected, nil
+}
+
+func withId(id string, calls ...string) []string {
+	for i := range calls {
+		calls[i] += " " + id
+	}
+	return calls
+}
+
+func TestApplyAndRecover(t *testing.T) {
+	g := NewWithT(t)
+
+	var calls []string
+	timeImpl := &fakeImpl{name: "time", calls: &calls}
+	impl := &Impl{
+		Log:     logr.Discard(),
+		network: &fakeImpl{name: "network", calls: &calls},
+		stress:  &fakeImpl{name: "stress", calls: &calls},
+		time:    timeImpl,
+	}
+	chaos := &v1alpha1.CompositeChaos{
+		Spec: v1alpha1.CompositeChaosSpec{
+			Faults: []v1alpha1.CompositeFault{
+				{Name: "delay", Network: &v1alpha1.CompositeNetworkFault{Action: v1alpha1.DelayAction}},
+				{Name: "cpu", Stress: &v1alpha1.CompositeStressFault{StressngStressors: "--cpu 1"}},
+				{Name: "shift", Time: &v1alpha1.CompositeTimeFault{TimeOffset: "-1h"}},
+			},
+		},
+	}
+	records := []*v1alpha1.Record{{Id: "default/pod/container", SelectorKey: ".", Phase: v1alpha1.NotInjected}}
+
+	// the time fault fails, so the network and stress faults are rolled back in the reverse order
+	timeImpl.fail = true
+	phase, err := impl.Apply(context.TODO(), 0, records, chaos)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(phase).To(Equal(waitForRollback))
+	g.Expect(chaos.Status.Instances[records[0].Id].RollingBack).To(BeTrue())
+
+	records[0].Phase = phase
+	phase, err = impl.Apply(context.TODO(), 0, records, chaos)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+	g.Expect(calls).To(Equal(withId("default/pod/container", "apply network", "apply stress", "apply time", "recover stress", "recover network")))
+	instance := chaos.Status.Instances[records[0].Id]
+	g.Expect(instance.RollingBack).To(BeFalse())
+	for _, state := range instance.Faults {
+		g.Expect(state.Phase).To(Equal(v1alpha1.NotInjected))
+	}
+
+	// all the faults are injected in order, and recovered in the reverse order
+	calls = nil
+	timeImpl.fail = false
+	records[0].Phase = phase
+	phase, err = impl.Apply(context.TODO(), 0, records, chaos)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.Injected))
+
+	records[0].Phase = phase
+	phase, err = impl.Recover(context.TODO(), 0, records, chaos)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+	g.Expect(calls).To(Equal(withId("default/pod/container", "apply network", "apply stress", "apply time", "recover time", "recover stress", "recover network")))
+	g.Expect(chaos.Status.Instances).NotTo(HaveKey(records[0].Id))
+}
+
+func TestApplyFirstFaultFailed(t *testing.T) {
+	g := NewWithT(t)
+
+	var calls []string
+	impl := &Impl{
+		Log:    logr.Discard(),
+		stress: &fakeImpl{name: "stress", calls: &calls, fail: true},
+		time:   &fakeImpl{name: "time", calls: &calls},
+	}
+	chaos := &v1alpha1.CompositeChaos{
+		Spec: v1alpha1.CompositeChaosSpec{
+			Faults: []v1alpha1.CompositeFault{
+				{Name: "cpu", Stress: &v1alpha1.CompositeStressFault{StressngStressors: "--cpu 1"}},
+				{Name: "shift", Time: &v1alpha1.CompositeTimeFault{TimeOffset: "-1h"}},
+			},
+		},
+	}
+	records := []*v1alpha1.Record{{Id: "default/pod/container", SelectorKey: ".", Phase: v1alpha1.NotInjected}}
+
+	// nothing has been injected, so there is nothing to roll back
+	phase, err := impl.Apply(context.TODO(), 0, records, chaos)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(phase).To(Equal(v1alpha1.NotInjected))
+	g.Expect(calls).To(Equal(withId("default/pod/container", "apply stress")))
+	g.Expect(chaos.Status.Instances[records[0].Id].RollingBack).To(BeFalse())
+}
+
+func TestNetworkFaultSharedByContainers(t *testing.T) {
+	g := NewWithT(t)
+
+	var calls []string
+	network := &fakeImpl{name: "network", calls: &calls, wait: true}
+	impl := &Impl{
+		Log:     logr.Discard(),
+		network: network,
+		time:    &fakeImpl{name: "time", calls: &calls},
+	}
+	chaos := &v1alpha1.CompositeChaos{
+		Spec: v1alpha1.CompositeChaosSpec{
+			Faults: []v1alpha1.CompositeFault{
+				{Name: "delay", Network: &v1alpha1.CompositeNetworkFault{Action: v1alpha1.DelayAction}},
+				{Name: "shift", Time: &v1alpha1.CompositeTimeFault{TimeOffset: "-1h"}},
+			},
+		},
+	}
+	records := []*v1alpha1.Record{
+		{Id: "default/pod/app", SelectorKey: ".", Phase: v1alpha1.NotInjected},
+		{Id: "default/pod/sidecar", SelectorKey: ".", Phase: v1alpha1.NotInjected},
+	}
+	apply := func(index int) v1alpha1.Phase {
+		phase, err := impl.Apply(context.TODO(), index, records, chaos)
+		g.Expect(err).NotTo(HaveOccurred())
+		records[index].Phase = phase
+		return phase
+	}
+	recoverAt := func(index int) v1alpha1.Phase {
+		phase, err := impl.Recover(context.TODO(), index, records, chaos)
+		g.Expect(err).NotTo(HaveOccurred())
+		records[index].Phase = phase
+		return phase
+	}
+
+	// the sidecar waits for the network fault being injected into the pod by the app container
+	g.Expect(apply(0)).To(Equal(waitForApplySync))
+	g.Expect(apply(1)).To(Equal(waitForApplySync))
+	g.Expect(calls).To(Equal([]string{"apply network default/pod/app"}))
+
+	calls = nil
+	network.wait = false
+	g.Expect(apply(0)).To(Equal(v1alpha1.Injected))
+	g.Expect(apply(1)).To(Equal(v1alpha1.Injected))
+	g.Expect(calls).To(Equal([]string{
+		"apply network default/pod/app",
+		"apply time default/pod/app",
+		"apply time default/pod/sidecar",
+	}))
+
+	// the network fault is kept until the last container of the pod is recovered
+	calls = nil
+	g.Expect(recoverAt(0)).To(Equal(v1alpha1.NotInjected))
+	g.Expect(calls).To(Equal([]string{"recover time default/pod/app"}))
+
+	calls = nil
+	g.Expect(recoverAt(1)).To(Equal(v1alpha1.NotInjected))
+	g.Expect(calls).To(Equal([]string{
+		"recover time default/pod/sidecar",
+		"recover network default/pod/sidecar",
+	}))
+	g.Expect(chaos.Status.Instances).To(BeEmpty())
+}
@@ -102,16 +102,21 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		Source: m.Source,
 		Port:   httpchaos.Spec.Port,
 		PodHttpChaosBaseRule: v1alpha1.PodHttpChaosBaseRule{
-			Target: httpchaos.Spec.Target,
+			Target:    httpchaos.Spec.Target,
+			Direction: httpchaos.Spec.Direction,
 			Selector: v1alpha1.PodHttpChaosSelector{
 				Port:            &httpchaos.Spec.Port,
 				Path:            httpchaos.Spec.Path,
+				Host:            httpchaos.Spec.Host,
 				Method:          httpchaos.Spec.Method,
 				Code:            httpchaos.Spec.Code,
 				RequestHeaders:  httpchaos.Spec.RequestHeaders,
 				ResponseHeaders: httpchaos.Spec.ResponseHeaders,
 			},
 			Actions: httpchaos.Spec.PodHttpChaosActions,
+			Percent: httpchaos.Spec.Percent,
+			Times:   httpchaos.Spec.Times,
+			Rate:    httpchaos.Spec.Rate,
 		},
 	})
 
@@ -18,15 +18,11 @@ package physicalmachinechaos
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
-	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
 	"strings"
-	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
@@ -35,7 +31,7 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
-	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosd"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
 )
 
@@ -185,15 +181,10 @@ func (impl *Impl) doHttpRequest(method, url string, data io.Reader) (int, string
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	var httpClient *http.Client
-	if config.ControllerCfg.ChaosdSecurityMode {
-		httpClient, err = securityHTTPClient(url)
-		if err != nil {
-			impl.Log.Error(err, "generate HTTPS client")
-			return 0, "", err
-		}
-	} else {
-		httpClient = &http.Client{Timeout: 5 * time.Second}
+	httpClient, err := chaosd.NewHTTPClient(url)
+	if err != nil {
+		impl.Log.Error(err, "generate HTTP client")
+		return 0, "", err
 	}
 
 	resp, err := httpClient.Do(req)
@@ -212,35 +203,6 @@ func (impl *Impl) doHttpRequest(method, url string, data io.Reader) (int, string
 	return resp.StatusCode, string(body), nil
 }
 
-func securityHTTPClient(url string) (*http.Client, error) {
-	if !strings.Contains(url, "https") {
-		return nil, errors.Errorf("a secure url should begin with `https` rather than `http`, url: %s", url)
-	}
-
-	pair, err := tls.LoadX509KeyPair(config.ControllerCfg.ChaosdClientCert, config.ControllerCfg.ChaosdClientKey)
-	if err != nil {
-		return nil, errors.Wrap(err, "load x509 key pair failed")
-	}
-
-	pool := x509.NewCertPool()
-	ca, err := os.ReadFile(config.ControllerCfg.ChaosdCACert)
-	if err != nil {
-		return nil, errors.Wrap(err, "read ChaosdCACert file failed")
-	}
-	pool.AppendCertsFromPEM(ca)
-
-	return &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				RootCAs:      pool,
-				Certificates: []tls.Certificate{pair},
-				ServerName:   "chaosd.chaos-mesh.org",
-			},
-		},
-		Timeout: 5 * time.Second,
-	}, nil
-}
-
 func NewImpl(c client.Client, log logr.Logger) *impltypes.ChaosImplPair {
 	return &impltypes.ChaosImplPair{
 		Name:   "physicalmachinechaos",
@@ -0,0 +1,159 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podfaultchaos
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"go.uber.org/fx"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	impltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ impltypes.ChaosImpl = (*Impl)(nil)
+
+type Impl struct {
+	client.Client
+	Log logr.Logger
+
+	decoder *utils.ContainerRecordDecoder
+}
+
+func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	impl.Log.Info("podfaultchaos apply", "record", records[index])
+
+	podfaultchaos := obj.(*v1alpha1.PodFaultChaos)
+	if podfaultchaos.Status.Instances == nil {
+		podfaultchaos.Status.Instances = make(map[string]string)
+	}
+	if _, ok := podfaultchaos.Status.Instances[records[index].Id]; ok {
+		impl.Log.Info("the podfaultchaos has already been injected")
+		return v1alpha1.Injected, nil
+	}
+
+	spec, err := actionSpec(&podfaultchaos.Spec)
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	res, err := pbClient.ApplyPodFault(ctx, &pb.ApplyPodFaultRequest{
+		ContainerId: decodedContainer.ContainerId,
+		Action:      string(podfaultchaos.Spec.Action),
+		Spec:        spec,
+	})
+	if err != nil {
+		return v1alpha1.NotInjected, err
+	}
+
+	podfaultchaos.Status.Instances[records[index].Id] = res.State
+	return v1alpha1.Injected, nil
+}
+
+func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Record, obj v1alpha1.InnerObject) (v1alpha1.Phase, error) {
+	impl.Log.Info("podfaultchaos recover", "record", records[index])
+
+	podfaultchaos := obj.(*v1alpha1.PodFaultChaos)
+	state, ok := podfaultchaos.Status.Instances[records[index].Id]
+	if !ok {
+		impl.Log.Info("the podfaultchaos has already been recovered")
+		return v1alpha1.NotInjected, nil
+	}
+
+	spec, err := actionSpec(&podfaultchaos.Spec)
+	if err != nil {
+		return v1alpha1.Injected, err
+	}
+
+	decodedContainer, err := impl.decoder.DecodeContainerRecord(ctx, records[index], obj)
+	pbClient := decodedContainer.PbClient
+	if pbClient != nil {
+		defer pbClient.Close()
+	}
+	if err != nil {
+		if errors.Is(err, utils.ErrContainerNotFound) {
+			// pretend the disappeared container has been recovered
+			delete(podfaultchaos.Status.Instances, records[index].Id)
+			return v1alpha1.NotInjected, nil
+		}
+		return v1alpha1.Injected, err
+	}
+
+	if _, err = pbClient.RecoverPodFault(ctx, &pb.RecoverPodFaultRequest{
+		ContainerId: decodedContainer.ContainerId,
+		Action:      string(podfaultchaos.Spec.Action),
+		Spec:        spec,
+		State:       state,
+	}); err != nil {
+		return v1alpha1.Injected, err
+	}
+
+	delete(podfaultchaos.Status.Instances, records[index].Id)
+	return v1alpha1.NotInjected, nil
+}
+
+// actionSpec returns the json encoded configuration of the action, whose json key is the same as the action
+func actionSpec(spec *v1alpha1.PodFaultChaosSpec) (string, error) {
+	expInfo, err := json.Marshal(&spec.PodFaultExpInfo)
+	if err != nil {
+		return "", errors.Wrap(err, "encode the configuration")
+	}
+
+	configs := map[string]json.RawMessage{}
+	if err := json.Unmarshal(expInfo, &configs); err != nil {
+		return "", errors.Wrap(err, "decode the configuration")
+	}
+
+	config, ok := configs[string(spec.Action)]
+	if !ok {
+		return "", errors.Errorf("the configuration of %s action is required", spec.Action)
+	}
+	return string(config), nil
+}
+
+func NewImpl(c client.Client, log logr.Logger, decoder *utils.ContainerRecordDecoder) *impltypes.ChaosImplPair {
+	return &impltypes.ChaosImplPair{
+		Name:   "podfaultchaos",
+		Object: &v1alpha1.PodFaultChaos{},
+		Impl: &Impl{
+			Client:  c,
+			Log:     log.WithName("podfaultchaos"),
+			decoder: decoder,
+		},
+	}
+}
+
+var Module = fx.Provide(
+	fx.Annotated{
+		Group:  "impl",
+		Target: NewImpl,
+	},
+)
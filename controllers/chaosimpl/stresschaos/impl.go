@@ -65,7 +65,7 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 	stressors := stresschaos.Spec.StressngStressors
 	cpuStressors := ""
 	memoryStressors := ""
-	if len(stressors) == 0 {
+	if len(stressors) == 0 && stresschaos.Spec.Stressors != nil {
 		cpuStressors, memoryStressors, err = stresschaos.Spec.Stressors.Normalize()
 		if err != nil {
 			impl.Log.Info("fail to ")
@@ -81,16 +81,27 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		MemoryStressors: memoryStressors,
 		EnterNS:         true,
 	}
-	if stresschaos.Spec.Stressors.MemoryStressor != nil {
+	if stresschaos.Spec.Stressors != nil && stresschaos.Spec.Stressors.MemoryStressor != nil {
 		req.OomScoreAdj = int32(stresschaos.Spec.Stressors.MemoryStressor.OOMScoreAdj)
 	}
+	if stresschaos.Spec.Throttle != nil {
+		values, err := stresschaos.Spec.Throttle.Values()
+		if err != nil {
+			return v1alpha1.NotInjected, err
+		}
+		req.Throttle = &pb.CgroupThrottle{
+			CpuMax:     values.CPUMax,
+			MemoryHigh: values.MemoryHigh,
+			IoMax:      values.IOMax,
+		}
+	}
 	res, err := pbClient.ExecStressors(ctx, &req)
 
 	if err != nil {
 		return v1alpha1.NotInjected, err
 	}
 	// TODO: support custom status
-	stresschaos.Status.Instances[records[index].Id] = v1alpha1.StressInstance{
+	instance := v1alpha1.StressInstance{
 		UID: res.CpuInstance,
 		StartTime: &metav1.Time{
 			Time: time.Unix(res.CpuStartTime/1000, (res.CpuStartTime%1000)*int64(time.Millisecond)),
@@ -99,7 +110,17 @@ func (impl *Impl) Apply(ctx context.Context, index int, records []*v1alpha1.Reco
 		MemoryStartTime: &metav1.Time{
 			Time: time.Unix(res.MemoryStartTime/1000, (res.MemoryStartTime%1000)*int64(time.Millisecond)),
 		},
+		CgroupPath:   res.CgroupPath,
+		CgroupDriver: res.CgroupDriver,
+	}
+	if res.OriginalThrottle != nil {
+		instance.OriginalThrottle = &v1alpha1.CgroupThrottleValues{
+			CPUMax:     res.OriginalThrottle.CpuMax,
+			MemoryHigh: res.OriginalThrottle.MemoryHigh,
+			IOMax:      res.OriginalThrottle.IoMax,
+		}
 	}
+	stresschaos.Status.Instances[records[index].Id] = instance
 
 	return v1alpha1.Injected, nil
 }
@@ -130,6 +151,14 @@ func (impl *Impl) Recover(ctx context.Context, index int, records []*v1alpha1.Re
 	req := &pb.CancelStressRequest{
 		CpuInstance:    instance.UID,
 		MemoryInstance: instance.MemoryUID,
+		CgroupPath:     instance.CgroupPath,
+	}
+	if instance.OriginalThrottle != nil {
+		req.OriginalThrottle = &pb.CgroupThrottle{
+			CpuMax:     instance.OriginalThrottle.CPUMax,
+			MemoryHigh: instance.OriginalThrottle.MemoryHigh,
+			IoMax:      instance.OriginalThrottle.IOMax,
+		}
 	}
 	if instance.StartTime != nil {
 		req.CpuStartTime = instance.StartTime.UnixNano() / int64(time.Millisecond)
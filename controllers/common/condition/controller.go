@@ -17,6 +17,7 @@ package condition
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	"github.com/go-logr/logr"
@@ -121,8 +122,18 @@ func diffConditions(obj v1alpha1.InnerObject) (newConditionMap map[v1alpha1.Chao
 	}
 
 	// If records is `nil`, we don't need to check the `allInjected` and `allRecovered` conditions.
+	// The unsupported targets are never injected, so they are not taken into account.
+	var supported, unsupported []*v1alpha1.Record
+	for _, record := range records {
+		if record.Unsupported != "" {
+			unsupported = append(unsupported, record)
+		} else {
+			supported = append(supported, record)
+		}
+	}
+
 	allInjected := corev1.ConditionFalse
-	if records != nil && every(records, func(record *v1alpha1.Record) bool {
+	if len(supported) > 0 && every(supported, func(record *v1alpha1.Record) bool {
 		return record.Phase == v1alpha1.Injected
 	}) {
 		allInjected = corev1.ConditionTrue
@@ -142,6 +153,17 @@ func diffConditions(obj v1alpha1.InnerObject) (newConditionMap map[v1alpha1.Chao
 		Status: allRecovered,
 	}
 
+	if len(unsupported) > 0 {
+		newConditionMap[v1alpha1.ConditionAllTargetsSupported] = StatusAndReason{
+			Status: corev1.ConditionFalse,
+			Reason: fmt.Sprintf("%d of %d targets are unsupported, %s: %s", len(unsupported), len(records), unsupported[0].Id, unsupported[0].Unsupported),
+		}
+	} else {
+		newConditionMap[v1alpha1.ConditionAllTargetsSupported] = StatusAndReason{
+			Status: corev1.ConditionTrue,
+		}
+	}
+
 	if obj.IsPaused() {
 		newConditionMap[v1alpha1.ConditionPaused] = StatusAndReason{
 			Status: corev1.ConditionTrue,
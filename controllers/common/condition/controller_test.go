@@ -73,5 +73,24 @@ var _ = Describe("Test condition controller", func() {
 
 			Expect(newConditionMap[v1alpha1.ConditionAllRecovered].Status).To(Equal(corev1.ConditionTrue))
 		})
+
+		It("AllTargetsSupported state should be false when some records are unsupported", func() {
+			obj := reconciler.Object.DeepCopyObject().(v1alpha1.InnerObject)
+			obj.GetStatus().Experiment.Records = append(obj.GetStatus().Experiment.Records, &v1alpha1.Record{
+				Id:    "default/pod-1",
+				Phase: v1alpha1.Injected,
+			}, &v1alpha1.Record{
+				Id:          "default/pod-2",
+				Phase:       v1alpha1.NotInjected,
+				Unsupported: "fuse is unavailable on node node-1",
+			})
+			newConditionMap := diffConditions(obj)
+
+			Expect(newConditionMap[v1alpha1.ConditionAllTargetsSupported]).To(Equal(StatusAndReason{
+				Status: corev1.ConditionFalse,
+				Reason: "1 of 2 targets are unsupported, default/pod-2: fuse is unavailable on node node-1",
+			}))
+			Expect(newConditionMap[v1alpha1.ConditionAllInjected].Status).To(Equal(corev1.ConditionTrue))
+		})
 	})
 })
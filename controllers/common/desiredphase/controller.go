@@ -20,6 +20,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
@@ -28,6 +29,7 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaospolicy"
 )
 
 // Reconciler for common chaos
@@ -61,7 +63,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		Reconciler:   r,
 		shouldUpdate: false,
 	}
-	return reconcileInfo.Reconcile(req)
+	return reconcileInfo.Reconcile(ctx, req)
 }
 
 type reconcileInfo struct {
@@ -70,13 +72,19 @@ type reconcileInfo struct {
 	*Reconciler
 	shouldUpdate bool
 	requeueAfter time.Duration
+	// intermittent is the status of the intermittent chaos, which is nil if the chaos is not intermittent
+	intermittent *v1alpha1.IntermittentStatus
 }
 
 func (info *reconcileInfo) GetCreationTimestamp() metav1.Time {
 	return info.obj.GetCreationTimestamp()
 }
 
-func (info *reconcileInfo) CalcDesiredPhase() (v1alpha1.DesiredPhase, []recorder.ChaosEvent) {
+// concurrencyRetryInterval is the interval to check again whether the stopped chaos could start running within the
+// MaxConcurrent of ChaosPolicies
+const concurrencyRetryInterval = 30 * time.Second
+
+func (info *reconcileInfo) CalcDesiredPhase(ctx context.Context) (v1alpha1.DesiredPhase, []recorder.ChaosEvent, error) {
 	events := []recorder.ChaosEvent{}
 
 	// Consider the finalizers
@@ -84,13 +92,13 @@ func (info *reconcileInfo) CalcDesiredPhase() (v1alpha1.DesiredPhase, []recorder
 		if info.obj.GetStatus().Experiment.DesiredPhase != v1alpha1.StoppedPhase {
 			events = append(events, recorder.Deleted{})
 		}
-		return v1alpha1.StoppedPhase, events
+		return v1alpha1.StoppedPhase, events, nil
 	}
 
 	if info.obj.IsOneShot() {
 		// An oneshot chaos should always be in running phase, so that it cannot
 		// be applied multiple times or cause other bugs :(
-		return v1alpha1.RunningPhase, events
+		return v1alpha1.RunningPhase, events, nil
 	}
 
 	// Consider the duration
@@ -104,7 +112,7 @@ func (info *reconcileInfo) CalcDesiredPhase() (v1alpha1.DesiredPhase, []recorder
 		if info.obj.GetStatus().Experiment.DesiredPhase != v1alpha1.StoppedPhase {
 			events = append(events, recorder.TimeUp{})
 		}
-		return v1alpha1.StoppedPhase, events
+		return v1alpha1.StoppedPhase, events, nil
 	}
 
 	info.requeueAfter = untilStop
@@ -114,20 +122,88 @@ func (info *reconcileInfo) CalcDesiredPhase() (v1alpha1.DesiredPhase, []recorder
 		if info.obj.GetStatus().Experiment.DesiredPhase != v1alpha1.StoppedPhase {
 			events = append(events, recorder.Paused{})
 		}
-		return v1alpha1.StoppedPhase, events
+		return v1alpha1.StoppedPhase, events, nil
+	}
+
+	// Then stop the chaos during the blackout windows of ChaosPolicies
+	policies, err := chaospolicy.Governing(ctx, info.Client, chaospolicy.Namespaces(info.obj))
+	if err != nil {
+		// requeue rather than keep the chaos running during an unknown blackout window
+		return "", nil, err
+	}
+	blackout, err := chaospolicy.ActiveBlackout(policies, now)
+	if err != nil {
+		info.Log.Error(err, "failed to parse blackout windows")
+	}
+	if blackout != nil {
+		if info.obj.GetStatus().Experiment.DesiredPhase != v1alpha1.StoppedPhase {
+			events = append(events, recorder.Blackout{Policy: blackout.Policy})
+		}
+		info.requeueBefore(blackout.Until.Sub(now))
+		return v1alpha1.StoppedPhase, events, nil
+	}
+	nextBlackout, err := chaospolicy.NextBlackout(policies, now)
+	if err != nil {
+		info.Log.Error(err, "failed to parse blackout windows")
+	}
+	if nextBlackout != nil {
+		info.requeueBefore(nextBlackout.Sub(now))
+	}
+
+	// Then inject and recover the intermittent chaos in turn
+	if obj, ok := info.obj.(v1alpha1.IntermittentObject); ok && obj.GetIntermittent() != nil {
+		intermittent, err := nextIntermittentStatus(obj.GetIntermittent(), obj.GetStatus().Experiment.Intermittent, now, randomJitter)
+		if err != nil {
+			info.Log.Error(err, "failed to calculate the period of intermittent chaos")
+		} else {
+			info.intermittent = intermittent
+			info.requeueBefore(intermittent.PeriodEndTime.Sub(now))
+			if intermittent.Phase == v1alpha1.StoppedPhase {
+				if info.obj.GetStatus().Experiment.DesiredPhase != v1alpha1.StoppedPhase {
+					events = append(events, recorder.OffPeriod{Cycle: intermittent.Cycles})
+				}
+				return v1alpha1.StoppedPhase, events, nil
+			}
+		}
+	}
+
+	// Then keep the stopped chaos stopped if it would exceed the MaxConcurrent of ChaosPolicies, e.g. once the
+	// blackout window ends
+	if info.obj.GetStatus().Experiment.DesiredPhase == v1alpha1.StoppedPhase {
+		policy, err := chaospolicy.ConcurrencyExceeded(ctx, info.Client, policies, info.obj)
+		if err != nil {
+			return "", nil, err
+		}
+		if policy != nil {
+			events = append(events, recorder.ConcurrencyExceeded{Policy: policy.Name})
+			info.requeueBefore(concurrencyRetryInterval)
+			return v1alpha1.StoppedPhase, events, nil
+		}
 	}
 
 	if info.obj.GetStatus().Experiment.DesiredPhase != v1alpha1.RunningPhase {
 		events = append(events, recorder.Started{})
 	}
-	return v1alpha1.RunningPhase, events
+	return v1alpha1.RunningPhase, events, nil
+}
+
+// requeueBefore makes sure the object will be reconciled again in the duration
+func (info *reconcileInfo) requeueBefore(duration time.Duration) {
+	if info.requeueAfter == 0 || duration < info.requeueAfter {
+		info.requeueAfter = duration
+	}
 }
 
-func (info *reconcileInfo) Reconcile(req ctrl.Request) (ctrl.Result, error) {
-	desiredPhase, events := info.CalcDesiredPhase()
+func (info *reconcileInfo) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	desiredPhase, events, err := info.CalcDesiredPhase(ctx)
+	if err != nil {
+		info.Log.Error(err, "failed to calculate the desired phase")
+		return ctrl.Result{}, err
+	}
 
 	info.Log.Info("modify desiredPhase", "desiredPhase", desiredPhase)
-	if info.obj.GetStatus().Experiment.DesiredPhase != desiredPhase {
+	intermittentChanged := info.intermittent != nil && !equality.Semantic.DeepEqual(info.intermittent, info.obj.GetStatus().Experiment.Intermittent)
+	if info.obj.GetStatus().Experiment.DesiredPhase != desiredPhase || intermittentChanged {
 		for _, ev := range events {
 			info.Recorder.Event(info.obj, ev)
 		}
@@ -140,8 +216,11 @@ func (info *reconcileInfo) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 				return err
 			}
 
-			if obj.GetStatus().Experiment.DesiredPhase != desiredPhase {
+			if obj.GetStatus().Experiment.DesiredPhase != desiredPhase || intermittentChanged {
 				obj.GetStatus().Experiment.DesiredPhase = desiredPhase
+				if intermittentChanged {
+					obj.GetStatus().Experiment.Intermittent = info.intermittent
+				}
 				info.Log.Info("update object", "namespace", obj.GetNamespace(), "name", obj.GetName())
 				return info.Client.Update(context.TODO(), obj)
 			}
@@ -0,0 +1,79 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package desiredphase
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// nextIntermittentStatus returns the status of the intermittent chaos at `now`. The chaos starts with an on
+// period, and turns to the next period once the current one ends. The `jitter` returns a random duration
+// between 0 and the maximum jitter, which is added to every period.
+func nextIntermittentStatus(spec *v1alpha1.IntermittentSpec, status *v1alpha1.IntermittentStatus, now time.Time, jitter func(time.Duration) time.Duration) (*v1alpha1.IntermittentStatus, error) {
+	onPeriod, err := time.ParseDuration(spec.OnPeriod)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse on period")
+	}
+	offPeriod, err := time.ParseDuration(spec.OffPeriod)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse off period")
+	}
+	var maxJitter time.Duration
+	if spec.Jitter != "" {
+		maxJitter, err = time.ParseDuration(spec.Jitter)
+		if err != nil {
+			return nil, errors.Wrap(err, "parse jitter")
+		}
+	}
+
+	if status == nil {
+		return &v1alpha1.IntermittentStatus{
+			Phase:         v1alpha1.RunningPhase,
+			PeriodEndTime: metav1.NewTime(now.Add(onPeriod + jitter(maxJitter))),
+			Cycles:        1,
+		}, nil
+	}
+
+	if now.Before(status.PeriodEndTime.Time) {
+		return status, nil
+	}
+
+	// the next period starts from now, rather than the end of the last period, in case the controller has
+	// missed several periods
+	next := status.DeepCopy()
+	if status.Phase == v1alpha1.RunningPhase {
+		next.Phase = v1alpha1.StoppedPhase
+		next.PeriodEndTime = metav1.NewTime(now.Add(offPeriod + jitter(maxJitter)))
+	} else {
+		next.Phase = v1alpha1.RunningPhase
+		next.PeriodEndTime = metav1.NewTime(now.Add(onPeriod + jitter(maxJitter)))
+		next.Cycles++
+	}
+	return next, nil
+}
+
+func randomJitter(max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(max) + 1))
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package desiredphase
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestNextIntermittentStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+	spec := &v1alpha1.IntermittentSpec{OnPeriod: "1m", OffPeriod: "2m", Jitter: "10s"}
+	jitter := func(max time.Duration) time.Duration {
+		g.Expect(max).To(Equal(10 * time.Second))
+		return time.Second
+	}
+
+	// start with an on period
+	status, err := nextIntermittentStatus(spec, nil, now, jitter)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(&v1alpha1.IntermittentStatus{
+		Phase:         v1alpha1.RunningPhase,
+		PeriodEndTime: metav1.NewTime(now.Add(time.Minute + time.Second)),
+		Cycles:        1,
+	}))
+
+	// keep the current period
+	next, err := nextIntermittentStatus(spec, status, now.Add(time.Minute), jitter)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next).To(Equal(status))
+
+	// turn to the off period
+	now = now.Add(time.Minute + time.Second)
+	status, err = nextIntermittentStatus(spec, status, now, jitter)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(&v1alpha1.IntermittentStatus{
+		Phase:         v1alpha1.StoppedPhase,
+		PeriodEndTime: metav1.NewTime(now.Add(2*time.Minute + time.Second)),
+		Cycles:        1,
+	}))
+
+	// start the next cycle
+	now = now.Add(time.Hour)
+	status, err = nextIntermittentStatus(spec, status, now, jitter)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status).To(Equal(&v1alpha1.IntermittentStatus{
+		Phase:         v1alpha1.RunningPhase,
+		PeriodEndTime: metav1.NewTime(now.Add(time.Minute + time.Second)),
+		Cycles:        2,
+	}))
+
+	_, err = nextIntermittentStatus(&v1alpha1.IntermittentSpec{OnPeriod: "1x", OffPeriod: "1m"}, nil, now, jitter)
+	g.Expect(err).To(HaveOccurred())
+}
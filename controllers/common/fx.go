@@ -35,6 +35,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/config"
 	"github.com/chaos-mesh/chaos-mesh/controllers/types"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/builder"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
 	"github.com/chaos-mesh/chaos-mesh/pkg/selector"
@@ -51,6 +52,7 @@ type Params struct {
 	Impls           []*chaosimpltypes.ChaosImplPair `group:"impl"`
 	Reader          client.Reader                   `name:"no-cache"`
 	Steps           []pipeline.PipelineStep
+	Prober          *chaosdaemon.Prober `optional:"true"`
 }
 
 func Bootstrap(params Params) error {
@@ -125,6 +127,32 @@ func Bootstrap(params Params) error {
 			predicaters = append(predicaters, PickChildCRDPredicate{})
 		}
 
+		// Reconcile all the objects of this kind when ChaosPolicies change,
+		// so that the blackout windows take effect immediately
+		objectList := pair.ObjectList
+		builder.Watches(&v1alpha1.ChaosPolicy{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				list := objectList.DeepCopyList()
+				err := kubeclient.List(ctx, list)
+				if err != nil {
+					setupLog.Error(err, "fail to list object")
+					return nil
+				}
+
+				reqs := []reconcile.Request{}
+				for _, item := range list.GetItems() {
+					reqs = append(reqs, reconcile.Request{
+						NamespacedName: k8sTypes.NamespacedName{
+							Namespace: item.GetNamespace(),
+							Name:      item.GetName(),
+						},
+					})
+				}
+				return reqs
+			}),
+		)
+		predicaters = append(predicaters, ChaosPolicyPredicate{})
+
 		pipe := pipeline.NewPipeline(&pipeline.PipelineContext{
 			Logger: logger,
 			Object: &types.Object{
@@ -137,6 +165,7 @@ func Bootstrap(params Params) error {
 			Reader:          reader,
 			RecorderBuilder: recorderBuilder,
 			Selector:        selector,
+			Prober:          params.Prober,
 		})
 
 		pipe.AddSteps(params.Steps...)
@@ -168,6 +197,18 @@ func (PickChildCRDPredicate) Update(e event.UpdateEvent) bool {
 	return false
 }
 
+// ChaosPolicyPredicate allows the update events of ChaosPolicy to trigger the
+// Reconcile of Chaos CRD.
+type ChaosPolicyPredicate struct {
+	predicate.Funcs
+}
+
+// Update implements UpdateEvent filter for ChaosPolicy.
+func (ChaosPolicyPredicate) Update(e event.UpdateEvent) bool {
+	_, ok := e.ObjectNew.(*v1alpha1.ChaosPolicy)
+	return ok
+}
+
 // StatusRecordEventsChangePredicate skip the update event,
 // when we Only update object.status.experiment.records[].events
 type StatusRecordEventsChangePredicate struct {
@@ -213,7 +254,7 @@ func (RemoteChaosPredicate) Create(e event.CreateEvent) bool {
 		return true
 	}
 
-	if obj.GetRemoteCluster() == "" {
+	if !v1alpha1.IsRemoteChaos(obj) {
 		return true
 	}
 
@@ -226,7 +267,7 @@ func (RemoteChaosPredicate) Update(e event.UpdateEvent) bool {
 		return true
 	}
 
-	if obj.GetRemoteCluster() == "" {
+	if !v1alpha1.IsRemoteChaos(obj) {
 		return true
 	}
 
@@ -239,7 +280,7 @@ func (RemoteChaosPredicate) Delete(e event.DeleteEvent) bool {
 		return true
 	}
 
-	if obj.GetRemoteCluster() == "" {
+	if !v1alpha1.IsRemoteChaos(obj) {
 		return true
 	}
 
@@ -252,7 +293,7 @@ func (RemoteChaosPredicate) Generic(e event.GenericEvent) bool {
 		return true
 	}
 
-	if obj.GetRemoteCluster() == "" {
+	if !v1alpha1.IsRemoteChaos(obj) {
 		return true
 	}
 
@@ -26,6 +26,7 @@ import (
 
 	chaosimpltypes "github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
 	"github.com/chaos-mesh/chaos-mesh/controllers/types"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
 	"github.com/chaos-mesh/chaos-mesh/pkg/selector"
 )
@@ -45,6 +46,7 @@ type PipelineContext struct {
 	RecorderBuilder *recorder.RecorderBuilder
 	Impl            chaosimpltypes.ChaosImpl
 	Selector        *selector.Selector
+	Prober          *chaosdaemon.Prober
 }
 
 type PipelineStep func(ctx *PipelineContext) reconcile.Reconciler
@@ -19,8 +19,10 @@ import (
 	"context"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/util/retry"
@@ -30,6 +32,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/types"
 	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
 	"github.com/chaos-mesh/chaos-mesh/pkg/selector"
 )
@@ -49,6 +52,10 @@ type Reconciler struct {
 
 	Selector *selector.Selector
 
+	// Prober is used to check whether the targets are capable of the chaos before injection. The check is
+	// skipped if it's nil.
+	Prober *chaosdaemon.Prober
+
 	Log logr.Logger
 }
 
@@ -81,6 +88,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	logger := r.Log.WithValues("name", obj.GetName(), "namespace", obj.GetNamespace(), "kind", obj.GetObjectKind().GroupVersionKind().Kind)
 
+	lastSelectedTime := obj.GetStatus().Experiment.LastSelectedTime
+	var reselectAfter time.Duration
 	if records == nil {
 		for name, sel := range selectors {
 			targets, err := r.Selector.Select(context.TODO(), sel)
@@ -99,19 +108,40 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 					Activity: "select targets",
 					Err:      "no target has been selected",
 				})
-				return ctrl.Result{}, nil
+				// the targets may be created later, if the selector has a reselect policy
+				return ctrl.Result{RequeueAfter: reselectInterval(selectors)}, nil
 			}
 
 			for _, target := range targets {
-				records = append(records, &v1alpha1.Record{
+				record := &v1alpha1.Record{
 					Id:          target.Id(),
 					SelectorKey: name,
 					Phase:       v1alpha1.NotInjected,
-				})
+				}
+				r.markUnsupported(ctx, obj, record, target)
+				records = append(records, record)
 				shouldUpdate = true
 			}
 		}
-		// TODO: dynamic upgrade the records when some of these pods/containers stopped
+		lastSelectedTime = &metav1.Time{Time: time.Now()}
+	} else if interval := reselectInterval(selectors); interval > 0 && desiredPhase == v1alpha1.RunningPhase {
+		if lastSelectedTime == nil || !time.Now().Before(lastSelectedTime.Add(interval)) {
+			records = r.reselect(context.TODO(), obj, selectors, records, logger)
+			lastSelectedTime = &metav1.Time{Time: time.Now()}
+			shouldUpdate = true
+		}
+		reselectAfter = time.Until(lastSelectedTime.Add(interval))
+	}
+
+	statusChecks, err := getStatusChecks(context.TODO(), r.Client, obj.GetNamespace(), selectors)
+	if err != nil {
+		logger.Error(err, "unable to get the status checks of the rollout")
+		return ctrl.Result{Requeue: true}, nil
+	}
+	plan := planRollout(selectors, records, statusChecks, time.Now())
+	rollouts := plan.statuses
+	if !equality.Semantic.DeepEqual(rollouts, obj.GetStatus().Experiment.Rollouts) {
+		shouldUpdate = true
 	}
 
 	needRetry := false
@@ -148,6 +178,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			}
 		}
 
+		if operation == Apply && record.Unsupported != "" {
+			idLogger.Info("skip the target which is not capable of the chaos", "reason", record.Unsupported)
+			continue
+		}
+
+		if operation == Apply && desiredPhase == v1alpha1.RunningPhase && !plan.released[index] {
+			idLogger.Info("waiting for the previous batches of the rollout")
+			continue
+		}
+
 		if operation == Apply {
 			idLogger.Info("apply chaos")
 			record.Phase, err = r.Impl.Apply(context.TODO(), index, records, obj)
@@ -239,6 +279,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			}
 
 			obj.GetStatus().Experiment.Records = records
+			obj.GetStatus().Experiment.Rollouts = rollouts
+			obj.GetStatus().Experiment.LastSelectedTime = lastSelectedTime
 			if objWithStatus, ok := obj.(v1alpha1.InnerObjectWithCustomStatus); ok {
 				ptrToCustomStatus := objWithStatus.GetCustomStatus()
 				// TODO: auto generate SetCustomStatus rather than reflect
@@ -259,7 +301,25 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			Field: "records",
 		})
 	}
-	return ctrl.Result{Requeue: needRetry}, nil
+	requeueAfter := plan.requeueAfter
+	if reselectAfter > 0 && (requeueAfter == 0 || reselectAfter < requeueAfter) {
+		requeueAfter = reselectAfter
+	}
+	return ctrl.Result{Requeue: needRetry, RequeueAfter: requeueAfter}, nil
+}
+
+// markUnsupported marks the record as unsupported, if the target is not capable of the chaos
+func (r *Reconciler) markUnsupported(ctx context.Context, obj v1alpha1.InnerObjectWithSelector, record *v1alpha1.Record, target selector.Target) {
+	record.Unsupported = r.checkTarget(ctx, obj, target)
+	if record.Unsupported == "" {
+		return
+	}
+
+	r.Log.Info("target is not capable of the chaos", "id", record.Id, "reason", record.Unsupported)
+	r.Recorder.Event(obj, recorder.TargetUnsupported{
+		Id:    record.Id,
+		Cause: record.Unsupported,
+	})
 }
 
 func newRecordEvent(eventType v1alpha1.RecordEventType, eventStage v1alpha1.RecordEventOperation, msg string) *v1alpha1.RecordEvent {
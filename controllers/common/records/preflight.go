@@ -0,0 +1,161 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package records
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/container"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/pod"
+)
+
+// requirements are the capabilities of the node which are required by a chaos
+type requirements struct {
+	kernelModules []string
+	fuse          bool
+	bpf           bool
+	ptrace        bool
+	cgroupV2      bool
+}
+
+func (r *requirements) addKernelModules(modules ...string) {
+	for _, module := range modules {
+		found := false
+		for _, existing := range r.kernelModules {
+			if existing == module {
+				found = true
+				break
+			}
+		}
+		if !found {
+			r.kernelModules = append(r.kernelModules, module)
+		}
+	}
+}
+
+func (r *requirements) empty() bool {
+	return len(r.kernelModules) == 0 && !r.fuse && !r.bpf && !r.ptrace && !r.cgroupV2
+}
+
+// addNetworkRequirements adds the kernel modules required by the traffic control of the network chaos
+func (r *requirements) addNetworkRequirements(action v1alpha1.NetworkChaosAction, hasTargets bool) {
+	switch action {
+	case v1alpha1.NetemAction, v1alpha1.DelayAction, v1alpha1.LossAction, v1alpha1.DuplicateAction, v1alpha1.CorruptAction:
+		r.addKernelModules("sch_netem")
+	case v1alpha1.BandwidthAction:
+		r.addKernelModules("sch_tbf")
+	}
+	// the traffic to the targets is matched with ipset
+	if hasTargets || action == v1alpha1.PartitionAction {
+		r.addKernelModules("ip_set", "xt_set")
+	}
+}
+
+// requirementsOf returns the capabilities of the node required by the chaos
+func requirementsOf(obj v1alpha1.InnerObject) requirements {
+	var r requirements
+	switch chaos := obj.(type) {
+	case *v1alpha1.NetworkChaos:
+		r.addNetworkRequirements(chaos.Spec.Action, chaos.Spec.Target != nil || len(chaos.Spec.ExternalTargets) > 0)
+	case *v1alpha1.IOChaos:
+		r.fuse = true
+	case *v1alpha1.TimeChaos, *v1alpha1.JVMChaos:
+		r.ptrace = true
+	case *v1alpha1.KernelChaos:
+		r.bpf = true
+	case *v1alpha1.StressChaos:
+		r.cgroupV2 = chaos.Spec.Throttle != nil
+	case *v1alpha1.CompositeChaos:
+		for _, fault := range chaos.Spec.Faults {
+			if fault.Network != nil {
+				r.addNetworkRequirements(fault.Network.Action, len(fault.Network.ExternalTargets) > 0)
+			}
+			if fault.IO != nil {
+				r.fuse = true
+			}
+			if fault.Time != nil {
+				r.ptrace = true
+			}
+		}
+	}
+	return r
+}
+
+// unsupportedReason returns the reason why the node is not capable of the requirements, or an empty string
+// if it's capable
+func unsupportedReason(r requirements, host *pb.HostCapabilities, nodeName string) string {
+	var missing []string
+	for _, module := range r.kernelModules {
+		for _, m := range host.MissingKernelModules {
+			if m == module {
+				missing = append(missing, module)
+				break
+			}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Sprintf("kernel module %s is missing on node %s", strings.Join(missing, ", "), nodeName)
+	}
+	if r.fuse && !host.Fuse {
+		return fmt.Sprintf("fuse is unavailable on node %s", nodeName)
+	}
+	if r.ptrace && !host.Ptrace {
+		return fmt.Sprintf("ptrace is not permitted on node %s", nodeName)
+	}
+	if r.bpf && !host.Bpf {
+		return fmt.Sprintf("bpf is not permitted on node %s", nodeName)
+	}
+	// the cgroup version is unknown if it's empty
+	if r.cgroupV2 && host.CgroupVersion != "" && host.CgroupVersion != "v2" {
+		return fmt.Sprintf("cgroup v2 is required on node %s, but it's %s", nodeName, host.CgroupVersion)
+	}
+	return ""
+}
+
+// checkTarget returns the reason why the target is not capable of the chaos. An empty string is returned if
+// the target is capable, or if its capabilities are unknown.
+func (r *Reconciler) checkTarget(ctx context.Context, obj v1alpha1.InnerObject, target selector.Target) string {
+	if r.Prober == nil {
+		return ""
+	}
+
+	var targetPod *v1.Pod
+	switch t := target.(type) {
+	case *pod.Pod:
+		targetPod = &t.Pod
+	case *container.Container:
+		targetPod = &t.Pod
+	default:
+		return ""
+	}
+
+	requirements := requirementsOf(obj)
+	if requirements.empty() {
+		return ""
+	}
+	host := r.Prober.HostCapabilities(ctx, targetPod)
+	if host == nil {
+		return ""
+	}
+	return unsupportedReason(requirements, host, targetPod.Spec.NodeName)
+}
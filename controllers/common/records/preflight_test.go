@@ -0,0 +1,97 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package records
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func TestUnsupportedReason(t *testing.T) {
+	g := NewWithT(t)
+
+	host := &pb.HostCapabilities{
+		MissingKernelModules: []string{"sch_tbf", "xt_set"},
+		Fuse:                 true,
+		Bpf:                  false,
+		Ptrace:               true,
+		CgroupVersion:        "hybrid",
+	}
+
+	type TestCase struct {
+		name   string
+		obj    v1alpha1.InnerObject
+		reason string
+	}
+
+	tcs := []TestCase{
+		{
+			name: "delay without targets",
+			obj:  &v1alpha1.NetworkChaos{Spec: v1alpha1.NetworkChaosSpec{Action: v1alpha1.DelayAction}},
+		},
+		{
+			name:   "bandwidth",
+			obj:    &v1alpha1.NetworkChaos{Spec: v1alpha1.NetworkChaosSpec{Action: v1alpha1.BandwidthAction}},
+			reason: "kernel module sch_tbf is missing on node node-1",
+		},
+		{
+			name: "delay to external targets",
+			obj: &v1alpha1.NetworkChaos{Spec: v1alpha1.NetworkChaosSpec{
+				Action:          v1alpha1.DelayAction,
+				ExternalTargets: []string{"1.1.1.1"},
+			}},
+			reason: "kernel module xt_set is missing on node node-1",
+		},
+		{
+			name: "io chaos",
+			obj:  &v1alpha1.IOChaos{},
+		},
+		{
+			name:   "kernel chaos",
+			obj:    &v1alpha1.KernelChaos{},
+			reason: "bpf is not permitted on node node-1",
+		},
+		{
+			name: "stress chaos",
+			obj:  &v1alpha1.StressChaos{},
+		},
+		{
+			name:   "stress chaos with throttle",
+			obj:    &v1alpha1.StressChaos{Spec: v1alpha1.StressChaosSpec{Throttle: &v1alpha1.CgroupThrottle{CPU: "500m"}}},
+			reason: "cgroup v2 is required on node node-1, but it's hybrid",
+		},
+		{
+			name: "pod chaos",
+			obj:  &v1alpha1.PodChaos{},
+		},
+		{
+			name: "composite chaos",
+			obj: &v1alpha1.CompositeChaos{Spec: v1alpha1.CompositeChaosSpec{Faults: []v1alpha1.CompositeFault{
+				{Name: "time", Time: &v1alpha1.CompositeTimeFault{}},
+				{Name: "bandwidth", Network: &v1alpha1.CompositeNetworkFault{Action: v1alpha1.BandwidthAction}},
+			}}},
+			reason: "kernel module sch_tbf is missing on node node-1",
+		},
+	}
+
+	for _, tc := range tcs {
+		g.Expect(unsupportedReason(requirementsOf(tc.obj), host, "node-1")).To(Equal(tc.reason), tc.name)
+	}
+}
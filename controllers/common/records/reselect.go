@@ -0,0 +1,186 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package records
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/generic"
+)
+
+// defaultReselectInterval is used if the interval of the reselect policy is empty
+const defaultReselectInterval = time.Minute
+
+// reselectInterval returns the shortest interval of the reselect policies of the selectors, or 0 if none of the
+// selectors has a reselect policy
+func reselectInterval(selectors map[string]interface{}) time.Duration {
+	var interval time.Duration
+	for _, selector := range selectors {
+		selector, ok := selector.(v1alpha1.SelectorWithReselect)
+		if !ok || selector.GetReselect() == nil {
+			continue
+		}
+
+		// the interval has been validated by the webhook
+		current, _ := time.ParseDuration(selector.GetReselect().Interval)
+		if current <= 0 {
+			current = defaultReselectInterval
+		}
+		if interval == 0 || current < interval {
+			interval = current
+		}
+	}
+	return interval
+}
+
+// reselect selects the targets of the selectors which have a reselect policy again. The records of the targets
+// which are gone are recovered and dropped, and the new targets are appended to the records, to keep the number
+// of targets required by the mode of the selector.
+func (r *Reconciler) reselect(ctx context.Context, obj v1alpha1.InnerObjectWithSelector, selectors map[string]interface{}, records []*v1alpha1.Record, logger logr.Logger) []*v1alpha1.Record {
+	var keys []string
+	for key := range selectors {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	gone := map[int]struct{}{}
+	var added []*v1alpha1.Record
+	byId := map[string]selector.Target{}
+	for _, key := range keys {
+		selector, ok := selectors[key].(v1alpha1.SelectorWithReselect)
+		if !ok || selector.GetReselect() == nil {
+			continue
+		}
+
+		targets, err := r.Selector.Select(ctx, selector.WithAllMode())
+		if err != nil {
+			logger.Error(err, "fail to reselect", "selectorKey", key)
+			continue
+		}
+		var candidates []string
+		for _, target := range targets {
+			candidates = append(candidates, target.Id())
+			byId[target.Id()] = target
+		}
+
+		mode, value := selector.GetMode()
+		desired, err := generic.MaxObjectsByMode(mode, value, len(candidates))
+		if err != nil {
+			logger.Error(err, "fail to reselect", "selectorKey", key)
+			continue
+		}
+		if mode == v1alpha1.RandomMaxPercentMode {
+			// the number of targets is random in this mode, so keep the number selected at first
+			desired = min(desired, countRecords(records, key))
+		}
+
+		goneIndexes, newRecords := reselectTargets(key, records, candidates, desired)
+		for _, record := range newRecords {
+			r.markUnsupported(ctx, obj, record, byId[record.Id])
+		}
+		for _, index := range goneIndexes {
+			gone[index] = struct{}{}
+		}
+		added = append(added, newRecords...)
+	}
+
+	if len(gone) == 0 && len(added) == 0 {
+		return records
+	}
+
+	var reselected []*v1alpha1.Record
+	for index, record := range records {
+		if _, ok := gone[index]; !ok {
+			reselected = append(reselected, record)
+			continue
+		}
+
+		if strings.HasPrefix(string(record.Phase), string(v1alpha1.Injected)) {
+			// the target has gone, so the chaos is recovered in best effort
+			if _, err := r.Impl.Recover(ctx, index, records, obj); err != nil {
+				logger.Error(err, "fail to recover the chaos on the target which has gone", "id", record.Id)
+			}
+		}
+		logger.Info("drop the record of the target which has gone", "id", record.Id)
+	}
+	reselected = append(reselected, added...)
+
+	r.Recorder.Event(obj, recorder.Reselected{
+		Added:   len(added),
+		Dropped: len(gone),
+	})
+	return reselected
+}
+
+// reselectTargets returns the indexes of the records of the selector whose targets are not among the candidates
+// anymore, and the records of the new targets to be injected, to keep `desired` targets selected.
+func reselectTargets(key string, records []*v1alpha1.Record, candidates []string, desired int) ([]int, []*v1alpha1.Record) {
+	matched := make(map[string]struct{}, len(candidates))
+	for _, candidate := range candidates {
+		matched[candidate] = struct{}{}
+	}
+
+	var gone []int
+	selected := map[string]struct{}{}
+	for index, record := range records {
+		if record.SelectorKey != key {
+			continue
+		}
+		if _, ok := matched[record.Id]; !ok {
+			gone = append(gone, index)
+			continue
+		}
+		selected[record.Id] = struct{}{}
+	}
+
+	var fresh []string
+	for _, candidate := range candidates {
+		if _, ok := selected[candidate]; !ok {
+			fresh = append(fresh, candidate)
+		}
+	}
+
+	var added []*v1alpha1.Record
+	if count := desired - len(selected); count > 0 && len(fresh) > 0 {
+		for _, index := range generic.RandomFixedIndexes(0, uint(len(fresh)), uint(count)) {
+			added = append(added, &v1alpha1.Record{
+				Id:          fresh[index],
+				SelectorKey: key,
+				Phase:       v1alpha1.NotInjected,
+			})
+		}
+	}
+
+	return gone, added
+}
+
+func countRecords(records []*v1alpha1.Record, key string) int {
+	count := 0
+	for _, record := range records {
+		if record.SelectorKey == key {
+			count++
+		}
+	}
+	return count
+}
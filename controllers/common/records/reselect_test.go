@@ -0,0 +1,113 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package records
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestReselectTargets(t *testing.T) {
+	g := NewWithT(t)
+
+	record := func(key, id string) *v1alpha1.Record {
+		return &v1alpha1.Record{Id: id, SelectorKey: key, Phase: v1alpha1.Injected}
+	}
+	ids := func(records []*v1alpha1.Record) []string {
+		var ids []string
+		for _, record := range records {
+			ids = append(ids, record.Id)
+		}
+		return ids
+	}
+
+	type TestCase struct {
+		name       string
+		records    []*v1alpha1.Record
+		candidates []string
+		desired    int
+		gone       []int
+		added      []string
+	}
+
+	tcs := []TestCase{
+		{
+			name:       "nothing changed",
+			records:    []*v1alpha1.Record{record(".", "a"), record(".", "b")},
+			candidates: []string{"a", "b", "c"},
+			desired:    2,
+		},
+		{
+			name:       "replace the pod which has gone",
+			records:    []*v1alpha1.Record{record(".", "a"), record(".", "b")},
+			candidates: []string{"b", "c"},
+			desired:    2,
+			gone:       []int{0},
+			added:      []string{"c"},
+		},
+		{
+			name:       "inject the new pods",
+			records:    []*v1alpha1.Record{record(".", "a")},
+			candidates: []string{"a", "b"},
+			desired:    2,
+			added:      []string{"b"},
+		},
+		{
+			name:       "drop the pods which have gone without candidates",
+			records:    []*v1alpha1.Record{record(".", "a"), record(".", "b")},
+			candidates: []string{"b"},
+			desired:    1,
+			gone:       []int{0},
+		},
+		{
+			name:       "ignore the records of other selectors",
+			records:    []*v1alpha1.Record{record(".Target", "a"), record(".", "b")},
+			candidates: []string{"c"},
+			desired:    1,
+			gone:       []int{1},
+			added:      []string{"c"},
+		},
+	}
+
+	for _, tc := range tcs {
+		gone, added := reselectTargets(".", tc.records, tc.candidates, tc.desired)
+		g.Expect(gone).To(Equal(tc.gone), tc.name)
+		g.Expect(ids(added)).To(Equal(tc.added), tc.name)
+		for _, record := range added {
+			g.Expect(record.SelectorKey).To(Equal("."), tc.name)
+			g.Expect(record.Phase).To(Equal(v1alpha1.NotInjected), tc.name)
+		}
+	}
+}
+
+func TestReselectInterval(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(reselectInterval(map[string]interface{}{
+		".": &v1alpha1.PodSelector{},
+	})).To(BeZero())
+	g.Expect(reselectInterval(map[string]interface{}{
+		".": &v1alpha1.ContainerSelector{PodSelector: v1alpha1.PodSelector{Reselect: &v1alpha1.ReselectSpec{}}},
+	})).To(Equal(defaultReselectInterval))
+	g.Expect(reselectInterval(map[string]interface{}{
+		".":       &v1alpha1.PodSelector{Reselect: &v1alpha1.ReselectSpec{Interval: "5m"}},
+		".Target": &v1alpha1.PodSelector{Reselect: &v1alpha1.ReselectSpec{Interval: "30s"}},
+	})).To(Equal(30 * time.Second))
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package records
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// statusCheckPollInterval is the interval to check the status check of a rollout again, if it's not found or
+// not completed yet
+const statusCheckPollInterval = 10 * time.Second
+
+// rolloutPlan decides which records are allowed to be applied, according to the rollouts of their selectors
+type rolloutPlan struct {
+	// released represents whether every record is allowed to be applied
+	released []bool
+	// statuses are the progress of the rollouts, sorted by the selector key
+	statuses []v1alpha1.RolloutStatus
+	// requeueAfter is the duration after which the rollouts should be checked again
+	requeueAfter time.Duration
+}
+
+// planRollout plans the rollouts of the selectors.
+//
+// The progress of a rollout is derived from the records themselves: the batches containing a record
+// which has been applied once are released, so the rollout continues from the same batch after the chaos
+// is paused and resumed, or after the controller restarts.
+func planRollout(selectors map[string]interface{}, records []*v1alpha1.Record, statusChecks map[string]*v1alpha1.StatusCheck, now time.Time) rolloutPlan {
+	plan := rolloutPlan{
+		released: make([]bool, len(records)),
+	}
+
+	groups := map[string][]int{}
+	var keys []string
+	for index, record := range records {
+		// the unsupported targets are never injected, so they are left out of the batches
+		if record.Unsupported != "" {
+			continue
+		}
+		if _, ok := groups[record.SelectorKey]; !ok {
+			keys = append(keys, record.SelectorKey)
+		}
+		groups[record.SelectorKey] = append(groups[record.SelectorKey], index)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		indexes := groups[key]
+		count := len(indexes)
+		if spec := getRollout(selectors[key]); spec != nil {
+			var status v1alpha1.RolloutStatus
+			var wait time.Duration
+			count, status, wait = rollout(spec, records, indexes, statusChecks[key], now)
+			status.SelectorKey = key
+			plan.statuses = append(plan.statuses, status)
+			if wait > 0 && (plan.requeueAfter == 0 || wait < plan.requeueAfter) {
+				plan.requeueAfter = wait
+			}
+		}
+
+		for _, index := range indexes[:count] {
+			plan.released[index] = true
+		}
+	}
+
+	return plan
+}
+
+// rollout returns the number of released records among the indexes, the progress of the rollout, and the
+// duration to wait if the next batch is not allowed to be released for now
+func rollout(spec *v1alpha1.RolloutSpec, records []*v1alpha1.Record, indexes []int, statusCheck *v1alpha1.StatusCheck, now time.Time) (int, v1alpha1.RolloutStatus, time.Duration) {
+	total := len(indexes)
+	batchSize := spec.BatchSize
+	if batchSize <= 0 {
+		batchSize = total
+	}
+	// the interval has been validated by the webhook
+	interval, _ := time.ParseDuration(spec.Interval)
+
+	status := v1alpha1.RolloutStatus{
+		Phase:        v1alpha1.RolloutProgressing,
+		TotalBatches: (total + batchSize - 1) / batchSize,
+	}
+
+	count := 0
+	for position, index := range indexes {
+		if records[index].Phase != v1alpha1.NotInjected || len(records[index].Events) > 0 {
+			count = position + 1
+		}
+	}
+	// round up to the whole batch
+	count = min((count+batchSize-1)/batchSize*batchSize, total)
+	status.ReleasedBatches = (count + batchSize - 1) / batchSize
+
+	if statusCheck != nil && statusCheckFailed(statusCheck) {
+		status.Phase = v1alpha1.RolloutHalted
+		status.Message = fmt.Sprintf("status check %s failed", spec.StatusCheck)
+		return count, status, 0
+	}
+
+	if count == 0 {
+		status.ReleasedBatches = 1
+		return min(batchSize, total), status, 0
+	}
+
+	var finishedAt time.Time
+	for _, index := range indexes[(count-1)/batchSize*batchSize : count] {
+		record := records[index]
+		event := lastApplyEvent(record)
+
+		var finished bool
+		switch {
+		case record.Phase == v1alpha1.Injected:
+			finished = true
+		case event != nil && event.Type == v1alpha1.TypeFailed:
+			if spec.StopOnFailure {
+				status.Phase = v1alpha1.RolloutHalted
+				status.Message = fmt.Sprintf("failed to inject %s: %s", record.Id, event.Message)
+				return count, status, 0
+			}
+			finished = true
+		}
+		if !finished {
+			return count, status, 0
+		}
+
+		if event != nil && event.Timestamp != nil && event.Timestamp.After(finishedAt) {
+			finishedAt = event.Timestamp.Time
+		}
+	}
+
+	if count == total {
+		status.Phase = v1alpha1.RolloutCompleted
+		return count, status, 0
+	}
+
+	next := finishedAt.Add(interval)
+	if now.Before(next) {
+		status.NextBatchTime = &metav1.Time{Time: next}
+		return count, status, next.Sub(now)
+	}
+
+	if spec.StatusCheck != "" {
+		if statusCheck == nil {
+			status.Message = fmt.Sprintf("status check %s is not found", spec.StatusCheck)
+			return count, status, statusCheckPollInterval
+		}
+		if statusCheck.Spec.Mode == v1alpha1.StatusCheckSynchronous && !statusCheck.IsCompleted() {
+			status.Message = fmt.Sprintf("waiting for status check %s to complete", spec.StatusCheck)
+			return count, status, statusCheckPollInterval
+		}
+	}
+
+	status.ReleasedBatches++
+	return min(count+batchSize, total), status, 0
+}
+
+// getStatusChecks gets the status checks referred by the rollouts of the selectors, in the namespace of the
+// chaos. The status checks which are not found are omitted.
+func getStatusChecks(ctx context.Context, c client.Client, namespace string, selectors map[string]interface{}) (map[string]*v1alpha1.StatusCheck, error) {
+	statusChecks := map[string]*v1alpha1.StatusCheck{}
+	for key, selector := range selectors {
+		spec := getRollout(selector)
+		if spec == nil || spec.StatusCheck == "" {
+			continue
+		}
+
+		statusCheck := &v1alpha1.StatusCheck{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: spec.StatusCheck}, statusCheck); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		statusChecks[key] = statusCheck
+	}
+	return statusChecks, nil
+}
+
+func getRollout(selector interface{}) *v1alpha1.RolloutSpec {
+	if selector, ok := selector.(v1alpha1.SelectorWithRollout); ok {
+		return selector.GetRollout()
+	}
+	return nil
+}
+
+func statusCheckFailed(statusCheck *v1alpha1.StatusCheck) bool {
+	for _, condition := range statusCheck.Status.Conditions {
+		if condition.Type == v1alpha1.StatusCheckConditionFailureThresholdExceed {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func lastApplyEvent(record *v1alpha1.Record) *v1alpha1.RecordEvent {
+	for i := len(record.Events) - 1; i >= 0; i-- {
+		if record.Events[i].Operation == v1alpha1.Apply {
+			return &record.Events[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package records
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestPlanRollout(t *testing.T) {
+	g := NewWithT(t)
+
+	now := time.Now()
+	injected := func(at time.Time) *v1alpha1.Record {
+		return &v1alpha1.Record{
+			SelectorKey: ".",
+			Phase:       v1alpha1.Injected,
+			Events:      []v1alpha1.RecordEvent{*v1alpha1.NewRecordEvent(v1alpha1.TypeSucceeded, v1alpha1.Apply, "", metav1.NewTime(at))},
+		}
+	}
+	failed := func(at time.Time) *v1alpha1.Record {
+		return &v1alpha1.Record{
+			SelectorKey: ".",
+			Phase:       v1alpha1.NotInjected,
+			Events:      []v1alpha1.RecordEvent{*v1alpha1.NewRecordEvent(v1alpha1.TypeFailed, v1alpha1.Apply, "failed", metav1.NewTime(at))},
+		}
+	}
+	notInjected := func() *v1alpha1.Record {
+		return &v1alpha1.Record{SelectorKey: ".", Phase: v1alpha1.NotInjected}
+	}
+	selector := func(rollout *v1alpha1.RolloutSpec) map[string]interface{} {
+		return map[string]interface{}{
+			".": &v1alpha1.PhysicalMachineSelector{Rollout: rollout},
+		}
+	}
+
+	statusCheck := func(mode v1alpha1.StatusCheckMode, conditions ...v1alpha1.StatusCheckCondition) *v1alpha1.StatusCheck {
+		return &v1alpha1.StatusCheck{
+			Spec:   v1alpha1.StatusCheckSpec{Mode: mode},
+			Status: v1alpha1.StatusCheckStatus{Conditions: conditions},
+		}
+	}
+
+	type TestCase struct {
+		name         string
+		rollout      *v1alpha1.RolloutSpec
+		statusCheck  *v1alpha1.StatusCheck
+		records      []*v1alpha1.Record
+		released     []bool
+		phase        v1alpha1.RolloutPhase
+		requeueAfter time.Duration
+	}
+
+	tcs := []TestCase{
+		{
+			name:     "without rollout",
+			records:  []*v1alpha1.Record{notInjected(), notInjected(), notInjected()},
+			released: []bool{true, true, true},
+		},
+		{
+			name:     "release the first batch",
+			rollout:  &v1alpha1.RolloutSpec{BatchSize: 2, Interval: "1m"},
+			records:  []*v1alpha1.Record{notInjected(), notInjected(), notInjected()},
+			released: []bool{true, true, false},
+			phase:    v1alpha1.RolloutProgressing,
+		},
+		{
+			name:     "wait for the batch to be injected",
+			rollout:  &v1alpha1.RolloutSpec{BatchSize: 2},
+			records:  []*v1alpha1.Record{injected(now), notInjected(), notInjected()},
+			released: []bool{true, true, false},
+			phase:    v1alpha1.RolloutProgressing,
+		},
+		{
+			name:         "wait for the interval",
+			rollout:      &v1alpha1.RolloutSpec{BatchSize: 2, Interval: "1m"},
+			records:      []*v1alpha1.Record{injected(now.Add(-time.Minute)), injected(now.Add(-30 * time.Second)), notInjected()},
+			released:     []bool{true, true, false},
+			phase:        v1alpha1.RolloutProgressing,
+			requeueAfter: 30 * time.Second,
+		},
+		{
+			name:     "release the next batch after the interval",
+			rollout:  &v1alpha1.RolloutSpec{BatchSize: 2, Interval: "1m"},
+			records:  []*v1alpha1.Record{injected(now.Add(-2 * time.Minute)), injected(now.Add(-time.Minute)), notInjected()},
+			released: []bool{true, true, true},
+			phase:    v1alpha1.RolloutProgressing,
+		},
+		{
+			name:     "continue on failure",
+			rollout:  &v1alpha1.RolloutSpec{BatchSize: 1},
+			records:  []*v1alpha1.Record{failed(now), notInjected()},
+			released: []bool{true, true},
+			phase:    v1alpha1.RolloutProgressing,
+		},
+		{
+			name:     "stop on failure",
+			rollout:  &v1alpha1.RolloutSpec{BatchSize: 1, StopOnFailure: true},
+			records:  []*v1alpha1.Record{failed(now), notInjected()},
+			released: []bool{true, false},
+			phase:    v1alpha1.RolloutHalted,
+		},
+		{
+			name:     "resume from the released batches",
+			rollout:  &v1alpha1.RolloutSpec{BatchSize: 2, Interval: "1h"},
+			records:  []*v1alpha1.Record{notInjected(), failed(now), notInjected(), notInjected()},
+			released: []bool{true, true, false, false},
+			phase:    v1alpha1.RolloutProgressing,
+		},
+		{
+			name:     "complete the rollout",
+			rollout:  &v1alpha1.RolloutSpec{BatchSize: 2, Interval: "1h"},
+			records:  []*v1alpha1.Record{injected(now), injected(now)},
+			released: []bool{true, true},
+			phase:    v1alpha1.RolloutCompleted,
+		},
+		{
+			name:         "wait for the status check to be created",
+			rollout:      &v1alpha1.RolloutSpec{BatchSize: 1, StatusCheck: "check"},
+			records:      []*v1alpha1.Record{injected(now), notInjected()},
+			released:     []bool{true, false},
+			phase:        v1alpha1.RolloutProgressing,
+			requeueAfter: statusCheckPollInterval,
+		},
+		{
+			name:         "wait for the synchronous status check to complete",
+			rollout:      &v1alpha1.RolloutSpec{BatchSize: 1, StatusCheck: "check"},
+			statusCheck:  statusCheck(v1alpha1.StatusCheckSynchronous),
+			records:      []*v1alpha1.Record{injected(now), notInjected()},
+			released:     []bool{true, false},
+			phase:        v1alpha1.RolloutProgressing,
+			requeueAfter: statusCheckPollInterval,
+		},
+		{
+			name:        "release the next batch if the status check is healthy",
+			rollout:     &v1alpha1.RolloutSpec{BatchSize: 1, StatusCheck: "check"},
+			statusCheck: statusCheck(v1alpha1.StatusCheckContinuous),
+			records:     []*v1alpha1.Record{injected(now), notInjected()},
+			released:    []bool{true, true},
+			phase:       v1alpha1.RolloutProgressing,
+		},
+		{
+			name:    "halt if the status check fails",
+			rollout: &v1alpha1.RolloutSpec{BatchSize: 1, StatusCheck: "check"},
+			statusCheck: statusCheck(v1alpha1.StatusCheckContinuous, v1alpha1.StatusCheckCondition{
+				Type:   v1alpha1.StatusCheckConditionFailureThresholdExceed,
+				Status: corev1.ConditionTrue,
+			}),
+			records:  []*v1alpha1.Record{injected(now), notInjected()},
+			released: []bool{true, false},
+			phase:    v1alpha1.RolloutHalted,
+		},
+	}
+
+	for _, tc := range tcs {
+		plan := planRollout(selector(tc.rollout), tc.records, map[string]*v1alpha1.StatusCheck{".": tc.statusCheck}, now)
+		g.Expect(plan.released).To(Equal(tc.released), tc.name)
+		g.Expect(plan.requeueAfter).To(Equal(tc.requeueAfter), tc.name)
+		if tc.rollout == nil {
+			g.Expect(plan.statuses).To(BeEmpty(), tc.name)
+		} else {
+			g.Expect(plan.statuses).To(HaveLen(1), tc.name)
+			g.Expect(plan.statuses[0].Phase).To(Equal(tc.phase), tc.name)
+		}
+	}
+}
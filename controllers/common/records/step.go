@@ -29,6 +29,7 @@ func Step(ctx *pipeline.PipelineContext) reconcile.Reconciler {
 		Reader:   ctx.Reader,
 		Recorder: ctx.RecorderBuilder.Build("records"),
 		Selector: ctx.Selector,
+		Prober:   ctx.Prober,
 		Log:      ctx.Logger.WithName("records"),
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/clusterregistry"
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/remotechaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/remotecluster"
+	"github.com/chaos-mesh/chaos-mesh/controllers/physicalmachine"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podhttpchaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podiochaos"
 	"github.com/chaos-mesh/chaos-mesh/controllers/podnetworkchaos"
@@ -36,6 +37,7 @@ import (
 var Module = fx.Options(
 	fx.Provide(
 		chaosdaemon.New,
+		chaosdaemon.NewProber,
 		recorder.NewRecorderBuilder,
 		common.AllSteps,
 		clusterregistry.New,
@@ -48,6 +50,7 @@ var Module = fx.Options(
 	fx.Invoke(statuscheck.Bootstrap),
 	fx.Invoke(remotecluster.Bootstrap),
 	fx.Invoke(remotechaos.Bootstrap),
+	fx.Invoke(physicalmachine.Bootstrap),
 
 	schedule.Module,
 	chaosimpl.AllImpl,
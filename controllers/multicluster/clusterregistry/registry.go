@@ -19,12 +19,14 @@ import (
 	"context"
 	"os"
 	"sync"
+	"time"
 
 	fxlogr "github.com/chaos-mesh/fx-logr"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"go.uber.org/fx"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -37,8 +39,12 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/types"
 )
 
+// probeTimeout is the timeout of requesting the API server of remote cluster in Probe
+const probeTimeout = 10 * time.Second
+
 type remoteCluster struct {
-	app *fx.App
+	app    *fx.App
+	config *rest.Config
 
 	client.Client
 }
@@ -131,6 +137,29 @@ func (r *RemoteClusterRegistry) WithClient(name string, f func(c client.Client)
 	return f(cluster.Client)
 }
 
+// Probe checks the readiness of the API server of the remote cluster. The lock is not held while requesting the
+// API server, so a slow remote cluster will not block the others.
+func (r *RemoteClusterRegistry) Probe(ctx context.Context, name string) error {
+	r.lock.Lock()
+	cluster, ok := r.clusters[name]
+	r.lock.Unlock()
+	if !ok {
+		return errors.Wrapf(ErrNotExist, "lookup cluster: %s", name)
+	}
+
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cluster.config)
+	if err != nil {
+		return errors.Wrapf(err, "create discovery client of cluster %s", name)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+	if _, err := discoveryClient.RESTClient().Get().AbsPath("/readyz").DoRaw(ctx); err != nil {
+		return errors.Wrapf(err, "probe readiness of cluster %s", name)
+	}
+	return nil
+}
+
 // Stop stops the running controller-manager which watches the remote cluster.
 func (r *RemoteClusterRegistry) Stop(ctx context.Context, name string) error {
 	r.lock.Lock()
@@ -200,6 +229,7 @@ func (r *RemoteClusterRegistry) Spawn(name string, config *rest.Config) error {
 
 	r.clusters[name] = &remoteCluster{
 		app:    app,
+		config: config,
 		Client: remoteClient,
 	}
 
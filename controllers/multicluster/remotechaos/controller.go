@@ -20,7 +20,10 @@ import (
 	"reflect"
 
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,13 +32,20 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/clusterregistry"
 )
 
+// remoteClients runs functions with the clients of remote clusters, it's implemented by RemoteClusterRegistry
+type remoteClients interface {
+	WithClient(name string, f func(c client.Client) error) error
+}
+
+var _ remoteClients = (*clusterregistry.RemoteClusterRegistry)(nil)
+
 type Reconciler struct {
 	client.Client
 	Log logr.Logger
 
 	Object v1alpha1.InnerObject
 
-	registry *clusterregistry.RemoteClusterRegistry
+	registry remoteClients
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -51,6 +61,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	if v1alpha1.IsFannedOutChaos(obj) {
+		return r.reconcileFanOut(ctx, req, obj)
+	}
+
 	err := r.registry.WithClient(obj.GetRemoteCluster(), func(c client.Client) error {
 		r.Log.Info("handling chaos with remote client", "cluster", obj.GetRemoteCluster())
 
@@ -81,21 +95,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 					})
 				}
 
-				// omit the remoteCluster
-				localSpecValue := reflect.Indirect(reflect.ValueOf(localObj)).FieldByName("Spec")
-				localSpecValue.FieldByName("RemoteCluster").Set(reflect.ValueOf(""))
-
-				// only Spec, Name, Namespace and a label will be initialized
-				newObj := r.Object.DeepCopyObject().(v1alpha1.RemoteObject)
-				reflect.Indirect(reflect.ValueOf(newObj)).FieldByName("Spec").Set(localSpecValue)
-
-				newObj.SetLabels(map[string]string{
-					"chaos-mesh.org/controlled-by": "remote-chaos",
-				})
-				newObj.SetName(obj.GetName())
-				newObj.SetNamespace(obj.GetNamespace())
-
-				return c.Create(ctx, newObj)
+				if err := r.checkRemoteCluster(ctx, obj.GetRemoteCluster()); err != nil {
+					return err
+				}
+				return c.Create(ctx, r.newRemoteChaos(localObj))
 			}
 
 			// TODO: handle this error
@@ -124,3 +127,48 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	return ctrl.Result{}, nil
 }
+
+// newRemoteChaos builds the chaos which will be created in the remote cluster, only Spec, Name, Namespace and a
+// label will be initialized.
+func (r *Reconciler) newRemoteChaos(obj v1alpha1.RemoteObject) v1alpha1.RemoteObject {
+	// omit the remote clusters
+	localSpecValue := reflect.Indirect(reflect.ValueOf(obj.DeepCopyObject())).FieldByName("Spec")
+	for _, name := range []string{"RemoteCluster", "RemoteClusters", "RemoteClusterSelector"} {
+		field := localSpecValue.FieldByName(name)
+		field.Set(reflect.Zero(field.Type()))
+	}
+
+	newObj := r.Object.DeepCopyObject().(v1alpha1.RemoteObject)
+	reflect.Indirect(reflect.ValueOf(newObj)).FieldByName("Spec").Set(localSpecValue)
+
+	newObj.SetLabels(map[string]string{
+		"chaos-mesh.org/controlled-by": "remote-chaos",
+	})
+	newObj.SetName(obj.GetName())
+	newObj.SetNamespace(obj.GetNamespace())
+
+	return newObj
+}
+
+// checkRemoteCluster refuses to dispatch the chaos to the remote cluster, if the remote cluster is not ready or the
+// chaos mesh running in it is incompatible with the installed one.
+func (r *Reconciler) checkRemoteCluster(ctx context.Context, name string) error {
+	var remoteCluster v1alpha1.RemoteCluster
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, &remoteCluster); err != nil {
+		return errors.Wrapf(err, "get remote cluster %s", name)
+	}
+
+	for _, condition := range remoteCluster.Status.Conditions {
+		switch condition.Type {
+		case v1alpha1.RemoteClusterConditionReady:
+			if condition.Status != corev1.ConditionTrue {
+				return errors.Errorf("remote cluster %s is not ready: %s", name, condition.Reason)
+			}
+		case v1alpha1.RemoteClusterConditionVersionCompatible:
+			if condition.Status == corev1.ConditionFalse {
+				return errors.Errorf("remote cluster %s is incompatible: %s", name, condition.Reason)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package remotechaos
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/clusterregistry"
+)
+
+// fanOutFinalizer keeps the fanned out chaos until the chaos in all the remote clusters have been deleted
+const fanOutFinalizer = "chaos-mesh/remotechaos"
+
+// fanOutRetryInterval is the interval to check the remote clusters again, if the chaos could not be created
+// or deleted in some of them
+const fanOutRetryInterval = 10 * time.Second
+
+// reconcileFanOut creates the chaos in every selected remote cluster, and deletes them once the local chaos is
+// being deleted. The states of the chaos in the remote clusters are aggregated by the remotechaosmonitor.
+func (r *Reconciler) reconcileFanOut(ctx context.Context, req ctrl.Request, obj v1alpha1.RemoteObject) (ctrl.Result, error) {
+	clusters, err := r.selectRemoteClusters(ctx, obj)
+	if err != nil {
+		r.Log.Error(err, "unable to select remote clusters")
+		return ctrl.Result{}, err
+	}
+
+	if obj.GetDeletionTimestamp() != nil {
+		for _, status := range obj.GetStatus().RemoteClusters {
+			clusters = append(clusters, status.Name)
+		}
+		return r.cleanFanOut(ctx, req, sets.List(sets.New(clusters...)))
+	}
+
+	// the stale chaos should be deleted, if the remote cluster is not selected anymore
+	selected := sets.New(clusters...)
+	var staleClusters []string
+	for _, status := range obj.GetStatus().RemoteClusters {
+		if !selected.Has(status.Name) {
+			staleClusters = append(staleClusters, status.Name)
+		}
+	}
+	if _, err := r.deleteRemoteChaos(ctx, req, staleClusters); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// the finalizer should be added before creating any remote chaos
+	if err := r.updateFanOutStatus(ctx, req, clusters, nil); err != nil {
+		r.Log.Error(err, "unable to update the status of remote clusters")
+		return ctrl.Result{}, err
+	}
+
+	dispatchErrors := make(map[string]string)
+	for _, cluster := range clusters {
+		err := r.registry.WithClient(cluster, func(c client.Client) error {
+			remoteObj := r.Object.DeepCopyObject().(v1alpha1.RemoteObject)
+			err := c.Get(ctx, req.NamespacedName, remoteObj)
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+
+			if err := r.checkRemoteCluster(ctx, cluster); err != nil {
+				return err
+			}
+			r.Log.Info("creating remote chaos", "cluster", cluster, "namespace", req.Namespace, "name", req.Name)
+			return c.Create(ctx, r.newRemoteChaos(obj))
+		})
+		if err != nil {
+			r.Log.Error(err, "unable to create remote chaos", "cluster", cluster)
+			dispatchErrors[cluster] = err.Error()
+		}
+	}
+
+	if err := r.updateFanOutStatus(ctx, req, clusters, dispatchErrors); err != nil {
+		r.Log.Error(err, "unable to update the status of remote clusters")
+		return ctrl.Result{}, err
+	}
+	if len(dispatchErrors) > 0 {
+		return ctrl.Result{RequeueAfter: fanOutRetryInterval}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// cleanFanOut deletes the chaos in the remote clusters, and removes the finalizer of local chaos after all of
+// them are deleted.
+func (r *Reconciler) cleanFanOut(ctx context.Context, req ctrl.Request, clusters []string) (ctrl.Result, error) {
+	remaining, err := r.deleteRemoteChaos(ctx, req, clusters)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if remaining > 0 {
+		r.Log.Info("waiting for the remote chaos to be deleted", "remaining", remaining)
+		return ctrl.Result{RequeueAfter: fanOutRetryInterval}, nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := r.Object.DeepCopyObject().(v1alpha1.RemoteObject)
+		if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		if !controllerutil.RemoveFinalizer(obj, fanOutFinalizer) {
+			return nil
+		}
+
+		r.Log.Info("removing the finalizer of fanned out chaos", "namespace", req.Namespace, "name", req.Name)
+		return r.Client.Update(ctx, obj)
+	})
+	return ctrl.Result{}, err
+}
+
+// deleteRemoteChaos deletes the chaos in the remote clusters, and returns the count of remote chaos which
+// still exist. The remote clusters which have been removed from the registry are ignored.
+func (r *Reconciler) deleteRemoteChaos(ctx context.Context, req ctrl.Request, clusters []string) (int, error) {
+	remaining := 0
+	for _, cluster := range clusters {
+		err := r.registry.WithClient(cluster, func(c client.Client) error {
+			remoteObj := r.Object.DeepCopyObject().(v1alpha1.RemoteObject)
+			if err := c.Get(ctx, req.NamespacedName, remoteObj); err != nil {
+				return client.IgnoreNotFound(err)
+			}
+
+			remaining++
+			if remoteObj.GetDeletionTimestamp() != nil {
+				return nil
+			}
+			r.Log.Info("deleting remote chaos", "cluster", cluster, "namespace", req.Namespace, "name", req.Name)
+			return client.IgnoreNotFound(c.Delete(ctx, remoteObj))
+		})
+		if errors.Is(err, clusterregistry.ErrNotExist) {
+			r.Log.Info("remote cluster not found, skip deleting remote chaos", "cluster", cluster)
+			continue
+		}
+		if err != nil {
+			return remaining, errors.Wrapf(err, "delete remote chaos in cluster %s", cluster)
+		}
+	}
+
+	return remaining, nil
+}
+
+// updateFanOutStatus adds the finalizer to the local chaos, and keeps the states of selected remote clusters.
+// The errors of remote clusters are not changed if dispatchErrors is nil.
+func (r *Reconciler) updateFanOutStatus(ctx context.Context, req ctrl.Request, clusters []string, dispatchErrors map[string]string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		obj := r.Object.DeepCopyObject().(v1alpha1.RemoteObject)
+		if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+			return err
+		}
+		origin := obj.DeepCopyObject().(v1alpha1.RemoteObject)
+
+		controllerutil.AddFinalizer(obj, fanOutFinalizer)
+		status := obj.GetStatus()
+		status.SetRemoteClusters(clusters)
+		if dispatchErrors != nil {
+			for _, cluster := range clusters {
+				status.SetRemoteClusterError(cluster, dispatchErrors[cluster])
+			}
+		}
+
+		if reflect.DeepEqual(origin.GetFinalizers(), obj.GetFinalizers()) &&
+			reflect.DeepEqual(origin.GetStatus(), obj.GetStatus()) {
+			return nil
+		}
+		return r.Client.Update(ctx, obj)
+	})
+}
+
+// selectRemoteClusters returns the sorted names of remote clusters where the chaos will be fanned out
+func (r *Reconciler) selectRemoteClusters(ctx context.Context, obj v1alpha1.RemoteObject) ([]string, error) {
+	clusters := sets.New(obj.GetRemoteClusters()...)
+
+	if obj.GetRemoteClusterSelector() != nil {
+		selector, err := metav1.LabelSelectorAsSelector(obj.GetRemoteClusterSelector())
+		if err != nil {
+			return nil, errors.Wrap(err, "parse remote cluster selector")
+		}
+
+		var remoteClusters v1alpha1.RemoteClusterList
+		if err := r.Client.List(ctx, &remoteClusters, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, errors.Wrap(err, "list remote clusters")
+		}
+		for _, remoteCluster := range remoteClusters.Items {
+			if remoteCluster.DeletionTimestamp == nil {
+				clusters.Insert(remoteCluster.Name)
+			}
+		}
+	}
+
+	return sets.List(clusters), nil
+}
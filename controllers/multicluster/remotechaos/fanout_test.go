@@ -0,0 +1,158 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package remotechaos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/multicluster/clusterregistry"
+)
+
+// fakeRegistry holds the clients of remote clusters
+type fakeRegistry map[string]client.Client
+
+func (r fakeRegistry) WithClient(name string, f func(c client.Client) error) error {
+	c, ok := r[name]
+	if !ok {
+		return errors.Wrapf(clusterregistry.ErrNotExist, "lookup cluster: %s", name)
+	}
+	return f(c)
+}
+
+func newReadyRemoteCluster(name string, labels map[string]string) *v1alpha1.RemoteCluster {
+	return &v1alpha1.RemoteCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: v1alpha1.RemoteClusterStatus{
+			Conditions: []v1alpha1.RemoteClusterCondition{
+				{Type: v1alpha1.RemoteClusterConditionReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	RegisterTestingT(t)
+
+	scheme := runtime.NewScheme()
+	Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	}
+
+	ctx := context.Background()
+	key := types.NamespacedName{Namespace: "default", Name: "failure"}
+	chaos := &v1alpha1.PodChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: key.Namespace, Name: key.Name},
+		Spec: v1alpha1.PodChaosSpec{
+			Action:         v1alpha1.PodFailureAction,
+			RemoteClusters: []string{"east"},
+			RemoteClusterSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"region": "west"},
+			},
+		},
+	}
+	local := newClient(chaos,
+		newReadyRemoteCluster("east", nil),
+		newReadyRemoteCluster("west", map[string]string{"region": "west"}),
+		newReadyRemoteCluster("north", nil),
+	)
+	registry := fakeRegistry{"east": newClient(), "west": newClient()}
+	r := &Reconciler{
+		Client:   local,
+		Log:      logr.Discard(),
+		Object:   &v1alpha1.PodChaos{},
+		registry: registry,
+	}
+	reconcile := func() ctrl.Result {
+		result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+	get := func(c client.Client) (*v1alpha1.PodChaos, error) {
+		obj := &v1alpha1.PodChaos{}
+		return obj, c.Get(ctx, key, obj)
+	}
+
+	// the chaos is created in the listed cluster and the one selected by labels
+	Expect(reconcile()).To(Equal(ctrl.Result{}))
+	for _, cluster := range []string{"east", "west"} {
+		remote, err := get(registry[cluster])
+		Expect(err).NotTo(HaveOccurred())
+		Expect(remote.Labels).To(HaveKeyWithValue("chaos-mesh.org/controlled-by", "remote-chaos"))
+		Expect(remote.Spec.Action).To(Equal(v1alpha1.PodFailureAction))
+		Expect(remote.Spec.RemoteClusters).To(BeEmpty())
+		Expect(remote.Spec.RemoteClusterSelector).To(BeNil())
+	}
+	obj, err := get(local)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(obj.Finalizers).To(ContainElement(fanOutFinalizer))
+	Expect(obj.Status.RemoteClusters).To(HaveLen(2))
+
+	// the clusters which could not be dispatched to are recorded and retried
+	obj.Spec.RemoteClusters = []string{"east", "north"}
+	Expect(local.Update(ctx, obj)).To(Succeed())
+	Expect(reconcile().RequeueAfter).To(Equal(fanOutRetryInterval))
+	obj, err = get(local)
+	Expect(err).NotTo(HaveOccurred())
+	errs := map[string]string{}
+	for _, status := range obj.Status.RemoteClusters {
+		errs[status.Name] = status.Error
+	}
+	Expect(errs).To(HaveLen(3))
+	Expect(errs["east"]).To(BeEmpty())
+	Expect(errs["west"]).To(BeEmpty())
+	Expect(errs["north"]).To(ContainSubstring("lookup cluster: north"))
+
+	// the chaos in the cluster which is not selected anymore is deleted
+	obj.Spec.RemoteClusters = []string{"east"}
+	obj.Spec.RemoteClusterSelector = nil
+	Expect(local.Update(ctx, obj)).To(Succeed())
+	Expect(reconcile()).To(Equal(ctrl.Result{}))
+	_, err = get(registry["west"])
+	Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	_, err = get(registry["east"])
+	Expect(err).NotTo(HaveOccurred())
+	obj, err = get(local)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(obj.Status.RemoteClusters).To(HaveLen(1))
+	Expect(obj.Status.RemoteClusters[0].Name).To(Equal("east"))
+
+	// the local chaos is kept until the remote chaos are deleted
+	Expect(local.Delete(ctx, obj)).To(Succeed())
+	Expect(reconcile().RequeueAfter).To(Equal(fanOutRetryInterval))
+	_, err = get(registry["east"])
+	Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	_, err = get(local)
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(reconcile()).To(Equal(ctrl.Result{}))
+	_, err = get(local)
+	Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
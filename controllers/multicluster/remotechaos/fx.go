@@ -16,14 +16,21 @@
 package remotechaos
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/go-logr/logr"
 	"go.uber.org/fx"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
+	ctrlbuilder "sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/config"
@@ -59,9 +66,11 @@ func Bootstrap(params Params) error {
 		setupLog.Info("setting up controller", "resource-name", obj.Name)
 
 		builder := builder.Default(mgr).
-			For(obj.Object).
-			Named(obj.Name + "-remotechaos").
-			WithEventFilter(remotePredicates)
+			For(obj.Object, ctrlbuilder.WithPredicates(remotePredicates)).
+			Named(obj.Name + "-remotechaos")
+		// the chaos should be reconciled when the remote clusters change, since the chaos could only be dispatched
+		// to the ready remote clusters, and the ones selected by a selector may change
+		builder.Watches(&v1alpha1.RemoteCluster{}, handler.EnqueueRequestsFromMapFunc(chaosOnRemoteCluster(client, obj.Object, setupLog)))
 		err := builder.Complete(&Reconciler{
 			Client: client,
 			Log:    logger.WithName("remotechaos"),
@@ -80,6 +89,43 @@ func Bootstrap(params Params) error {
 	return nil
 }
 
+// chaosOnRemoteCluster maps the changes of a remote cluster to the chaos deployed in it, and the chaos fanned out
+// by a selector
+func chaosOnRemoteCluster(c client.Client, obj v1alpha1.InnerObject, logger logr.Logger) handler.MapFunc {
+	return func(ctx context.Context, remoteCluster client.Object) []reconcile.Request {
+		kind, ok := v1alpha1.AllKinds()[reflect.TypeOf(obj).Elem().Name()]
+		if !ok {
+			return nil
+		}
+
+		list := kind.SpawnList()
+		if err := c.List(ctx, list); err != nil {
+			logger.Error(err, "fail to list object")
+			return nil
+		}
+
+		var reqs []reconcile.Request
+		for _, item := range list.GetItems() {
+			remoteObj, ok := item.(v1alpha1.RemoteObject)
+			if !ok {
+				continue
+			}
+			if remoteObj.GetRemoteCluster() != remoteCluster.GetName() &&
+				!sets.New(remoteObj.GetRemoteClusters()...).Has(remoteCluster.GetName()) &&
+				remoteObj.GetRemoteClusterSelector() == nil {
+				continue
+			}
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: k8sTypes.NamespacedName{
+					Namespace: item.GetNamespace(),
+					Name:      item.GetName(),
+				},
+			})
+		}
+		return reqs
+	}
+}
+
 // this controller will only create or delete the remote chaos
 var remotePredicates = predicate.Funcs{
 	CreateFunc: func(e event.CreateEvent) bool {
@@ -89,7 +135,7 @@ var remotePredicates = predicate.Funcs{
 			return false
 		}
 
-		if obj.GetRemoteCluster() == "" {
+		if !v1alpha1.IsRemoteChaos(obj) {
 			return false
 		}
 
@@ -104,7 +150,7 @@ var remotePredicates = predicate.Funcs{
 			return false
 		}
 
-		if obj.GetRemoteCluster() == "" {
+		if !v1alpha1.IsRemoteChaos(obj) {
 			fmt.Println("remote cluster is empty")
 			return false
 		}
@@ -120,7 +166,7 @@ var remotePredicates = predicate.Funcs{
 			return false
 		}
 
-		if obj.GetRemoteCluster() == "" {
+		if !v1alpha1.IsRemoteChaos(obj) {
 			fmt.Println("remote cluster is empty")
 			return false
 		}
@@ -90,6 +90,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, nil
 	}
 
+	if v1alpha1.IsFannedOutChaos(localObj) {
+		// the remote chaos of a fanned out chaos is created and deleted by the remotechaos controller
+		if shouldDelete {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, r.aggregateStatus(ctx, req, remoteObj)
+	}
+
 	if shouldDelete {
 		r.logger.Info("deleting local obj", "namespace", localObj.GetNamespace(), "name", localObj.GetName())
 		localObj.SetFinalizers([]string{})
@@ -140,3 +148,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 	return ctrl.Result{}, nil
 }
+
+// aggregateStatus records the status of the remote chaos into the fanned out local chaos
+func (r *Reconciler) aggregateStatus(ctx context.Context, req ctrl.Request, remoteObj v1alpha1.RemoteObject) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		localObj := r.obj.DeepCopyObject().(v1alpha1.RemoteObject)
+		err := r.localClient.Get(ctx, req.NamespacedName, localObj)
+		if err != nil {
+			return client.IgnoreNotFound(err)
+		}
+
+		status := localObj.GetStatus().DeepCopy()
+		status.SetRemoteClusterStatus(r.clusterName, *remoteObj.GetStatus())
+		if reflect.DeepEqual(localObj.GetStatus(), status) {
+			return nil
+		}
+
+		r.logger.Info("aggregating status of remote chaos", "namespace", req.Namespace, "name", req.Name)
+		status.DeepCopyInto(localObj.GetStatus())
+		return r.localClient.Update(ctx, localObj)
+	})
+}
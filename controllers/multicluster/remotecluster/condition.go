@@ -16,6 +16,8 @@
 package remotecluster
 
 import (
+	"sort"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -37,6 +39,10 @@ func setRemoteClusterCondition(obj *v1alpha1.RemoteCluster, typ v1alpha1.RemoteC
 	for _, condition := range conditionMap {
 		conditions = append(conditions, condition)
 	}
+	// keep the order of conditions stable, to avoid updating the status needlessly
+	sort.Slice(conditions, func(i, j int) bool {
+		return conditions[i].Type < conditions[j].Type
+	})
 
 	obj.Status.Conditions = conditions
 }
@@ -17,6 +17,8 @@ package remotecluster
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 
 	"github.com/go-logr/logr"
@@ -47,24 +49,26 @@ type Reconciler struct {
 	client.Client
 }
 
-func (r *Reconciler) getRestConfig(ctx context.Context, secretRef v1alpha1.RemoteClusterSecretRef) (clientcmd.ClientConfig, error) {
+// getRestConfig returns the client config in the secret, and the hash of the kubeconfig to detect its rotation
+func (r *Reconciler) getRestConfig(ctx context.Context, secretRef v1alpha1.RemoteClusterSecretRef) (clientcmd.ClientConfig, string, error) {
 	var secret corev1.Secret
 	err := r.Client.Get(ctx, types.NamespacedName{
 		Namespace: secretRef.Namespace,
 		Name:      secretRef.Name,
 	}, &secret)
 	if err != nil {
-		return nil, errors.Wrapf(err, "get secret %s/%s", secretRef.Namespace, secretRef.Name)
+		return nil, "", errors.Wrapf(err, "get secret %s/%s", secretRef.Namespace, secretRef.Name)
 	}
 
 	kubeconfig := secret.Data[secretRef.Key]
 
 	config, err := clientcmd.Load(kubeconfig)
 	if err != nil {
-		return nil, errors.Wrap(err, "load kubeconfig")
+		return nil, "", errors.Wrap(err, "load kubeconfig")
 	}
 
-	return clientcmd.NewDefaultClientConfig(*config, nil), nil
+	hash := sha256.Sum256(kubeconfig)
+	return clientcmd.NewDefaultClientConfig(*config, nil), hex.EncodeToString(hash[:]), nil
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -82,12 +86,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	r.Log.Info("remote cluster", "Generation:", obj.ObjectMeta.Generation, "ObservedGeneration:", obj.Status.ObservedGeneration)
 
-	if obj.ObjectMeta.Generation <= obj.Status.ObservedGeneration {
+	if obj.ObjectMeta.Generation <= obj.Status.ObservedGeneration && obj.DeletionTimestamp.IsZero() {
 		r.Log.Info("the target remote cluster has been up to date", "remote cluster", obj.Namespace+"/"+obj.Name)
-		return ctrl.Result{}, nil
+		return r.probe(ctx, &obj)
 	}
 
-	clientConfig, err := r.getRestConfig(ctx, obj.Spec.KubeConfig.SecretRef)
+	clientConfig, kubeConfigHash, err := r.getRestConfig(ctx, obj.Spec.KubeConfig.SecretRef)
 	if err != nil {
 		r.Log.Error(err, "fail to get clientConfig from secret")
 		return ctrl.Result{Requeue: true}, nil
@@ -131,7 +135,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{Requeue: true}, nil
 	}
 
-	err = r.ensureClusterControllerManager(ctx, &obj, clientConfig)
+	err = r.ensureClusterControllerManager(ctx, &obj, clientConfig, kubeConfigHash)
 	if err != nil {
 		r.Log.Error(err, "fail to boot remote cluster controller manager")
 		return ctrl.Result{Requeue: true}, nil
@@ -155,6 +159,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			return err
 		}
 		newObj.Status.CurrentVersion = currentVersion
+		newObj.Status.KubeConfigHash = kubeConfigHash
 		newObj.Status.ObservedGeneration = observedGeneration
 		err = r.Client.Status().Update(ctx, &newObj)
 		return err
@@ -167,12 +172,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) ensureClusterControllerManager(ctx context.Context, obj *v1alpha1.RemoteCluster, config clientcmd.ClientConfig) error {
+// ensureClusterControllerManager spawns the controller manager watching the remote cluster, and restarts it if
+// the kubeconfig has been rotated.
+func (r *Reconciler) ensureClusterControllerManager(ctx context.Context, obj *v1alpha1.RemoteCluster, config clientcmd.ClientConfig, kubeConfigHash string) error {
 	restConfig, err := config.ClientConfig()
 	if err != nil {
 		return errors.Wrap(err, "get rest config from client config")
 	}
 
+	if obj.Status.KubeConfigHash != kubeConfigHash {
+		r.Log.Info("kubeconfig of remote cluster has been rotated, restarting the controller manager", "name", obj.Name)
+		err = r.registry.Stop(ctx, obj.Name)
+		if err != nil && !errors.Is(err, clusterregistry.ErrNotExist) {
+			return err
+		}
+	}
+
 	err = r.registry.Spawn(obj.Name, restConfig)
 	if err != nil {
 		if !errors.Is(err, clusterregistry.ErrAlreadyExist) {
@@ -16,9 +16,15 @@
 package remotecluster
 
 import (
+	"context"
+
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/config"
@@ -27,7 +33,7 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
 )
 
-func Bootstrap(mgr ctrl.Manager, client client.Client, logger logr.Logger, recorderBuilder *recorder.RecorderBuilder, registry *clusterregistry.RemoteClusterRegistry) error {
+func Bootstrap(mgr ctrl.Manager, kubeClient client.Client, logger logr.Logger, recorderBuilder *recorder.RecorderBuilder, registry *clusterregistry.RemoteClusterRegistry) error {
 	if !config.ShouldSpawnController("remotecluster") {
 		return nil
 	}
@@ -35,8 +41,27 @@ func Bootstrap(mgr ctrl.Manager, client client.Client, logger logr.Logger, recor
 	return builder.Default(mgr).
 		For(&v1alpha1.RemoteCluster{}).
 		Named("remotecluster").
+		// the controller manager watching the remote cluster should be restarted, once the kubeconfig is rotated
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+			var remoteClusters v1alpha1.RemoteClusterList
+			if err := kubeClient.List(ctx, &remoteClusters); err != nil {
+				logger.Error(err, "fail to list remote clusters")
+				return nil
+			}
+
+			var reqs []reconcile.Request
+			for _, remoteCluster := range remoteClusters.Items {
+				secretRef := remoteCluster.Spec.KubeConfig.SecretRef
+				if secretRef.Namespace == obj.GetNamespace() && secretRef.Name == obj.GetName() {
+					reqs = append(reqs, reconcile.Request{
+						NamespacedName: types.NamespacedName{Name: remoteCluster.Name},
+					})
+				}
+			}
+			return reqs
+		})).
 		Complete(&Reconciler{
-			Client: client,
+			Client: kubeClient,
 			Log:    logger.WithName("remotecluster"),
 
 			registry: registry,
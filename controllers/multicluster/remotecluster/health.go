@@ -0,0 +1,120 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package remotecluster
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// healthProbeInterval is the interval to probe the health of the remote cluster
+const healthProbeInterval = 30 * time.Second
+
+// probe checks the remote cluster periodically. It restarts the controller manager watching the remote cluster if
+// it's not running or the kubeconfig has been rotated, and records the readiness of the remote cluster and the
+// compatibility of the chaos mesh running in it.
+func (r *Reconciler) probe(ctx context.Context, obj *v1alpha1.RemoteCluster) (ctrl.Result, error) {
+	newObj := obj.DeepCopy()
+	r.probeRemoteCluster(ctx, newObj)
+
+	if !reflect.DeepEqual(obj.Status, newObj.Status) {
+		if err := r.Client.Status().Update(ctx, newObj); err != nil {
+			r.Log.Error(err, "fail to update the status of remote cluster", "name", obj.Name)
+			return ctrl.Result{Requeue: true}, nil
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: healthProbeInterval}, nil
+}
+
+func (r *Reconciler) probeRemoteCluster(ctx context.Context, obj *v1alpha1.RemoteCluster) {
+	clientConfig, kubeConfigHash, err := r.getRestConfig(ctx, obj.Spec.KubeConfig.SecretRef)
+	if err != nil {
+		setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionReady, corev1.ConditionFalse, err.Error())
+		return
+	}
+
+	err = r.ensureClusterControllerManager(ctx, obj, clientConfig, kubeConfigHash)
+	if err != nil {
+		setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionReady, corev1.ConditionFalse, err.Error())
+		return
+	}
+	obj.Status.KubeConfigHash = kubeConfigHash
+
+	err = r.registry.Probe(ctx, obj.Name)
+	if err != nil {
+		setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionReady, corev1.ConditionFalse, err.Error())
+		return
+	}
+	setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionReady, corev1.ConditionTrue, "")
+
+	observedVersion, err := r.observeVersion(ctx, obj, clientConfig)
+	if err != nil {
+		setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionVersionCompatible, corev1.ConditionUnknown, err.Error())
+		return
+	}
+	obj.Status.ObservedVersion = observedVersion
+
+	if err := versionCompatible(obj.Status.CurrentVersion, observedVersion); err != nil {
+		setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionVersionCompatible, corev1.ConditionFalse, err.Error())
+		return
+	}
+	setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionVersionCompatible, corev1.ConditionTrue, "")
+}
+
+// observeVersion returns the version of chaos mesh release in the remote cluster
+func (r *Reconciler) observeVersion(ctx context.Context, obj *v1alpha1.RemoteCluster, clientConfig clientcmd.ClientConfig) (string, error) {
+	helmClient, err := r.getHelmClient(ctx, clientConfig)
+	if err != nil {
+		return "", err
+	}
+
+	release, err := helmClient.GetRelease(obj.Spec.Namespace, chaosMeshReleaseName)
+	if err != nil {
+		return "", errors.Wrap(err, "get chaos mesh release")
+	}
+
+	return release.Chart.AppVersion(), nil
+}
+
+// versionCompatible checks whether the chaos mesh running in the remote cluster is compatible with the installed
+// one. The semantic versions are compatible if they have the same major and minor versions, and the other versions,
+// like "latest", are only compatible with the same ones.
+func versionCompatible(currentVersion string, observedVersion string) error {
+	if currentVersion == "" || currentVersion == observedVersion {
+		return nil
+	}
+
+	current, currentErr := semver.NewVersion(currentVersion)
+	observed, observedErr := semver.NewVersion(observedVersion)
+	if currentErr != nil || observedErr != nil {
+		return errors.Errorf("version %s is different from the installed version %s", observedVersion, currentVersion)
+	}
+
+	if current.Major() != observed.Major() || current.Minor() != observed.Minor() {
+		return errors.Errorf("version %s is incompatible with the installed version %s", observedVersion, currentVersion)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package remotecluster
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestVersionCompatible(t *testing.T) {
+	RegisterTestingT(t)
+
+	Expect(versionCompatible("", "2.6.1")).To(Succeed())
+	Expect(versionCompatible("2.6.1", "2.6.1")).To(Succeed())
+	Expect(versionCompatible("2.6.1", "v2.6.3")).To(Succeed())
+	Expect(versionCompatible("latest", "latest")).To(Succeed())
+
+	Expect(versionCompatible("2.6.1", "2.7.0")).To(MatchError("version 2.7.0 is incompatible with the installed version 2.6.1"))
+	Expect(versionCompatible("2.6.1", "3.6.1")).NotTo(Succeed())
+	Expect(versionCompatible("latest", "2.6.1")).To(MatchError("version 2.6.1 is different from the installed version latest"))
+}
+
+func TestSetRemoteClusterConditionOrder(t *testing.T) {
+	RegisterTestingT(t)
+
+	obj := &v1alpha1.RemoteCluster{}
+	setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionVersionCompatible, corev1.ConditionTrue, "")
+	setRemoteClusterCondition(obj, v1alpha1.RemoteClusterConditionReady, corev1.ConditionTrue, "")
+
+	Expect(obj.Status.Conditions).To(Equal([]v1alpha1.RemoteClusterCondition{
+		{Type: v1alpha1.RemoteClusterConditionInstalled, Status: corev1.ConditionFalse},
+		{Type: v1alpha1.RemoteClusterConditionReady, Status: corev1.ConditionTrue},
+		{Type: v1alpha1.RemoteClusterConditionVersionCompatible, Status: corev1.ConditionTrue},
+	}))
+}
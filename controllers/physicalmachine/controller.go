@@ -0,0 +1,165 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package physicalmachine
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosd"
+)
+
+const (
+	reasonProbed      = "Probed"
+	reasonUnreachable = "Unreachable"
+)
+
+// Reconciler probes chaosd on the physical machine periodically, keeps the status fresh, and recovers
+// the orphaned attacks
+type Reconciler struct {
+	client.Client
+
+	Log      logr.Logger
+	Recorder record.EventRecorder
+	Interval time.Duration
+	// GracePeriod is the minimum age of the orphaned attacks to be recovered, zero disables the recovery
+	GracePeriod time.Duration
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	obj := &v1alpha1.PhysicalMachine{}
+	if err := r.Client.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "unable to get physical machine")
+		return ctrl.Result{}, err
+	}
+
+	status := r.probe(ctx, obj)
+
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &v1alpha1.PhysicalMachine{}
+		if err := r.Client.Get(ctx, req.NamespacedName, latest); err != nil {
+			return err
+		}
+		latest.Status = *status
+		return r.Client.Status().Update(ctx, latest)
+	})
+	if err != nil {
+		r.Log.Error(err, "fail to update status", "physicalmachine", req.NamespacedName)
+	}
+
+	return ctrl.Result{RequeueAfter: r.Interval}, nil
+}
+
+// probe returns the status of the physical machine with the facts reported by chaosd
+func (r *Reconciler) probe(ctx context.Context, obj *v1alpha1.PhysicalMachine) *v1alpha1.PhysicalMachineStatus {
+	status := obj.Status.DeepCopy()
+	now := metav1.Now()
+
+	c, err := chaosd.New(obj.Spec.Address)
+	if err == nil {
+		err = c.Health(ctx)
+	}
+	if err != nil {
+		if r.setReachable(status, corev1.ConditionFalse, reasonUnreachable, err.Error(), now) {
+			r.Recorder.Eventf(obj, corev1.EventTypeWarning, reasonUnreachable, "chaosd on %s is unreachable: %s", obj.Spec.Address, err)
+		}
+		return status
+	}
+
+	status.LastHeartbeatTime = &now
+	if r.setReachable(status, corev1.ConditionTrue, reasonProbed, "", now) {
+		r.Recorder.Eventf(obj, corev1.EventTypeNormal, reasonProbed, "chaosd on %s is reachable", obj.Spec.Address)
+	}
+
+	if version, err := c.Version(ctx); err == nil {
+		status.Version = version.GitVersion
+	} else {
+		r.Log.Error(err, "fail to get version of chaosd", "address", obj.Spec.Address)
+	}
+
+	info, err := c.SystemInfo(ctx)
+	switch {
+	case err == nil:
+		status.Inventory = &v1alpha1.PhysicalMachineInventory{
+			Hostname:      info.Hostname,
+			OS:            info.OS,
+			KernelVersion: info.KernelVersion,
+			Arch:          info.Arch,
+		}
+	case errors.Is(err, chaosd.ErrNotSupported):
+		status.Inventory = nil
+	default:
+		r.Log.Error(err, "fail to get system info from chaosd", "address", obj.Spec.Address)
+	}
+
+	experiments, err := c.ListExperiments(ctx)
+	if err != nil {
+		r.Log.Error(err, "fail to list experiments in chaosd", "address", obj.Spec.Address)
+		return status
+	}
+	recovered := r.collectOrphans(ctx, obj, c, experiments)
+	status.Experiments = nil
+	for _, experiment := range experiments {
+		if !experiment.IsActive() || recovered[experiment.UID] {
+			continue
+		}
+		createdAt := metav1.NewTime(experiment.CreatedAt)
+		status.Experiments = append(status.Experiments, v1alpha1.PhysicalMachineExperiment{
+			UID:       experiment.UID,
+			Kind:      experiment.Kind,
+			Action:    experiment.Action,
+			Status:    experiment.Status,
+			CreatedAt: &createdAt,
+		})
+	}
+
+	return status
+}
+
+// setReachable sets the reachable condition, and reports whether the status of condition is changed
+func (r *Reconciler) setReachable(status *v1alpha1.PhysicalMachineStatus, conditionStatus corev1.ConditionStatus, reason, message string, now metav1.Time) bool {
+	condition := status.GetCondition(v1alpha1.PhysicalMachineConditionReachable)
+	if condition == nil {
+		status.Conditions = append(status.Conditions, v1alpha1.PhysicalMachineCondition{
+			Type: v1alpha1.PhysicalMachineConditionReachable,
+		})
+		condition = &status.Conditions[len(status.Conditions)-1]
+	}
+
+	changed := condition.Status != conditionStatus
+	if changed {
+		condition.LastTransitionTime = &now
+	}
+	condition.Status = conditionStatus
+	condition.Reason = reason
+	condition.Message = message
+	condition.LastProbeTime = &now
+	return changed
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package physicalmachine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubectl/pkg/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosd"
+	chaosdtest "github.com/chaos-mesh/chaos-mesh/controllers/utils/test/chaosd"
+	"github.com/chaos-mesh/chaos-mesh/pkg/log"
+	. "github.com/chaos-mesh/chaos-mesh/pkg/testutils"
+)
+
+func TestProbe(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	securityMode := config.ControllerCfg.ChaosdSecurityMode
+	config.ControllerCfg.ChaosdSecurityMode = false
+	defer func() { config.ControllerCfg.ChaosdSecurityMode = securityMode }()
+
+	server := chaosdtest.NewServer(
+		chaosd.Experiment{UID: "running", Kind: "network", Action: "delay", Status: chaosd.ExperimentSuccess, CreatedAt: time.Now()},
+		chaosd.Experiment{UID: "recovered", Kind: "process", Action: "kill", Status: chaosd.ExperimentDestroyed, CreatedAt: time.Now()},
+	)
+	server.Info = &chaosd.SystemInfo{Hostname: "pm0", OS: "Ubuntu 22.04", KernelVersion: "5.15.0", Arch: "amd64"}
+	defer server.Close()
+
+	unreachable := chaosdtest.NewServer()
+	unreachable.Close()
+
+	pm0 := NewPhysicalMachine(PhysicalMachineArg{Name: "pm0", Address: server.URL})
+	pm1 := NewPhysicalMachine(PhysicalMachineArg{Name: "pm1", Address: unreachable.URL})
+
+	err := v1alpha1.SchemeBuilder.AddToScheme(scheme.Scheme)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(&pm0, &pm1).
+		WithStatusSubresource(&v1alpha1.PhysicalMachine{}).
+		Build()
+
+	logger, _ := log.NewDefaultZapLogger()
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{
+		Client:   c,
+		Log:      logger,
+		Recorder: recorder,
+		Interval: time.Minute,
+	}
+
+	reconcile := func(name string) v1alpha1.PhysicalMachine {
+		key := types.NamespacedName{Namespace: pm0.Namespace, Name: name}
+		result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(result.RequeueAfter).To(Equal(time.Minute))
+
+		var physicalMachine v1alpha1.PhysicalMachine
+		g.Expect(c.Get(context.Background(), key, &physicalMachine)).To(Succeed())
+		return physicalMachine
+	}
+
+	reachable := reconcile("pm0")
+	condition := reachable.Status.GetCondition(v1alpha1.PhysicalMachineConditionReachable)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	g.Expect(reachable.Status.LastHeartbeatTime).NotTo(BeNil())
+	g.Expect(reachable.Status.Version).To(Equal("v1.4.0"))
+	g.Expect(reachable.Status.Inventory).To(Equal(&v1alpha1.PhysicalMachineInventory{
+		Hostname: "pm0", OS: "Ubuntu 22.04", KernelVersion: "5.15.0", Arch: "amd64",
+	}))
+	g.Expect(reachable.Status.Experiments).To(HaveLen(1))
+	g.Expect(reachable.Status.Experiments[0].UID).To(Equal("running"))
+	g.Expect(reachable.IsUnhealthy()).To(BeFalse())
+
+	unhealthy := reconcile("pm1")
+	condition = unhealthy.Status.GetCondition(v1alpha1.PhysicalMachineConditionReachable)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(reasonUnreachable))
+	g.Expect(unhealthy.Status.LastHeartbeatTime).To(BeNil())
+	g.Expect(unhealthy.IsUnhealthy()).To(BeTrue())
+
+	// the events are only recorded once the reachability is changed
+	reconcile("pm1")
+	g.Expect(recorder.Events).To(HaveLen(2))
+}
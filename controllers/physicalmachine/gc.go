@@ -0,0 +1,79 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package physicalmachine
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosd"
+)
+
+const reasonOrphanRecovered = "OrphanRecovered"
+
+// collectOrphans recovers the active attacks in chaosd which don't belong to any PhysicalMachineChaos, such as
+// the ones left by the force-deleted PhysicalMachineChaos. The attacks created in the grace period are skipped,
+// as the PhysicalMachineChaos creating them may not be observed yet. It returns the uids of the recovered attacks.
+func (r *Reconciler) collectOrphans(ctx context.Context, obj *v1alpha1.PhysicalMachine, c *chaosd.Client, experiments []chaosd.Experiment) map[string]bool {
+	recovered := make(map[string]bool)
+	if r.GracePeriod <= 0 {
+		return recovered
+	}
+
+	var candidates []chaosd.Experiment
+	for _, experiment := range experiments {
+		// the attacks launched by the command line of chaosd are not managed by Chaos Mesh
+		if !experiment.IsActive() || experiment.LaunchMode == chaosd.LaunchModeCommand {
+			continue
+		}
+		if time.Since(experiment.CreatedAt) < r.GracePeriod {
+			continue
+		}
+		candidates = append(candidates, experiment)
+	}
+	if len(candidates) == 0 {
+		return recovered
+	}
+
+	// the chaos are listed after the experiments, so the attacks created by them are not taken as orphans
+	var chaosList v1alpha1.PhysicalMachineChaosList
+	if err := r.Client.List(ctx, &chaosList); err != nil {
+		r.Log.Error(err, "fail to list physical machine chaos")
+		return recovered
+	}
+	live := make(map[string]bool)
+	for _, chaos := range chaosList.Items {
+		live[chaos.Spec.UID] = true
+	}
+
+	for _, experiment := range candidates {
+		if live[experiment.UID] {
+			continue
+		}
+		if err := c.RecoverAttack(ctx, experiment.UID); err != nil {
+			r.Log.Error(err, "fail to recover orphaned attack", "address", obj.Spec.Address, "uid", experiment.UID)
+			continue
+		}
+		recovered[experiment.UID] = true
+		r.Log.Info("orphaned attack recovered", "address", obj.Spec.Address, "uid", experiment.UID)
+		r.Recorder.Eventf(obj, corev1.EventTypeNormal, reasonOrphanRecovered,
+			"Recovered the %s %s attack %s without PhysicalMachineChaos", experiment.Kind, experiment.Action, experiment.UID)
+	}
+	return recovered
+}
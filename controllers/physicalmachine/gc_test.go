@@ -0,0 +1,105 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package physicalmachine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/kubectl/pkg/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosd"
+	chaosdtest "github.com/chaos-mesh/chaos-mesh/controllers/utils/test/chaosd"
+	"github.com/chaos-mesh/chaos-mesh/pkg/log"
+	. "github.com/chaos-mesh/chaos-mesh/pkg/testutils"
+)
+
+func TestCollectOrphans(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	securityMode := config.ControllerCfg.ChaosdSecurityMode
+	config.ControllerCfg.ChaosdSecurityMode = false
+	defer func() { config.ControllerCfg.ChaosdSecurityMode = securityMode }()
+
+	old := time.Now().Add(-time.Hour)
+	server := chaosdtest.NewServer(
+		chaosd.Experiment{UID: "live", Kind: "network", Action: "delay", Status: chaosd.ExperimentSuccess, CreatedAt: old},
+		chaosd.Experiment{UID: "orphan", Kind: "stress", Action: "cpu", Status: chaosd.ExperimentSuccess, CreatedAt: old},
+		chaosd.Experiment{UID: "in-grace-period", Kind: "stress", Action: "memory", Status: chaosd.ExperimentSuccess, CreatedAt: time.Now()},
+		chaosd.Experiment{UID: "recovered", Kind: "process", Action: "kill", Status: chaosd.ExperimentDestroyed, CreatedAt: old},
+		chaosd.Experiment{UID: "command-line", Kind: "disk", Action: "fill", Status: chaosd.ExperimentSuccess, CreatedAt: old, LaunchMode: chaosd.LaunchModeCommand},
+	)
+	defer server.Close()
+
+	physicalMachine := NewPhysicalMachine(PhysicalMachineArg{Name: "pm0", Address: server.URL})
+	chaos := &v1alpha1.PhysicalMachineChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "delay"},
+		Spec: v1alpha1.PhysicalMachineChaosSpec{
+			Action: v1alpha1.PMNetworkDelayAction,
+			ExpInfo: v1alpha1.ExpInfo{
+				UID: "live",
+			},
+		},
+	}
+
+	err := v1alpha1.SchemeBuilder.AddToScheme(scheme.Scheme)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme.Scheme).
+		WithObjects(&physicalMachine, chaos).
+		WithStatusSubresource(&v1alpha1.PhysicalMachine{}).
+		Build()
+
+	logger, _ := log.NewDefaultZapLogger()
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{
+		Client:      c,
+		Log:         logger,
+		Recorder:    recorder,
+		Interval:    time.Minute,
+		GracePeriod: 5 * time.Minute,
+	}
+
+	key := types.NamespacedName{Namespace: physicalMachine.Namespace, Name: physicalMachine.Name}
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.Recovered).To(Equal([]string{"orphan"}))
+
+	var latest v1alpha1.PhysicalMachine
+	g.Expect(c.Get(context.Background(), key, &latest)).To(Succeed())
+	var active []string
+	for _, experiment := range latest.Status.Experiments {
+		active = append(active, experiment.UID)
+	}
+	g.Expect(active).To(Equal([]string{"live", "in-grace-period", "command-line"}))
+
+	// nothing is recovered if it's disabled
+	server.Experiments[2].CreatedAt = old
+	r.GracePeriod = 0
+	_, err = r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.Recovered).To(Equal([]string{"orphan"}))
+}
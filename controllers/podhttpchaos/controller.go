@@ -20,9 +20,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,13 +42,16 @@ import (
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
 )
 
+// countersInterval is the interval to refresh the counters of rules from the tproxy
+const countersInterval = 10 * time.Second
+
 // Reconciler applys podhttpchaos
 type Reconciler struct {
 	client.Client
 
 	Recorder                 record.EventRecorder
 	Log                      logr.Logger
-	ChaosDaemonClientBuilder *chaosdaemon.ChaosDaemonClientBuilder
+	ChaosDaemonClientBuilder chaosdaemon.ChaosDaemonClientBuilderInterface
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -62,8 +68,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	if obj.ObjectMeta.Generation <= obj.Status.ObservedGeneration && obj.Status.FailedMessage == "" {
-		r.Log.Info("the target pod has been up to date", "pod", obj.Namespace+"/"+obj.Name)
-		return ctrl.Result{}, nil
+		// it's reached on every refresh of the counters
+		r.Log.V(1).Info("the target pod has been up to date", "pod", obj.Namespace+"/"+obj.Name)
+		return r.refreshCounters(ctx, obj)
 	}
 
 	r.Log.Info("updating http chaos", "pod", obj.Namespace+"/"+obj.Name, "spec", obj.Spec)
@@ -102,6 +109,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			obj.Status.ObservedGeneration = observedGeneration
 			obj.Status.Pid = pid
 			obj.Status.StartTime = startTime
+			// the counters are reset by the tproxy once the rules are applied
+			obj.Status.Rules = nil
 
 			return r.Client.Status().Update(context.TODO(), obj)
 		})
@@ -136,7 +145,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	proxyPortsMap := make(map[uint32]bool)
 
 	for _, rule := range obj.Spec.Rules {
-		proxyPortsMap[uint32(rule.Port)] = true
+		// the outbound traffic is redirected by chaos daemon according to the rules, rather than the proxy ports
+		if rule.Direction != v1alpha1.HTTPChaosOutbound {
+			proxyPortsMap[uint32(rule.Port)] = true
+		}
 		rules = append(rules, rule.PodHttpChaosBaseRule)
 	}
 
@@ -245,5 +257,84 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	pid = res.Instance
 	startTime = res.StartTime
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: countersInterval}, nil
+}
+
+// refreshCounters updates the counters of rules in the status with the ones reported by the tproxy
+func (r *Reconciler) refreshCounters(ctx context.Context, obj *v1alpha1.PodHttpChaos) (ctrl.Result, error) {
+	if len(obj.Spec.Rules) == 0 || obj.Status.Pid == 0 {
+		return ctrl.Result{}, nil
+	}
+
+	pod := &v1.Pod{}
+	err := r.Client.Get(ctx, types.NamespacedName{
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}, pod)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		r.Log.Error(err, "fail to find pod", "pod", obj.Namespace+"/"+obj.Name)
+		return ctrl.Result{RequeueAfter: countersInterval}, nil
+	}
+
+	pbClient, err := r.ChaosDaemonClientBuilder.Build(ctx, pod, &types.NamespacedName{
+		Namespace: obj.Namespace,
+		Name:      obj.Name,
+	})
+	if err != nil {
+		r.Log.Error(err, "fail to build chaos daemon client", "pod", obj.Namespace+"/"+obj.Name)
+		return ctrl.Result{RequeueAfter: countersInterval}, nil
+	}
+	defer pbClient.Close()
+
+	res, err := pbClient.GetHttpChaosCounters(ctx, &pb.GetHttpChaosCountersRequest{
+		Instance:  obj.Status.Pid,
+		StartTime: obj.Status.StartTime,
+	})
+	if status.Code(err) == codes.Unimplemented {
+		// the tproxy doesn't report the counters, which is not changed until the rules are applied again
+		r.Log.V(1).Info("tproxy does not report the counters of rules", "pod", obj.Namespace+"/"+obj.Name)
+		return ctrl.Result{}, nil
+	}
+	if err != nil {
+		r.Log.Error(err, "fail to get counters of rules", "pod", obj.Namespace+"/"+obj.Name)
+		return ctrl.Result{RequeueAfter: countersInterval}, nil
+	}
+
+	rules := make([]v1alpha1.PodHttpChaosRuleStatus, 0, len(obj.Spec.Rules))
+	for i, rule := range obj.Spec.Rules {
+		ruleStatus := v1alpha1.PodHttpChaosRuleStatus{
+			Source: rule.Source,
+			Port:   rule.Port,
+		}
+		if i < len(res.Counters) {
+			ruleStatus.Matched = res.Counters[i].Matched
+			ruleStatus.Injected = res.Counters[i].Injected
+		}
+		rules = append(rules, ruleStatus)
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		latest := &v1alpha1.PodHttpChaos{}
+		if err := r.Client.Get(ctx, types.NamespacedName{
+			Name:      obj.Name,
+			Namespace: obj.Namespace,
+		}, latest); err != nil {
+			return err
+		}
+		// the counters belong to the rules of an outdated generation
+		if latest.Generation != obj.Generation {
+			return nil
+		}
+
+		latest.Status.Rules = rules
+		return r.Client.Status().Update(ctx, latest)
+	})
+	if err != nil {
+		r.Log.Error(err, "fail to update counters of rules", "pod", obj.Namespace+"/"+obj.Name)
+	}
+
+	return ctrl.Result{RequeueAfter: countersInterval}, nil
 }
@@ -0,0 +1,193 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package podhttpchaos
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/test"
+	chaosdaemonclient "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/client"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// countersDaemonClient reports the counters of rules like the tproxy
+type countersDaemonClient struct {
+	test.MockChaosDaemonClient
+	counters []*pb.HttpChaosRuleCounter
+	err      error
+	requests []*pb.GetHttpChaosCountersRequest
+}
+
+func (c *countersDaemonClient) GetHttpChaosCounters(ctx context.Context, in *pb.GetHttpChaosCountersRequest, opts ...grpc.CallOption) (*pb.GetHttpChaosCountersResponse, error) {
+	c.requests = append(c.requests, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &pb.GetHttpChaosCountersResponse{Counters: c.counters}, nil
+}
+
+type fakeDaemonClientBuilder struct {
+	client *countersDaemonClient
+}
+
+func (b *fakeDaemonClientBuilder) Build(ctx context.Context, pod *v1.Pod, id *types.NamespacedName) (chaosdaemonclient.ChaosDaemonClientInterface, error) {
+	return b.client, nil
+}
+
+func (b *fakeDaemonClientBuilder) FindDaemonIP(ctx context.Context, pod *v1.Pod) (string, error) {
+	return "127.0.0.1", nil
+}
+
+func newPodHttpChaos() *v1alpha1.PodHttpChaos {
+	return &v1alpha1.PodHttpChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod", Generation: 1},
+		Spec: v1alpha1.PodHttpChaosSpec{
+			Rules: []v1alpha1.PodHttpChaosRule{
+				{Source: "default/delay", Port: 80},
+				{Source: "default/abort", Port: 8080},
+			},
+		},
+		Status: v1alpha1.PodHttpChaosStatus{Pid: 10, StartTime: 100, ObservedGeneration: 1},
+	}
+}
+
+func newReconciler(t *testing.T, daemon *countersDaemonClient, objs ...client.Object) *Reconciler {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return &Reconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+			WithStatusSubresource(&v1alpha1.PodHttpChaos{}).Build(),
+		Log:                      logr.Discard(),
+		ChaosDaemonClientBuilder: &fakeDaemonClientBuilder{client: daemon},
+	}
+}
+
+func TestRefreshCounters(t *testing.T) {
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"}}
+	counters := []*pb.HttpChaosRuleCounter{{Matched: 3, Injected: 2}}
+
+	tests := []struct {
+		name     string
+		mutate   func(*v1alpha1.PodHttpChaos)
+		noPod    bool
+		err      error
+		requests int
+		result   ctrl.Result
+		rules    []v1alpha1.PodHttpChaosRuleStatus
+	}{
+		{
+			name:     "counters are updated",
+			requests: 1,
+			result:   ctrl.Result{RequeueAfter: countersInterval},
+			// the rules without counters reported are treated as not matched
+			rules: []v1alpha1.PodHttpChaosRuleStatus{
+				{Source: "default/delay", Port: 80, Matched: 3, Injected: 2},
+				{Source: "default/abort", Port: 8080},
+			},
+		}, {
+			name:   "the tproxy is not started",
+			mutate: func(obj *v1alpha1.PodHttpChaos) { obj.Status.Pid = 0 },
+		}, {
+			name:   "no rules",
+			mutate: func(obj *v1alpha1.PodHttpChaos) { obj.Spec.Rules = nil },
+		}, {
+			name:  "the pod is deleted",
+			noPod: true,
+		}, {
+			name:     "the tproxy fails to report",
+			err:      errors.New("connection refused"),
+			requests: 1,
+			result:   ctrl.Result{RequeueAfter: countersInterval},
+		}, {
+			name:     "the tproxy does not support counters",
+			err:      status.Error(codes.Unimplemented, "tproxy does not report the counters of rules"),
+			requests: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			RegisterTestingT(t)
+			ctx := context.Background()
+
+			obj := newPodHttpChaos()
+			if tt.mutate != nil {
+				tt.mutate(obj)
+			}
+			objs := []client.Object{obj}
+			if !tt.noPod {
+				objs = append(objs, pod.DeepCopy())
+			}
+			daemon := &countersDaemonClient{counters: counters, err: tt.err}
+			r := newReconciler(t, daemon, objs...)
+
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(obj)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(tt.result))
+			Expect(daemon.requests).To(HaveLen(tt.requests))
+			for _, req := range daemon.requests {
+				Expect(req.Instance).To(BeEquivalentTo(10))
+				Expect(req.StartTime).To(BeEquivalentTo(100))
+			}
+
+			latest := &v1alpha1.PodHttpChaos{}
+			Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(obj), latest)).To(Succeed())
+			Expect(latest.Status.Rules).To(Equal(tt.rules))
+		})
+	}
+}
+
+func TestRefreshCountersOfOutdatedGeneration(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+
+	obj := newPodHttpChaos()
+	updated := obj.DeepCopy()
+	updated.Generation = 2
+	daemon := &countersDaemonClient{counters: []*pb.HttpChaosRuleCounter{{Matched: 1, Injected: 1}}}
+	r := newReconciler(t, daemon, updated, &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"}})
+
+	// the rules are changed while the counters are fetched
+	result, err := r.refreshCounters(ctx, obj)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(result).To(Equal(ctrl.Result{RequeueAfter: countersInterval}))
+	Expect(daemon.requests).To(HaveLen(1))
+
+	latest := &v1alpha1.PodHttpChaos{}
+	Expect(r.Client.Get(ctx, client.ObjectKeyFromObject(obj), latest)).To(Succeed())
+	Expect(latest.Status.Rules).To(BeEmpty())
+}
@@ -85,6 +85,10 @@ func (c *MockChaosDaemonClient) ApplyHttpChaos(ctx context.Context, in *chaosdae
 	return nil, mockError("ApplyHttpChaos")
 }
 
+func (c *MockChaosDaemonClient) GetHttpChaosCounters(ctx context.Context, in *chaosdaemon.GetHttpChaosCountersRequest, opts ...grpc.CallOption) (*chaosdaemon.GetHttpChaosCountersResponse, error) {
+	return nil, mockError("GetHttpChaosCounters")
+}
+
 func (c *MockChaosDaemonClient) SetDNSServer(ctx context.Context, in *chaosdaemon.SetDNSServerRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return nil, mockError("SetDNSServer")
 }
@@ -112,3 +116,15 @@ func (c *MockChaosDaemonClient) ApplyBlockChaos(ctx context.Context, req *chaosd
 func (c *MockChaosDaemonClient) RecoverBlockChaos(ctx context.Context, req *chaosdaemon.RecoverBlockChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	return &empty.Empty{}, nil
 }
+
+func (c *MockChaosDaemonClient) ApplyPodFault(ctx context.Context, req *chaosdaemon.ApplyPodFaultRequest, opts ...grpc.CallOption) (*chaosdaemon.ApplyPodFaultResponse, error) {
+	return nil, mockError("ApplyPodFault")
+}
+
+func (c *MockChaosDaemonClient) RecoverPodFault(ctx context.Context, req *chaosdaemon.RecoverPodFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	return nil, mockError("RecoverPodFault")
+}
+
+func (c *MockChaosDaemonClient) Probe(ctx context.Context, req *chaosdaemon.ProbeRequest, opts ...grpc.CallOption) (*chaosdaemon.ProbeResponse, error) {
+	return nil, mockError("Probe")
+}
@@ -148,6 +148,22 @@ var ChaosObjects = fx.Supply(
 			Object: &v1alpha1.BlockChaos{},
 		},
 	},
+
+	fx.Annotated{
+		Group: "objs",
+		Target: Object{
+			Name:   "podfaultchaos",
+			Object: &v1alpha1.PodFaultChaos{},
+		},
+	},
+
+	fx.Annotated{
+		Group: "objs",
+		Target: Object{
+			Name:   "compositechaos",
+			Object: &v1alpha1.CompositeChaos{},
+		},
+	},
 )
 
 // WebhookObject only used for registration the
@@ -176,4 +192,11 @@ var WebhookObjects = fx.Supply(
 			Object: &v1alpha1.StatusCheck{},
 		},
 	},
+	fx.Annotated{
+		Group: "webhookObjs",
+		Target: WebhookObject{
+			Name:   "chaospolicy",
+			Object: &v1alpha1.ChaosPolicy{},
+		},
+	},
 )
@@ -0,0 +1,214 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosd
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/controllers/config"
+)
+
+// ErrNotSupported means the API is not supported by the version of chaosd
+var ErrNotSupported = errors.New("not supported by chaosd")
+
+var errNotFound = errors.New("not found")
+
+const (
+	ExperimentCreated   = "created"
+	ExperimentSuccess   = "success"
+	ExperimentError     = "error"
+	ExperimentDestroyed = "destroyed"
+	ExperimentRevoked   = "revoked"
+
+	// LaunchModeCommand means the experiment is launched by the command line of chaosd, rather than the API
+	LaunchModeCommand = "cmd"
+)
+
+// Experiment is an experiment recorded by chaosd
+type Experiment struct {
+	UID       string    `json:"uid"`
+	CreatedAt time.Time `json:"created_at"`
+	Status    string    `json:"status"`
+	Kind      string    `json:"kind"`
+	Action    string    `json:"action"`
+	// LaunchMode is how the experiment is launched
+	LaunchMode string `json:"launch_mode"`
+}
+
+// IsActive returns true if the attack of the experiment is not recovered
+func (e *Experiment) IsActive() bool {
+	return e.Status == ExperimentCreated || e.Status == ExperimentSuccess
+}
+
+// VersionInfo is the version of chaosd
+type VersionInfo struct {
+	GitVersion string `json:"gitVersion"`
+	GitCommit  string `json:"gitCommit"`
+}
+
+// SystemInfo is the facts of the physical machine reported by chaosd
+type SystemInfo struct {
+	Hostname      string `json:"hostname"`
+	OS            string `json:"os"`
+	KernelVersion string `json:"kernelVersion"`
+	Arch          string `json:"arch"`
+}
+
+// Client is the client of the HTTP API of chaosd
+type Client struct {
+	address    string
+	httpClient *http.Client
+}
+
+// New returns the client of chaosd listening on the address, such as `https://192.168.0.1:31768`
+func New(address string) (*Client, error) {
+	httpClient, err := NewHTTPClient(address)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		address:    strings.TrimSuffix(address, "/"),
+		httpClient: httpClient,
+	}, nil
+}
+
+// Health checks whether chaosd is serving
+func (c *Client) Health(ctx context.Context) error {
+	_, err := c.do(ctx, http.MethodGet, "/api/system/health")
+	return err
+}
+
+// Version returns the version of chaosd
+func (c *Client) Version(ctx context.Context) (*VersionInfo, error) {
+	version := &VersionInfo{}
+	if err := c.get(ctx, "/api/system/version", version); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// SystemInfo returns the facts of the physical machine
+func (c *Client) SystemInfo(ctx context.Context) (*SystemInfo, error) {
+	info := &SystemInfo{}
+	if err := c.get(ctx, "/api/system/info", info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// ListExperiments returns all the experiments recorded by chaosd, including the recovered ones
+func (c *Client) ListExperiments(ctx context.Context) ([]Experiment, error) {
+	var experiments []Experiment
+	if err := c.get(ctx, "/api/experiments/", &experiments); err != nil {
+		return nil, err
+	}
+	return experiments, nil
+}
+
+// RecoverAttack recovers the attack of the experiment, it's fine if the experiment is not found
+func (c *Client) RecoverAttack(ctx context.Context, uid string) error {
+	_, err := c.do(ctx, http.MethodDelete, "/api/attack/"+uid)
+	if errors.Is(err, errNotFound) {
+		return nil
+	}
+	return err
+}
+
+func (c *Client) get(ctx context.Context, path string, out interface{}) error {
+	body, err := c.do(ctx, http.MethodGet, path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return errors.Wrapf(err, "unmarshal response of %s", path)
+	}
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.address+path, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "create HTTP request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound && strings.HasPrefix(path, "/api/system/"):
+		return nil, errors.Wrap(ErrNotSupported, path)
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errors.Wrapf(errNotFound, "%s %s", method, path)
+	case resp.StatusCode != http.StatusOK:
+		return nil, errors.Errorf("%s %s: HTTP status %d: %s", method, path, resp.StatusCode, body)
+	}
+	return body, nil
+}
+
+// NewHTTPClient returns the HTTP client to chaosd, which uses mTLS in the security mode
+func NewHTTPClient(url string) (*http.Client, error) {
+	if config.ControllerCfg.ChaosdSecurityMode {
+		return securityHTTPClient(url)
+	}
+	return &http.Client{Timeout: 5 * time.Second}, nil
+}
+
+func securityHTTPClient(url string) (*http.Client, error) {
+	if !strings.Contains(url, "https") {
+		return nil, errors.Errorf("a secure url should begin with `https` rather than `http`, url: %s", url)
+	}
+
+	pair, err := tls.LoadX509KeyPair(config.ControllerCfg.ChaosdClientCert, config.ControllerCfg.ChaosdClientKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "load x509 key pair failed")
+	}
+
+	pool := x509.NewCertPool()
+	ca, err := os.ReadFile(config.ControllerCfg.ChaosdCACert)
+	if err != nil {
+		return nil, errors.Wrap(err, "read ChaosdCACert file failed")
+	}
+	pool.AppendCertsFromPEM(ca)
+
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:      pool,
+				Certificates: []tls.Certificate{pair},
+				ServerName:   "chaosd.chaos-mesh.org",
+			},
+		},
+		Timeout: 5 * time.Second,
+	}, nil
+}
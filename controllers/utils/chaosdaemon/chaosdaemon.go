@@ -50,6 +50,14 @@ func findIPOnEndpointSlice(e *discoveryv1.EndpointSliceList, nodeName string) st
 	return ""
 }
 
+// ChaosDaemonClientBuilderInterface builds the client of the chaos daemon on the node of a pod
+type ChaosDaemonClientBuilderInterface interface {
+	Build(ctx context.Context, pod *v1.Pod, id *types.NamespacedName) (chaosdaemonclient.ChaosDaemonClientInterface, error)
+	FindDaemonIP(ctx context.Context, pod *v1.Pod) (string, error)
+}
+
+var _ ChaosDaemonClientBuilderInterface = (*ChaosDaemonClientBuilder)(nil)
+
 type ChaosDaemonClientBuilder struct {
 	client.Reader
 }
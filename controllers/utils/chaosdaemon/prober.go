@@ -0,0 +1,104 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	// capabilitiesTTL is the duration to cache the capabilities of a node
+	capabilitiesTTL = 5 * time.Minute
+	// unknownCapabilitiesTTL is the duration to cache a failed probe, which is shorter so that a chaos-daemon
+	// which is restarting or being upgraded is probed again soon
+	unknownCapabilitiesTTL = 30 * time.Second
+)
+
+type cachedCapabilities struct {
+	host     *pb.HostCapabilities
+	expireAt time.Time
+}
+
+// Prober probes the capabilities of the nodes through chaos-daemon, and caches them per node
+type Prober struct {
+	builder ChaosDaemonClientBuilderInterface
+
+	lock  sync.Mutex
+	nodes map[string]cachedCapabilities
+}
+
+func NewProber(builder *ChaosDaemonClientBuilder) *Prober {
+	return &Prober{
+		builder: builder,
+		nodes:   make(map[string]cachedCapabilities),
+	}
+}
+
+// HostCapabilities returns the capabilities of the node where the pod is. It returns nil if the capabilities
+// are unknown, e.g. the chaos-daemon is an older version without the Probe RPC, so that the caller could
+// skip the checks rather than rejecting the target.
+func (p *Prober) HostCapabilities(ctx context.Context, pod *v1.Pod) *pb.HostCapabilities {
+	nodeName := pod.Spec.NodeName
+	if nodeName == "" {
+		return nil
+	}
+
+	p.lock.Lock()
+	cached, ok := p.nodes[nodeName]
+	p.lock.Unlock()
+	if ok && time.Now().Before(cached.expireAt) {
+		return cached.host
+	}
+
+	host := p.probe(ctx, pod)
+	ttl := capabilitiesTTL
+	if host == nil {
+		ttl = unknownCapabilitiesTTL
+	}
+
+	p.lock.Lock()
+	p.nodes[nodeName] = cachedCapabilities{
+		host:     host,
+		expireAt: time.Now().Add(ttl),
+	}
+	p.lock.Unlock()
+	return host
+}
+
+func (p *Prober) probe(ctx context.Context, pod *v1.Pod) *pb.HostCapabilities {
+	daemonClient, err := p.builder.Build(ctx, pod, nil)
+	if err != nil {
+		log.Error(err, "fail to build chaos-daemon client", "node", pod.Spec.NodeName)
+		return nil
+	}
+	defer daemonClient.Close()
+
+	resp, err := daemonClient.Probe(ctx, &pb.ProbeRequest{})
+	if err != nil {
+		log.Info("fail to probe the capabilities of node", "node", pod.Spec.NodeName, "error", err.Error())
+		return nil
+	}
+	if resp == nil {
+		return nil
+	}
+	return resp.Host
+}
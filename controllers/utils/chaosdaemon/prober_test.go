@@ -0,0 +1,86 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/chaos-mesh/chaos-mesh/controllers/test"
+	chaosdaemonclient "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/client"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+type probeDaemonClient struct {
+	test.MockChaosDaemonClient
+	host   *pb.HostCapabilities
+	err    error
+	probes int
+}
+
+func (c *probeDaemonClient) Probe(ctx context.Context, in *pb.ProbeRequest, opts ...grpc.CallOption) (*pb.ProbeResponse, error) {
+	c.probes++
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &pb.ProbeResponse{Host: c.host}, nil
+}
+
+type probeClientBuilder struct {
+	client *probeDaemonClient
+}
+
+func (b *probeClientBuilder) Build(ctx context.Context, pod *v1.Pod, id *types.NamespacedName) (chaosdaemonclient.ChaosDaemonClientInterface, error) {
+	return b.client, nil
+}
+
+func (b *probeClientBuilder) FindDaemonIP(ctx context.Context, pod *v1.Pod) (string, error) {
+	return "", nil
+}
+
+func TestHostCapabilities(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+	pod := &v1.Pod{Spec: v1.PodSpec{NodeName: "node-1"}}
+
+	daemon := &probeDaemonClient{err: errors.New("unknown method Probe")}
+	p := &Prober{builder: &probeClientBuilder{client: daemon}, nodes: make(map[string]cachedCapabilities)}
+
+	// a failed probe is only cached for a short while
+	start := time.Now()
+	Expect(p.HostCapabilities(ctx, pod)).To(BeNil())
+	Expect(p.nodes["node-1"].expireAt).To(BeTemporally("~", start.Add(unknownCapabilitiesTTL), time.Second))
+	Expect(p.HostCapabilities(ctx, pod)).To(BeNil())
+	Expect(daemon.probes).To(Equal(1))
+
+	daemon.err, daemon.host = nil, &pb.HostCapabilities{KernelRelease: "5.15.0", CgroupVersion: "v2"}
+	p.nodes["node-1"] = cachedCapabilities{expireAt: time.Now().Add(-time.Second)}
+	Expect(p.HostCapabilities(ctx, pod)).To(BeIdenticalTo(daemon.host))
+	Expect(p.nodes["node-1"].expireAt).To(BeTemporally("~", time.Now().Add(capabilitiesTTL), time.Second))
+	Expect(p.HostCapabilities(ctx, pod)).To(BeIdenticalTo(daemon.host))
+	Expect(daemon.probes).To(Equal(2))
+
+	// the node is unknown before the pod is scheduled
+	Expect(p.HostCapabilities(ctx, &v1.Pod{})).To(BeNil())
+	Expect(daemon.probes).To(Equal(2))
+}
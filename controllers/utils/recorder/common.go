@@ -67,6 +67,40 @@ func (r NotSupported) Message() string {
 	return fmt.Sprintf("%s is not supported", r.Activity)
 }
 
+type Reselected struct {
+	Added   int
+	Dropped int
+}
+
+func (r Reselected) Type() string {
+	return "Normal"
+}
+
+func (r Reselected) Reason() string {
+	return "Reselected"
+}
+
+func (r Reselected) Message() string {
+	return fmt.Sprintf("Reselect targets: %d added, %d dropped", r.Added, r.Dropped)
+}
+
+type TargetUnsupported struct {
+	Id    string
+	Cause string
+}
+
+func (r TargetUnsupported) Type() string {
+	return "Warning"
+}
+
+func (r TargetUnsupported) Reason() string {
+	return "TargetUnsupported"
+}
+
+func (r TargetUnsupported) Message() string {
+	return fmt.Sprintf("Target %s is not capable of the chaos: %s", r.Id, r.Cause)
+}
+
 func init() {
-	register(Applied{}, Recovered{}, NotSupported{})
+	register(Applied{}, Recovered{}, NotSupported{}, Reselected{}, TargetUnsupported{})
 }
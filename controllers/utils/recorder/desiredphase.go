@@ -15,6 +15,10 @@
 
 package recorder
 
+import (
+	"fmt"
+)
+
 type Deleted struct {
 }
 
@@ -75,6 +79,54 @@ func (p Started) Message() string {
 	return "Experiment has started"
 }
 
+type Blackout struct {
+	Policy string
+}
+
+func (b Blackout) Type() string {
+	return "Normal"
+}
+
+func (b Blackout) Reason() string {
+	return "Blackout"
+}
+
+func (b Blackout) Message() string {
+	return fmt.Sprintf("Experiment has been stopped by the blackout window of ChaosPolicy %s", b.Policy)
+}
+
+type ConcurrencyExceeded struct {
+	Policy string
+}
+
+func (c ConcurrencyExceeded) Type() string {
+	return "Normal"
+}
+
+func (c ConcurrencyExceeded) Reason() string {
+	return "ConcurrencyExceeded"
+}
+
+func (c ConcurrencyExceeded) Message() string {
+	return fmt.Sprintf("Experiment is kept stopped as the running experiments have reached the maximum of ChaosPolicy %s", c.Policy)
+}
+
+type OffPeriod struct {
+	Cycle int
+}
+
+func (o OffPeriod) Type() string {
+	return "Normal"
+}
+
+func (o OffPeriod) Reason() string {
+	return "OffPeriod"
+}
+
+func (o OffPeriod) Message() string {
+	return fmt.Sprintf("Experiment has been stopped for the off period of cycle %d", o.Cycle)
+}
+
 func init() {
-	register(Deleted{}, TimeUp{}, Paused{}, Started{})
+	register(Deleted{}, TimeUp{}, Paused{}, Started{}, Blackout{}, ConcurrencyExceeded{}, OffPeriod{})
 }
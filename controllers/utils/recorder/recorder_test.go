@@ -36,6 +36,8 @@ func TestGenerateAnnotations(t *testing.T) {
 		{map[string]string{"chaos-mesh.org/id": "", "chaos-mesh.org/type": "applied"}, Applied{}},
 		{map[string]string{"chaos-mesh.org/id": "test", "chaos-mesh.org/type": "applied"}, Applied{"test"}},
 		{map[string]string{"chaos-mesh.org/id": "test", "chaos-mesh.org/type": "recovered"}, Recovered{"test"}},
+		{map[string]string{"chaos-mesh.org/added": "2", "chaos-mesh.org/dropped": "1", "chaos-mesh.org/type": "reselected"}, Reselected{Added: 2, Dropped: 1}},
+		{map[string]string{"chaos-mesh.org/id": "test", "chaos-mesh.org/cause": "fuse is unavailable", "chaos-mesh.org/type": "target-unsupported"}, TargetUnsupported{Id: "test", Cause: "fuse is unavailable"}},
 
 		{map[string]string{"chaos-mesh.org/field": "test", "chaos-mesh.org/type": "updated"}, Updated{"test"}},
 
@@ -43,6 +45,9 @@ func TestGenerateAnnotations(t *testing.T) {
 		{map[string]string{"chaos-mesh.org/type": "time-up"}, TimeUp{}},
 		{map[string]string{"chaos-mesh.org/type": "paused"}, Paused{}},
 		{map[string]string{"chaos-mesh.org/type": "started"}, Started{}},
+		{map[string]string{"chaos-mesh.org/policy": "release-freeze", "chaos-mesh.org/type": "blackout"}, Blackout{Policy: "release-freeze"}},
+		{map[string]string{"chaos-mesh.org/policy": "budget", "chaos-mesh.org/type": "concurrency-exceeded"}, ConcurrencyExceeded{Policy: "budget"}},
+		{map[string]string{"chaos-mesh.org/cycle": "2", "chaos-mesh.org/type": "off-period"}, OffPeriod{Cycle: 2}},
 
 		{map[string]string{"chaos-mesh.org/activity": "test1", "chaos-mesh.org/err": "test2", "chaos-mesh.org/type": "failed"}, Failed{"test1", "test2"}},
 		{map[string]string{"chaos-mesh.org/type": "not-supported", "chaos-mesh.org/activity": "pausing a workflow schedule"}, NotSupported{Activity: "pausing a workflow schedule"}},
@@ -80,6 +85,8 @@ func TestParse(t *testing.T) {
 	testCases := []casePair{
 		{"Successfully apply chaos for test", Applied{"test"}},
 		{"Successfully recover chaos for test", Recovered{"test"}},
+		{"Reselect targets: 2 added, 1 dropped", Reselected{Added: 2, Dropped: 1}},
+		{"Target test is not capable of the chaos: fuse is unavailable", TargetUnsupported{Id: "test", Cause: "fuse is unavailable"}},
 
 		{"Successfully update test of resource", Updated{"test"}},
 
@@ -87,6 +94,7 @@ func TestParse(t *testing.T) {
 		{"Time up according to the duration", TimeUp{}},
 		{"Experiment has been paused", Paused{}},
 		{"Experiment has started", Started{}},
+		{"Experiment has been stopped for the off period of cycle 2", OffPeriod{Cycle: 2}},
 
 		{"Failed to test1: test2", Failed{"test1", "test2"}},
 
@@ -40,6 +40,39 @@ func (it InvalidEntry) Message() string {
 	return fmt.Sprintf("failed to spawn new entry node of workflow, entry: %s", it.EntryTemplate)
 }
 
+type WorkflowTemplateResolved struct {
+	Template string
+}
+
+func (it WorkflowTemplateResolved) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it WorkflowTemplateResolved) Reason() string {
+	return v1alpha1.WorkflowTemplateResolved
+}
+
+func (it WorkflowTemplateResolved) Message() string {
+	return fmt.Sprintf("workflow template %s resolved", it.Template)
+}
+
+type WorkflowTemplateResolveFailed struct {
+	Template string
+	Error    string
+}
+
+func (it WorkflowTemplateResolveFailed) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it WorkflowTemplateResolveFailed) Reason() string {
+	return v1alpha1.WorkflowTemplateResolveFailed
+}
+
+func (it WorkflowTemplateResolveFailed) Message() string {
+	return fmt.Sprintf("failed to resolve workflow template %s: %s", it.Template, it.Error)
+}
+
 type EntryCreated struct {
 	Entry string
 }
@@ -246,6 +279,22 @@ func (it TaskPodPodCompleted) Message() string {
 	return fmt.Sprintf("pod %s for task node completed", it.PodName)
 }
 
+type TaskOutputsInvalid struct {
+	Err string
+}
+
+func (it TaskOutputsInvalid) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it TaskOutputsInvalid) Reason() string {
+	return v1alpha1.TaskOutputsInvalid
+}
+
+func (it TaskOutputsInvalid) Message() string {
+	return fmt.Sprintf("failed to collect outputs of task: %s", it.Err)
+}
+
 type ConditionalBranchesSelected struct {
 	SelectedBranches []string
 }
@@ -374,9 +423,44 @@ func (it WorkflowAborted) Message() string {
 	return fmt.Sprintf("abort the node because workflow %s aborted", it.WorkflowName)
 }
 
+type IterationScheduled struct {
+	Iteration int
+	Delay     string
+}
+
+func (it IterationScheduled) Type() string {
+	return corev1.EventTypeNormal
+}
+
+func (it IterationScheduled) Reason() string {
+	return v1alpha1.IterationScheduled
+}
+
+func (it IterationScheduled) Message() string {
+	return fmt.Sprintf("iteration %d scheduled after %s", it.Iteration, it.Delay)
+}
+
+type RetryExhausted struct {
+	Attempts int
+}
+
+func (it RetryExhausted) Type() string {
+	return corev1.EventTypeWarning
+}
+
+func (it RetryExhausted) Reason() string {
+	return v1alpha1.RetryExhausted
+}
+
+func (it RetryExhausted) Message() string {
+	return fmt.Sprintf("all the %d attempts failed", it.Attempts)
+}
+
 func init() {
 	register(
 		InvalidEntry{},
+		WorkflowTemplateResolved{},
+		WorkflowTemplateResolveFailed{},
 		EntryCreated{},
 		NodesCreated{},
 		ChaosCustomResourceCreated{},
@@ -390,6 +474,7 @@ func init() {
 		TaskPodSpawned{},
 		TaskPodSpawnFailed{},
 		TaskPodPodCompleted{},
+		TaskOutputsInvalid{},
 		ConditionalBranchesSelected{},
 		RerunBySpecChanged{},
 		StatusCheckCreated{},
@@ -397,5 +482,7 @@ func init() {
 		StatusCheckDeleted{},
 		StatusCheckDeletedFailed{},
 		ParentNodeAborted{},
+		IterationScheduled{},
+		RetryExhausted{},
 	)
 }
@@ -0,0 +1,93 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosd"
+)
+
+// Server is a fake chaosd serving the HTTP API used by the controllers
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	Version     chaosd.VersionInfo
+	Info        *chaosd.SystemInfo
+	Experiments []chaosd.Experiment
+	// Recovered are the uids of the attacks recovered by the DELETE requests
+	Recovered []string
+}
+
+// NewServer starts a fake chaosd, which should be closed after the test
+func NewServer(experiments ...chaosd.Experiment) *Server {
+	s := &Server{
+		Version:     chaosd.VersionInfo{GitVersion: "v1.4.0"},
+		Experiments: experiments,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/system/health", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/api/system/version", func(w http.ResponseWriter, r *http.Request) {
+		s.writeJSON(w, s.Version)
+	})
+	mux.HandleFunc("/api/system/info", func(w http.ResponseWriter, r *http.Request) {
+		if s.Info == nil {
+			http.NotFound(w, r)
+			return
+		}
+		s.writeJSON(w, s.Info)
+	})
+	mux.HandleFunc("/api/experiments/", func(w http.ResponseWriter, r *http.Request) {
+		s.writeJSON(w, s.Experiments)
+	})
+	mux.HandleFunc("/api/attack/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		s.recover(w, strings.TrimPrefix(r.URL.Path, "/api/attack/"))
+	})
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+func (s *Server) recover(w http.ResponseWriter, uid string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range s.Experiments {
+		if s.Experiments[i].UID == uid {
+			s.Experiments[i].Status = chaosd.ExperimentDestroyed
+			s.Recovered = append(s.Recovered, uid)
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, v interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -36,6 +36,11 @@ const (
 	stressChaos  = "stresschaos"
 	ioChaos      = "iochaos"
 	httpChaos    = "httpchaos"
+	timeChaos    = "timechaos"
+	dnsChaos     = "dnschaos"
+	jvmChaos     = "jvmchaos"
+	blockChaos   = "blockchaos"
+	kernelChaos  = "kernelchaos"
 )
 
 func NewDebugCommand(logger logr.Logger, debugs map[string]debug.Debug) (*cobra.Command, error) {
@@ -45,7 +50,8 @@ func NewDebugCommand(logger logr.Logger, debugs map[string]debug.Debug) (*cobra.
 		Use:   `debug (CHAOSTYPE) [-c CHAOSNAME] [-n NAMESPACE]`,
 		Short: `Print the debug information for certain chaos`,
 		Long: `Print the debug information for certain chaos.
-Currently support networkchaos, stresschaos, iochaos and httpchaos.
+Currently support networkchaos, stresschaos, iochaos, httpchaos, timechaos,
+dnschaos, jvmchaos, blockchaos and kernelchaos.
 
 Examples:
   # Return debug information from all networkchaos in default namespace
@@ -43,7 +43,8 @@ func NewRecoverCommand(logger logr.Logger, builders map[string]recover.Recoverer
 		Use:   `recover (CHAOSTYPE) POD[,POD[,POD...]] [-n NAMESPACE]`,
 		Short: `Recover certain chaos from certain pods`,
 		Long: `Recover certain chaos from certain pods.
-Currently unimplemented.
+Currently support networkchaos, stresschaos, iochaos, httpchaos, timechaos,
+dnschaos, jvmchaos, blockchaos and kernelchaos.
 
 Examples:
   # Recover network chaos from pods in namespace default
@@ -130,12 +131,18 @@ func (o *RecoverOptions) Run(recover recover.Recoverer, client *ctrlclient.CtrlC
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
+	var failed []string
 	for _, pod := range pods {
 		err = recover.Recover(ctx, pod)
 		if err != nil {
-			return err
+			// the other pods are still recovered, e.g. a pod not referenced by any chaos
+			common.PrettyPrint(err.Error(), 0, common.Red)
+			failed = append(failed, pod.Namespace+"/"+pod.Name)
 		}
 	}
+	if len(failed) != 0 {
+		return errors.Errorf("failed to recover pods %s", strings.Join(failed, ", "))
+	}
 	return nil
 }
 
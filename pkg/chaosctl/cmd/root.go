@@ -22,6 +22,8 @@ import (
 
 	cm "github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/debug"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/experiment"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/lint"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/recover"
 	"github.com/chaos-mesh/chaos-mesh/pkg/log"
 )
@@ -42,7 +44,14 @@ Interacting with chaos mesh
   chaosctl logs
 
   # forcedly recover chaos from pods
-  chaosctl recover networkchaos pod1 -n test`,
+  chaosctl recover networkchaos pod1 -n test
+
+  # create chaos from a file, and list all chaos
+  chaosctl apply -f chaos.yaml
+  chaosctl get -A
+
+  # watch the timeline of a chaos
+  chaosctl watch networkchaos/delay -n test`,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -90,6 +99,11 @@ func Execute() {
 		ioChaos:      debug.IODebug,
 		stressChaos:  debug.StressDebug,
 		httpChaos:    debug.HTTPDebug,
+		timeChaos:    debug.TimeDebug,
+		dnsChaos:     debug.DNSDebug,
+		jvmChaos:     debug.JVMDebug,
+		blockChaos:   debug.BlockDebug,
+		kernelChaos:  debug.KernelDebug,
 	})
 	if err != nil {
 		cm.PrettyPrint("failed to initialize cmd: ", 0, cm.Red)
@@ -102,6 +116,11 @@ func Execute() {
 		ioChaos:      recover.IORecoverer,
 		stressChaos:  recover.StressRecoverer,
 		networkChaos: recover.NetworkRecoverer,
+		timeChaos:    recover.TimeRecoverer,
+		dnsChaos:     recover.DNSRecoverer,
+		jvmChaos:     recover.JVMRecoverer,
+		blockChaos:   recover.BlockRecoverer,
+		kernelChaos:  recover.KernelRecoverer,
 	})
 	if err != nil {
 		cm.PrettyPrint("failed to initialize cmd: ", 0, cm.Red)
@@ -116,6 +135,28 @@ func Execute() {
 		os.Exit(1)
 	}
 
+	experimentCommands := []struct {
+		name string
+		new  func() (*cobra.Command, error)
+	}{
+		{"apply", experiment.NewApplyCmd},
+		{"get", experiment.NewGetCmd},
+		{"pause", experiment.NewPauseCmd},
+		{"resume", experiment.NewResumeCmd},
+		{"delete", experiment.NewDeleteCmd},
+		{"watch", experiment.NewWatchCmd},
+		{"lint", lint.NewLintCmd},
+	}
+	for _, c := range experimentCommands {
+		command, err := c.new()
+		if err != nil {
+			cm.PrettyPrint("failed to initialize cmd: ", 0, cm.Red)
+			cm.PrettyPrint(c.name+" command: "+err.Error(), 1, cm.Red)
+			os.Exit(1)
+		}
+		rootCmd.AddCommand(command)
+	}
+
 	rootCmd.AddCommand(debugCommand)
 	rootCmd.AddCommand(recoverCommand)
 	rootCmd.AddCommand(completionCmd)
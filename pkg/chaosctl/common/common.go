@@ -151,3 +151,16 @@ func InitClientSet() (*ClientSet, error) {
 	}
 	return &ClientSet{ctrlClient, kubeClient}, nil
 }
+
+// InitWatchClient inits a client which could also watch the objects
+func InitWatchClient() (client.WithWatch, error) {
+	restconfig, err := config.GetConfig()
+	if err != nil {
+		return nil, err
+	}
+	watchClient, err := client.NewWithWatch(restconfig, client.Options{Scheme: scheme})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create watch client")
+	}
+	return watchClient, nil
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// Manifest is an object decoded from a YAML or JSON document
+type Manifest struct {
+	// Source is the file and the index of document in it, e.g. chaos.yaml#1
+	Source string
+	Object client.Object
+	// Err is the error occurred while decoding the document, Object is nil if it's set
+	Err error
+}
+
+// ResolveKind returns the kind of chaos, schedule or workflow whose name equals to s case-insensitively
+func ResolveKind(s string) (string, *v1alpha1.ChaosKind, error) {
+	for name, kind := range v1alpha1.AllKindsIncludeScheduleAndWorkflow() {
+		if strings.EqualFold(name, s) {
+			return name, kind, nil
+		}
+	}
+	return "", nil, errors.Errorf("unknown kind %s", s)
+}
+
+// ReadManifests reads all chaos mesh manifests in the file, "-" means stdin. The documents which could not be
+// decoded are also returned with the error, so that all problems in the file could be reported at once.
+func ReadManifests(path string) ([]Manifest, error) {
+	var reader io.Reader
+	if path == "-" {
+		reader = os.Stdin
+	} else {
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "open %s", path)
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	var manifests []Manifest
+	docs := yaml.NewYAMLReader(bufio.NewReader(reader))
+	for i := 0; ; i++ {
+		doc, err := docs.Read()
+		if err == io.EOF {
+			return manifests, nil
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "read %s", path)
+		}
+		if len(bytes.TrimSpace(doc)) == 0 {
+			continue
+		}
+
+		source := path + "#" + strconv.Itoa(i)
+		obj, err := DecodeManifest(doc)
+		if err != nil {
+			manifests = append(manifests, Manifest{Source: source, Err: err})
+			continue
+		}
+		if obj == nil {
+			continue
+		}
+		manifests = append(manifests, Manifest{Source: source, Object: obj})
+	}
+}
+
+// DecodeManifest decodes a YAML or JSON document strictly, unknown fields are reported as error.
+// It returns nil if the document is empty.
+func DecodeManifest(doc []byte) (client.Object, error) {
+	data, err := yaml.ToJSON(doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "convert to json")
+	}
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+
+	var typeMeta metav1.TypeMeta
+	if err := json.Unmarshal(data, &typeMeta); err != nil {
+		return nil, errors.Wrap(err, "decode type meta")
+	}
+	gv := v1alpha1.GroupVersion.String()
+	if typeMeta.APIVersion != gv {
+		return nil, errors.Errorf("unsupported apiVersion %q, expected %q", typeMeta.APIVersion, gv)
+	}
+	runtimeObj, err := scheme.New(v1alpha1.GroupVersion.WithKind(typeMeta.Kind))
+	if err != nil {
+		return nil, errors.Errorf("unsupported kind %q", typeMeta.Kind)
+	}
+	obj, ok := runtimeObj.(client.Object)
+	if !ok {
+		return nil, errors.Errorf("unsupported kind %q", typeMeta.Kind)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(obj); err != nil {
+		return nil, errors.Wrapf(err, "decode %s", typeMeta.Kind)
+	}
+	return obj, nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestDecodeManifest(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		wantKind string
+		wantNil  bool
+		wantErr  bool
+	}{
+		{
+			name: "chaos",
+			doc: `apiVersion: chaos-mesh.org/v1alpha1
+kind: PodChaos
+metadata:
+  name: failure
+spec:
+  action: pod-failure
+  mode: one`,
+			wantKind: v1alpha1.KindPodChaos,
+		}, {
+			name:     "json",
+			doc:      `{"apiVersion": "chaos-mesh.org/v1alpha1", "kind": "Schedule", "metadata": {"name": "nightly"}}`,
+			wantKind: v1alpha1.KindSchedule,
+		}, {
+			name:    "empty document",
+			doc:     "# comment only",
+			wantNil: true,
+		}, {
+			name: "unsupported api version",
+			doc: `apiVersion: v1
+kind: Pod`,
+			wantErr: true,
+		}, {
+			name: "unsupported kind",
+			doc: `apiVersion: chaos-mesh.org/v1alpha1
+kind: FooChaos`,
+			wantErr: true,
+		}, {
+			name: "unknown field",
+			doc: `apiVersion: chaos-mesh.org/v1alpha1
+kind: PodChaos
+spec:
+  actoin: pod-failure`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			obj, err := DecodeManifest([]byte(tt.doc))
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			if tt.wantNil {
+				g.Expect(obj).To(gomega.BeNil())
+				return
+			}
+			g.Expect(obj.GetObjectKind().GroupVersionKind().Kind).To(gomega.Equal(tt.wantKind))
+		})
+	}
+}
+
+func TestReadManifests(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "chaos.yaml")
+	content := `apiVersion: chaos-mesh.org/v1alpha1
+kind: PodChaos
+metadata:
+  name: failure
+---
+apiVersion: chaos-mesh.org/v1alpha1
+kind: FooChaos
+`
+	g.Expect(os.WriteFile(path, []byte(content), 0644)).To(gomega.Succeed())
+
+	manifests, err := ReadManifests(path)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(manifests).To(gomega.HaveLen(2))
+	g.Expect(manifests[0].Source).To(gomega.Equal(path + "#0"))
+	g.Expect(manifests[0].Object.GetName()).To(gomega.Equal("failure"))
+	g.Expect(manifests[1].Source).To(gomega.Equal(path + "#1"))
+	g.Expect(manifests[1].Err).To(gomega.HaveOccurred())
+
+	_, err = ReadManifests(filepath.Join(t.TempDir(), "missing.yaml"))
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package common
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// Pausable is implemented by chaos and schedules
+type Pausable interface {
+	client.Object
+	IsPaused() bool
+}
+
+// SetPaused patches the pause annotation of the object, and reports whether it is changed
+func SetPaused(ctx context.Context, c client.Client, obj Pausable, pause bool) (bool, error) {
+	if obj.IsPaused() == pause {
+		return false, nil
+	}
+
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if pause {
+		annotations[v1alpha1.PauseAnnotationKey] = "true"
+	} else {
+		delete(annotations, v1alpha1.PauseAnnotationKey)
+	}
+	obj.SetAnnotations(annotations)
+	return true, c.Patch(ctx, obj, patch)
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package debug
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type blockDebugger struct {
+	client *ctrlclient.CtrlClient
+}
+
+func BlockDebug(client *ctrlclient.CtrlClient) Debugger {
+	return &blockDebugger{
+		client: client,
+	}
+}
+
+func (d *blockDebugger) Collect(ctx context.Context, namespace, chaosName string) ([]*common.ChaosResult, error) {
+	var results []*common.ChaosResult
+
+	var name *graphql.String
+	if chaosName != "" {
+		n := graphql.String(chaosName)
+		name = &n
+	}
+
+	var query struct {
+		Namespace []struct {
+			BlockChaos []struct {
+				Name string
+				Spec struct {
+					Action     string
+					VolumeName string
+					Delay      *struct {
+						Latency     string
+						Correlation string
+						Jitter      string
+					}
+				}
+				Status chaosStatus
+				Pods   []struct {
+					Namespace string
+					Name      string
+				}
+			} `graphql:"blockchaos(name: $name)"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+		"name":      name,
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return results, nil
+	}
+
+	for _, blockChaos := range query.Namespace[0].BlockChaos {
+		result := &common.ChaosResult{
+			Name: blockChaos.Name,
+		}
+
+		for _, pod := range blockChaos.Pods {
+			podResult := common.PodResult{
+				Name: pod.Name,
+			}
+
+			podResult.Items = append(podResult.Items, common.ItemResult{Name: "volume", Value: blockChaos.Spec.VolumeName})
+			if blockChaos.Spec.Delay != nil {
+				output, err := common.MarshalChaos(blockChaos.Spec.Delay)
+				if err != nil {
+					return nil, err
+				}
+				podResult.Items = append(podResult.Items, common.ItemResult{Name: "delay", Value: output})
+			}
+			podResult.Items = append(podResult.Items, blockChaos.Status.recordItems("blockchaos", pod.Namespace, pod.Name)...)
+			result.Pods = append(result.Pods, podResult)
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (d *blockDebugger) List(ctx context.Context, namespace string) ([]string, error) {
+	var query struct {
+		Namespace []struct {
+			BlockChaos []struct {
+				Name string
+			} `graphql:"blockchaos"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return nil, nil
+	}
+
+	var names []string
+	for _, blockChaos := range query.Namespace[0].BlockChaos {
+		names = append(names, string(blockChaos.Name))
+	}
+	return names, nil
+}
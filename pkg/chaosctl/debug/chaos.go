@@ -0,0 +1,58 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package debug
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+// chaosStatus is a subset of the ChaosStatus type.
+// It contains necessary information to check whether a pod is in the desired phase.
+type chaosStatus struct {
+	Experiment struct {
+		DesiredPhase string
+		Records      []struct {
+			Id, Phase string
+		} `graphql:"Records"`
+	}
+}
+
+// recordItems returns the phase of every record of the pod, or of its containers.
+// A record which is still injected while the chaos is stopped is reported as failure.
+func (s *chaosStatus) recordItems(kind, namespace, name string) []common.ItemResult {
+	var items []common.ItemResult
+	podID := namespace + "/" + name
+	for _, record := range s.Experiment.Records {
+		if record.Id != podID && !strings.HasPrefix(record.Id, podID+"/") {
+			continue
+		}
+
+		item := common.ItemResult{Name: fmt.Sprintf("record %s", record.Id), Value: record.Phase}
+		if s.Experiment.DesiredPhase == string(v1alpha1.StoppedPhase) && record.Phase == string(v1alpha1.Injected) {
+			item.Status = common.ItemFailure
+			item.ErrInfo = fmt.Sprintf("chaos is stopped but still injected, try `chaosctl recover %s %s -n %s`", kind, name, namespace)
+		} else {
+			item.Status = common.ItemSuccess
+			item.SucInfo = fmt.Sprintf("desired phase is %s", s.Experiment.DesiredPhase)
+		}
+		items = append(items, item)
+	}
+	return items
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package debug
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+func TestRecordItems(t *testing.T) {
+	RegisterTestingT(t)
+
+	var status chaosStatus
+	status.Experiment.DesiredPhase = "Run"
+	status.Experiment.Records = []struct{ Id, Phase string }{
+		{"default/pod/c1", "Injected"},
+		{"default/pod-1/c1", "Injected"},
+	}
+	Expect(status.recordItems("timechaos", "default", "pod")).To(Equal([]common.ItemResult{{
+		Name: "record default/pod/c1", Value: "Injected", Status: common.ItemSuccess, SucInfo: "desired phase is Run",
+	}}))
+
+	// a stopped chaos should not be injected any more
+	status.Experiment.DesiredPhase = "Stop"
+	status.Experiment.Records = append(status.Experiment.Records, struct{ Id, Phase string }{"default/pod", "Not Injected"})
+	Expect(status.recordItems("timechaos", "default", "pod")).To(Equal([]common.ItemResult{{
+		Name: "record default/pod/c1", Value: "Injected", Status: common.ItemFailure,
+		ErrInfo: "chaos is stopped but still injected, try `chaosctl recover timechaos pod -n default`",
+	}, {
+		Name: "record default/pod", Value: "Not Injected", Status: common.ItemSuccess, SucInfo: "desired phase is Stop",
+	}}))
+}
+
+func TestTimeDebug(t *testing.T) {
+	RegisterTestingT(t)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, _ := io.ReadAll(r.Body)
+		if !strings.Contains(string(body), "spec") {
+			w.Write([]byte(`{"data":{"namespace":[{"timechaos":[{"name":"offset"}]}]}}`))
+			return
+		}
+		w.Write([]byte(`{"data":{"namespace":[{"timechaos":[{
+			"name":"offset",
+			"spec":{"timeOffset":"-10m","clockIds":["CLOCK_REALTIME","CLOCK_MONOTONIC"]},
+			"status":{"experiment":{"desiredPhase":"Run","Records":[{"id":"default/pod/c1","phase":"Injected"}]}},
+			"pods":[{"namespace":"default","name":"pod"}]
+		}]}]}}`))
+	}))
+	defer srv.Close()
+	debugger := TimeDebug(ctrlclient.NewCtrlClient(srv.URL))
+
+	names, err := debugger.List(context.Background(), "default")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(names).To(Equal([]string{"offset"}))
+
+	results, err := debugger.Collect(context.Background(), "default", "offset")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(results).To(Equal([]*common.ChaosResult{{
+		Name: "offset",
+		Pods: []common.PodResult{{
+			Name: "pod",
+			Items: []common.ItemResult{
+				{Name: "time offset", Value: "-10m"},
+				{Name: "clock ids", Value: "CLOCK_REALTIME, CLOCK_MONOTONIC"},
+				{Name: "record default/pod/c1", Value: "Injected", Status: common.ItemSuccess, SucInfo: "desired phase is Run"},
+			},
+		}},
+	}}))
+}
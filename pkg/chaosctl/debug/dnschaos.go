@@ -0,0 +1,128 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package debug
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hasura/go-graphql-client"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type dnsDebugger struct {
+	client *ctrlclient.CtrlClient
+}
+
+func DNSDebug(client *ctrlclient.CtrlClient) Debugger {
+	return &dnsDebugger{
+		client: client,
+	}
+}
+
+func (d *dnsDebugger) Collect(ctx context.Context, namespace, chaosName string) ([]*common.ChaosResult, error) {
+	var results []*common.ChaosResult
+
+	var name *graphql.String
+	if chaosName != "" {
+		n := graphql.String(chaosName)
+		name = &n
+	}
+
+	var query struct {
+		Namespace []struct {
+			DNSChaos []struct {
+				Name string
+				Spec struct {
+					Action   string
+					Patterns []string
+				}
+				Status chaosStatus
+				Pods   []struct {
+					Namespace  string
+					Name       string
+					ResolvConf string
+				}
+			} `graphql:"dnschaos(name: $name)"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+		"name":      name,
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return results, nil
+	}
+
+	for _, dnsChaos := range query.Namespace[0].DNSChaos {
+		result := &common.ChaosResult{
+			Name: dnsChaos.Name,
+		}
+
+		for _, pod := range dnsChaos.Pods {
+			podResult := common.PodResult{
+				Name: pod.Name,
+			}
+
+			podResult.Items = append(podResult.Items, common.ItemResult{Name: "action", Value: dnsChaos.Spec.Action})
+			podResult.Items = append(podResult.Items, common.ItemResult{Name: "patterns", Value: strings.Join(dnsChaos.Spec.Patterns, ", ")})
+			podResult.Items = append(podResult.Items, common.ItemResult{Name: "cat /etc/resolv.conf", Value: pod.ResolvConf})
+			podResult.Items = append(podResult.Items, dnsChaos.Status.recordItems("dnschaos", pod.Namespace, pod.Name)...)
+			result.Pods = append(result.Pods, podResult)
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (d *dnsDebugger) List(ctx context.Context, namespace string) ([]string, error) {
+	var query struct {
+		Namespace []struct {
+			DNSChaos []struct {
+				Name string
+			} `graphql:"dnschaos"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return nil, nil
+	}
+
+	var names []string
+	for _, dnsChaos := range query.Namespace[0].DNSChaos {
+		names = append(names, string(dnsChaos.Name))
+	}
+	return names, nil
+}
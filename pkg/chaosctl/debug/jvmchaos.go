@@ -0,0 +1,132 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package debug
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hasura/go-graphql-client"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type jvmDebugger struct {
+	client *ctrlclient.CtrlClient
+}
+
+func JVMDebug(client *ctrlclient.CtrlClient) Debugger {
+	return &jvmDebugger{
+		client: client,
+	}
+}
+
+func (d *jvmDebugger) Collect(ctx context.Context, namespace, chaosName string) ([]*common.ChaosResult, error) {
+	var results []*common.ChaosResult
+
+	var name *graphql.String
+	if chaosName != "" {
+		n := graphql.String(chaosName)
+		name = &n
+	}
+
+	var query struct {
+		Namespace []struct {
+			JVMChaos []struct {
+				Name string
+				Spec struct {
+					Action string
+					Port   int32
+				}
+				Status chaosStatus
+				Podjvm []struct {
+					Pod struct {
+						Namespace string
+						Name      string
+					}
+					Rules string
+				}
+			} `graphql:"jvmchaos(name: $name)"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+		"name":      name,
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return results, nil
+	}
+
+	for _, jvmChaos := range query.Namespace[0].JVMChaos {
+		result := &common.ChaosResult{
+			Name: jvmChaos.Name,
+		}
+
+		for _, podJVMChaos := range jvmChaos.Podjvm {
+			podResult := common.PodResult{
+				Name: podJVMChaos.Pod.Name,
+			}
+
+			podResult.Items = append(podResult.Items, common.ItemResult{Name: "action", Value: jvmChaos.Spec.Action})
+			podResult.Items = append(podResult.Items, common.ItemResult{
+				Name:  fmt.Sprintf("byteman rules on port %d", jvmChaos.Spec.Port),
+				Value: podJVMChaos.Rules,
+			})
+			podResult.Items = append(podResult.Items, jvmChaos.Status.recordItems("jvmchaos", podJVMChaos.Pod.Namespace, podJVMChaos.Pod.Name)...)
+			result.Pods = append(result.Pods, podResult)
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (d *jvmDebugger) List(ctx context.Context, namespace string) ([]string, error) {
+	var query struct {
+		Namespace []struct {
+			JVMChaos []struct {
+				Name string
+			} `graphql:"jvmchaos"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return nil, nil
+	}
+
+	var names []string
+	for _, jvmChaos := range query.Namespace[0].JVMChaos {
+		names = append(names, string(jvmChaos.Name))
+	}
+	return names, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package debug
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type kernelDebugger struct {
+	client *ctrlclient.CtrlClient
+}
+
+func KernelDebug(client *ctrlclient.CtrlClient) Debugger {
+	return &kernelDebugger{
+		client: client,
+	}
+}
+
+func (d *kernelDebugger) Collect(ctx context.Context, namespace, chaosName string) ([]*common.ChaosResult, error) {
+	var results []*common.ChaosResult
+
+	var name *graphql.String
+	if chaosName != "" {
+		n := graphql.String(chaosName)
+		name = &n
+	}
+
+	var query struct {
+		Namespace []struct {
+			KernelChaos []struct {
+				Name string
+				Spec struct {
+					FailKernRequest struct {
+						Failtype  int
+						Callchain []struct {
+							Funcname   string
+							Parameters string
+							Predicate  string
+						}
+						Probability int
+						Times       int
+					}
+				}
+				Status chaosStatus
+				Pods   []struct {
+					Namespace string
+					Name      string
+				}
+			} `graphql:"kernelchaos(name: $name)"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+		"name":      name,
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return results, nil
+	}
+
+	for _, kernelChaos := range query.Namespace[0].KernelChaos {
+		result := &common.ChaosResult{
+			Name: kernelChaos.Name,
+		}
+
+		output, err := common.MarshalChaos(kernelChaos.Spec.FailKernRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range kernelChaos.Pods {
+			podResult := common.PodResult{
+				Name: pod.Name,
+			}
+
+			podResult.Items = append(podResult.Items, common.ItemResult{Name: "failKernRequest", Value: output})
+			podResult.Items = append(podResult.Items, kernelChaos.Status.recordItems("kernelchaos", pod.Namespace, pod.Name)...)
+			result.Pods = append(result.Pods, podResult)
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (d *kernelDebugger) List(ctx context.Context, namespace string) ([]string, error) {
+	var query struct {
+		Namespace []struct {
+			KernelChaos []struct {
+				Name string
+			} `graphql:"kernelchaos"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return nil, nil
+	}
+
+	var names []string
+	for _, kernelChaos := range query.Namespace[0].KernelChaos {
+		names = append(names, string(kernelChaos.Name))
+	}
+	return names, nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package debug
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hasura/go-graphql-client"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type timeDebugger struct {
+	client *ctrlclient.CtrlClient
+}
+
+func TimeDebug(client *ctrlclient.CtrlClient) Debugger {
+	return &timeDebugger{
+		client: client,
+	}
+}
+
+func (d *timeDebugger) Collect(ctx context.Context, namespace, chaosName string) ([]*common.ChaosResult, error) {
+	var results []*common.ChaosResult
+
+	var name *graphql.String
+	if chaosName != "" {
+		n := graphql.String(chaosName)
+		name = &n
+	}
+
+	var query struct {
+		Namespace []struct {
+			TimeChaos []struct {
+				Name string
+				Spec struct {
+					TimeOffset string
+					ClockIds   []string
+				}
+				Status chaosStatus
+				Pods   []struct {
+					Namespace string
+					Name      string
+				}
+			} `graphql:"timechaos(name: $name)"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+		"name":      name,
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return results, nil
+	}
+
+	for _, timeChaos := range query.Namespace[0].TimeChaos {
+		result := &common.ChaosResult{
+			Name: timeChaos.Name,
+		}
+
+		for _, pod := range timeChaos.Pods {
+			podResult := common.PodResult{
+				Name: pod.Name,
+			}
+
+			podResult.Items = append(podResult.Items, common.ItemResult{Name: "time offset", Value: timeChaos.Spec.TimeOffset})
+			podResult.Items = append(podResult.Items, common.ItemResult{Name: "clock ids", Value: strings.Join(timeChaos.Spec.ClockIds, ", ")})
+			podResult.Items = append(podResult.Items, timeChaos.Status.recordItems("timechaos", pod.Namespace, pod.Name)...)
+			result.Pods = append(result.Pods, podResult)
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (d *timeDebugger) List(ctx context.Context, namespace string) ([]string, error) {
+	var query struct {
+		Namespace []struct {
+			TimeChaos []struct {
+				Name string
+			} `graphql:"timechaos"`
+		} `graphql:"namespace(ns: $namespace)"`
+	}
+
+	variables := map[string]interface{}{
+		"namespace": graphql.String(namespace),
+	}
+
+	err := d.client.QueryClient.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(query.Namespace) == 0 {
+		return nil, nil
+	}
+
+	var names []string
+	for _, timeChaos := range query.Namespace[0].TimeChaos {
+		names = append(names, string(timeChaos.Name))
+	}
+	return names, nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+type ApplyOptions struct {
+	namespace string
+	filenames []string
+	dryRun    bool
+}
+
+func NewApplyCmd() (*cobra.Command, error) {
+	applyOption := &ApplyOptions{}
+
+	applyCmd := &cobra.Command{
+		Use:   `apply -f FILENAME [-n NAMESPACE]`,
+		Short: `Create or update chaos, schedules and workflows from YAML`,
+		Long: `Create or update chaos, schedules and workflows from YAML or JSON files.
+
+The manifests are defaulted and validated in the same way as the admission webhook before
+being sent to the cluster. Note that the spec of a chaos could not be updated once created.
+
+Examples:
+  # create the chaos in chaos.yaml
+  chaosctl apply -f chaos.yaml
+
+  # validate the manifests from stdin against the cluster without persisting them
+  cat chaos.yaml | chaosctl apply -f - --dry-run`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := applyOption.Validate(); err != nil {
+				return err
+			}
+			return applyOption.Run()
+		},
+	}
+	applyCmd.Flags().StringVarP(&applyOption.namespace, "namespace", "n", "default", "namespace of the objects which do not specify one")
+	applyCmd.Flags().StringSliceVarP(&applyOption.filenames, "filename", "f", nil, "files that contain the manifests, - means stdin")
+	applyCmd.Flags().BoolVar(&applyOption.dryRun, "dry-run", false, "if true, only submit the manifests to the server without persisting them")
+
+	return applyCmd, nil
+}
+
+func (o *ApplyOptions) Validate() error {
+	if len(o.filenames) == 0 {
+		return errors.New("-f must be specified")
+	}
+	return nil
+}
+
+func (o *ApplyOptions) Run() error {
+	var manifests []common.Manifest
+	for _, filename := range o.filenames {
+		m, err := common.ReadManifests(filename)
+		if err != nil {
+			return err
+		}
+		for _, manifest := range m {
+			if manifest.Err != nil {
+				return errors.Wrap(manifest.Err, manifest.Source)
+			}
+		}
+		manifests = append(manifests, m...)
+	}
+	if len(manifests) == 0 {
+		return errors.New("no manifest found")
+	}
+
+	// validate all the manifests before applying any of them
+	for _, m := range manifests {
+		if m.Object.GetNamespace() == "" {
+			m.Object.SetNamespace(o.namespace)
+		}
+		if defaulter, ok := m.Object.(webhook.Defaulter); ok {
+			defaulter.Default()
+		}
+		if validator, ok := m.Object.(webhook.Validator); ok {
+			if _, err := validator.ValidateCreate(); err != nil {
+				return errors.Wrapf(err, "%s is invalid", m.Source)
+			}
+		}
+	}
+
+	clientset, err := common.InitClientSet()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	for _, m := range manifests {
+		name := resourceName(m.Object.GetObjectKind().GroupVersionKind().Kind, m.Object.GetName())
+		action, err := o.apply(ctx, clientset.CtrlCli, m.Object)
+		if err != nil {
+			return errors.Wrapf(err, "apply %s", name)
+		}
+		if o.dryRun {
+			action += " (dry run)"
+		}
+		fmt.Printf("%s %s\n", name, action)
+	}
+	return nil
+}
+
+// apply creates the object, or updates it if it already exists, and returns the action taken
+func (o *ApplyOptions) apply(ctx context.Context, c client.Client, obj client.Object) (string, error) {
+	var createOpts []client.CreateOption
+	var updateOpts []client.UpdateOption
+	if o.dryRun {
+		createOpts = append(createOpts, client.DryRunAll)
+		updateOpts = append(updateOpts, client.DryRunAll)
+	}
+
+	existing := obj.DeepCopyObject().(client.Object)
+	err := c.Get(ctx, client.ObjectKeyFromObject(obj), existing)
+	if apierrors.IsNotFound(err) {
+		return "created", c.Create(ctx, obj, createOpts...)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if validator, ok := obj.(webhook.Validator); ok {
+		if _, err := validator.ValidateUpdate(existing); err != nil {
+			return "", err
+		}
+	}
+	if err := keepControllerFields(obj, existing); err != nil {
+		return "", err
+	}
+	obj.SetResourceVersion(existing.GetResourceVersion())
+	return "configured", c.Update(ctx, obj, updateOpts...)
+}
+
+// keepControllerFields carries over the status and finalizers of the existing object, which are maintained by the
+// controllers and would be dropped by the update otherwise. For example, the records in status are required to
+// recover the chaos. The annotations and labels not set in the file, and the owner references if the file sets none,
+// are kept as well, so that re-applying a paused chaos doesn't resume it.
+func keepControllerFields(obj client.Object, existing client.Object) error {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return errors.Wrap(err, "convert to unstructured")
+	}
+	existingContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(existing)
+	if err != nil {
+		return errors.Wrap(err, "convert to unstructured")
+	}
+	if status, ok := existingContent["status"]; ok {
+		content["status"] = status
+	} else {
+		delete(content, "status")
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, obj); err != nil {
+		return errors.Wrap(err, "convert from unstructured")
+	}
+
+	finalizers := existing.GetFinalizers()
+	for _, finalizer := range obj.GetFinalizers() {
+		if !controllerutil.ContainsFinalizer(existing, finalizer) {
+			finalizers = append(finalizers, finalizer)
+		}
+	}
+	obj.SetFinalizers(finalizers)
+
+	obj.SetAnnotations(mergeStringMap(existing.GetAnnotations(), obj.GetAnnotations()))
+	obj.SetLabels(mergeStringMap(existing.GetLabels(), obj.GetLabels()))
+	if len(obj.GetOwnerReferences()) == 0 {
+		obj.SetOwnerReferences(existing.GetOwnerReferences())
+	}
+	return nil
+}
+
+// mergeStringMap returns the existing entries overridden by the applied ones
+func mergeStringMap(existing map[string]string, applied map[string]string) map[string]string {
+	if len(existing) == 0 {
+		return applied
+	}
+	merged := make(map[string]string, len(existing)+len(applied))
+	for key, value := range existing {
+		merged[key] = value
+	}
+	for key, value := range applied {
+		merged[key] = value
+	}
+	return merged
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestApply(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	c := newFakeClient(t)
+
+	action, err := (&ApplyOptions{}).apply(ctx, c, newPodChaos("default", "failure"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(action).To(gomega.Equal("created"))
+
+	// the controllers add the finalizer and records after the chaos is created
+	existing := &v1alpha1.PodChaos{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "failure"}, existing)).To(gomega.Succeed())
+	existing.Finalizers = []string{"chaos-mesh/records"}
+	existing.Status.Experiment.Records = []*v1alpha1.Record{{Id: "default/pod", Phase: v1alpha1.Injected}}
+	// the chaos is paused by chaosctl pause
+	existing.Annotations = map[string]string{v1alpha1.PauseAnnotationKey: "true"}
+	g.Expect(c.Update(ctx, existing)).To(gomega.Succeed())
+
+	updated := newPodChaos("default", "failure")
+	updated.Annotations = map[string]string{"owner": "chaosctl"}
+	action, err = (&ApplyOptions{}).apply(ctx, c, updated)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(action).To(gomega.Equal("configured"))
+
+	applied := &v1alpha1.PodChaos{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Namespace: "default", Name: "failure"}, applied)).To(gomega.Succeed())
+	g.Expect(applied.Annotations).To(gomega.HaveKeyWithValue("owner", "chaosctl"))
+	g.Expect(applied.Annotations).To(gomega.HaveKeyWithValue(v1alpha1.PauseAnnotationKey, "true"))
+	g.Expect(applied.Finalizers).To(gomega.ConsistOf("chaos-mesh/records"))
+	g.Expect(applied.Status.Experiment.Records).To(gomega.HaveLen(1))
+	g.Expect(applied.Status.Experiment.Records[0].Id).To(gomega.Equal("default/pod"))
+}
+
+func TestApplyDryRun(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	c := newFakeClient(t)
+
+	action, err := (&ApplyOptions{dryRun: true}).apply(ctx, c, newPodChaos("default", "failure"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(action).To(gomega.Equal("created"))
+
+	list := &v1alpha1.PodChaosList{}
+	g.Expect(c.List(ctx, list)).To(gomega.Succeed())
+	g.Expect(list.Items).To(gomega.BeEmpty())
+}
+
+func TestKeepControllerFields(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	existing := newPodChaos("default", "failure")
+	existing.Finalizers = []string{"chaos-mesh/records"}
+	existing.Status.Experiment.Records = []*v1alpha1.Record{{Id: "default/pod", Phase: v1alpha1.Injected}}
+
+	obj := newPodChaos("default", "failure")
+	obj.Finalizers = []string{"custom"}
+	obj.Spec.Action = v1alpha1.PodKillAction
+	g.Expect(keepControllerFields(obj, existing)).To(gomega.Succeed())
+	g.Expect(obj.Finalizers).To(gomega.Equal([]string{"chaos-mesh/records", "custom"}))
+	g.Expect(obj.Status.Experiment.Records).To(gomega.Equal(existing.Status.Experiment.Records))
+	g.Expect(obj.Spec.Action).To(gomega.Equal(v1alpha1.PodKillAction))
+}
+
+func TestKeepMetadata(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	existing := newPodChaos("default", "failure")
+	existing.Annotations = map[string]string{v1alpha1.PauseAnnotationKey: "true", "note": "old"}
+	existing.Labels = map[string]string{"team": "sre"}
+	existing.OwnerReferences = []metav1.OwnerReference{{APIVersion: "chaos-mesh.org/v1alpha1", Kind: "Schedule", Name: "schedule", UID: "uid"}}
+
+	obj := newPodChaos("default", "failure")
+	obj.Annotations = map[string]string{"note": "new"}
+	g.Expect(keepControllerFields(obj, existing)).To(gomega.Succeed())
+	g.Expect(obj.Annotations).To(gomega.Equal(map[string]string{v1alpha1.PauseAnnotationKey: "true", "note": "new"}))
+	g.Expect(obj.Labels).To(gomega.Equal(map[string]string{"team": "sre"}))
+	g.Expect(obj.OwnerReferences).To(gomega.Equal(existing.OwnerReferences))
+
+	// the owner references set in the file win
+	obj = newPodChaos("default", "failure")
+	obj.OwnerReferences = []metav1.OwnerReference{{APIVersion: "v1", Kind: "ConfigMap", Name: "config", UID: "other"}}
+	g.Expect(keepControllerFields(obj, existing)).To(gomega.Succeed())
+	g.Expect(obj.OwnerReferences).To(gomega.HaveLen(1))
+	g.Expect(obj.OwnerReferences[0].Name).To(gomega.Equal("config"))
+}
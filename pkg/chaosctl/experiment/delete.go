@@ -0,0 +1,152 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+type DeleteOptions struct {
+	namespace string
+	all       bool
+	wait      bool
+	timeout   time.Duration
+}
+
+func NewDeleteCmd() (*cobra.Command, error) {
+	deleteOption := &DeleteOptions{}
+
+	deleteCmd := &cobra.Command{
+		Use:   `delete (KIND NAME... | KIND/NAME... | KIND --all) [-n NAMESPACE]`,
+		Short: `Delete chaos, schedules and workflows`,
+		Long: `Delete chaos, schedules and workflows.
+
+By default, it waits until the objects are removed, which means the chaos has been
+recovered from all its targets and the finalizers are removed.
+
+Examples:
+  chaosctl delete networkchaos/delay -n test
+
+  # delete all stress chaos without waiting for the recovery
+  chaosctl delete stresschaos --all --wait=false`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return deleteOption.Run(args)
+		},
+	}
+	deleteCmd.Flags().StringVarP(&deleteOption.namespace, "namespace", "n", "default", "namespace of the objects")
+	deleteCmd.Flags().BoolVar(&deleteOption.all, "all", false, "delete all objects of the kind in the namespace")
+	deleteCmd.Flags().BoolVar(&deleteOption.wait, "wait", true, "if true, wait for the objects to be recovered and removed")
+	deleteCmd.Flags().DurationVar(&deleteOption.timeout, "timeout", 5*time.Minute, "the time to wait for the objects to be removed")
+
+	return deleteCmd, nil
+}
+
+func (o *DeleteOptions) Run(args []string) error {
+	targets, err := parseTargets(args)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := common.InitClientSet()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	names, objects, err := o.collect(ctx, clientset.CtrlCli, targets)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range names {
+		if err := clientset.CtrlCli.Delete(ctx, objects[name]); err != nil {
+			return errors.Wrapf(err, "delete %s", name)
+		}
+		fmt.Printf("%s deleted\n", name)
+	}
+	if !o.wait {
+		return nil
+	}
+
+	for _, name := range names {
+		if err := o.waitForRemoval(ctx, clientset.CtrlCli, objects[name]); err != nil {
+			return errors.Wrapf(err, "wait for %s to be removed", name)
+		}
+		fmt.Printf("%s recovered and removed\n", name)
+	}
+	return nil
+}
+
+// collect returns the names and the objects of targets in the order of arguments, the objects of a kind are listed
+// if --all is given
+func (o *DeleteOptions) collect(ctx context.Context, c client.Client, targets []target) ([]string, map[string]client.Object, error) {
+	objects := make(map[string]client.Object)
+	var names []string
+	add := func(kind string, obj client.Object) {
+		name := resourceName(kind, obj.GetName())
+		if _, ok := objects[name]; !ok {
+			names = append(names, name)
+		}
+		objects[name] = obj
+	}
+	for _, t := range targets {
+		if t.name != "" {
+			obj := t.chaosKind.SpawnObject()
+			obj.SetNamespace(o.namespace)
+			obj.SetName(t.name)
+			add(t.kind, obj)
+			continue
+		}
+		if !o.all {
+			return nil, nil, errors.Errorf("name of %s is required, or use --all to delete all of them", t.kind)
+		}
+
+		list := t.chaosKind.SpawnList()
+		if err := c.List(ctx, list, client.InNamespace(o.namespace)); err != nil {
+			return nil, nil, errors.Wrapf(err, "list %s", t.kind)
+		}
+		for _, item := range list.GetItems() {
+			if obj, ok := item.(client.Object); ok {
+				add(t.kind, obj)
+			}
+		}
+	}
+	return names, objects, nil
+}
+
+// waitForRemoval waits until the object is not found
+func (o *DeleteOptions) waitForRemoval(ctx context.Context, c client.Client, obj client.Object) error {
+	key := client.ObjectKeyFromObject(obj)
+	return wait.PollUntilContextTimeout(ctx, time.Second, o.timeout, true, func(ctx context.Context) (bool, error) {
+		err := c.Get(ctx, key, obj.DeepCopyObject().(client.Object))
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
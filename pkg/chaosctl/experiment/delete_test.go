@@ -0,0 +1,69 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestCollect(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	c := newFakeClient(t, newPodChaos("default", "a"), newPodChaos("default", "b"), newPodChaos("test", "c"))
+	podChaos := v1alpha1.AllKinds()[v1alpha1.KindPodChaos]
+
+	names, objects, err := (&DeleteOptions{namespace: "default"}).collect(ctx, c, []target{
+		{kind: v1alpha1.KindPodChaos, chaosKind: podChaos, name: "b"},
+		{kind: v1alpha1.KindPodChaos, chaosKind: podChaos, name: "a"},
+		{kind: v1alpha1.KindPodChaos, chaosKind: podChaos, name: "b"},
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(names).To(gomega.Equal([]string{"podchaos/b", "podchaos/a"}))
+	g.Expect(objects["podchaos/a"].GetNamespace()).To(gomega.Equal("default"))
+
+	_, _, err = (&DeleteOptions{namespace: "default"}).collect(ctx, c, []target{{kind: v1alpha1.KindPodChaos, chaosKind: podChaos}})
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	names, _, err = (&DeleteOptions{namespace: "default", all: true}).collect(ctx, c, []target{{kind: v1alpha1.KindPodChaos, chaosKind: podChaos}})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(names).To(gomega.ConsistOf("podchaos/a", "podchaos/b"))
+}
+
+func TestWaitForRemoval(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	chaos := newPodChaos("default", "a")
+	chaos.Finalizers = []string{"chaos-mesh/records"}
+	c := newFakeClient(t, chaos)
+
+	// the object is kept by the finalizer until the chaos is recovered
+	g.Expect(c.Delete(ctx, newPodChaos("default", "a"))).To(gomega.Succeed())
+	err := (&DeleteOptions{timeout: 100 * time.Millisecond}).waitForRemoval(ctx, c, newPodChaos("default", "a"))
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(chaos), chaos)).To(gomega.Succeed())
+	chaos.Finalizers = nil
+	g.Expect(c.Update(ctx, chaos)).To(gomega.Succeed())
+	err = (&DeleteOptions{timeout: time.Second}).waitForRemoval(ctx, c, newPodChaos("default", "a"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+}
@@ -0,0 +1,253 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+	"github.com/chaos-mesh/chaos-mesh/pkg/status"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+type GetOptions struct {
+	namespace     string
+	allNamespaces bool
+	output        string
+}
+
+func NewGetCmd() (*cobra.Command, error) {
+	getOption := &GetOptions{}
+
+	getCmd := &cobra.Command{
+		Use:   `get [KIND [NAME...] | KIND/NAME...] [-n NAMESPACE | -A] [-o table|json|yaml]`,
+		Short: `Display chaos, schedules and workflows`,
+		Long: `Display chaos, schedules and workflows with their phase and targets.
+
+All kinds of chaos are listed if no kind is given. The TARGETS column shows how many
+records of a chaos are injected out of all its records.
+
+Examples:
+  # list all chaos in namespace default
+  chaosctl get
+
+  # list network chaos in all namespaces
+  chaosctl get networkchaos -A
+
+  # show a schedule in yaml
+  chaosctl get schedule/nightly -n test -o yaml`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := getOption.Validate(); err != nil {
+				return err
+			}
+			return getOption.Run(args)
+		},
+	}
+	getCmd.Flags().StringVarP(&getOption.namespace, "namespace", "n", "default", "namespace of the objects")
+	getCmd.Flags().BoolVarP(&getOption.allNamespaces, "all-namespaces", "A", false, "if true, list the objects across all namespaces")
+	getCmd.Flags().StringVarP(&getOption.output, "output", "o", outputTable, "output format, one of table, json and yaml")
+
+	return getCmd, nil
+}
+
+func (o *GetOptions) Validate() error {
+	switch o.output {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	default:
+		return errors.Errorf("unsupported output format %s", o.output)
+	}
+}
+
+func (o *GetOptions) Run(args []string) error {
+	var targets []target
+	if len(args) == 0 {
+		for kind, chaosKind := range v1alpha1.AllKinds() {
+			targets = append(targets, target{kind: kind, chaosKind: chaosKind})
+		}
+		sort.Slice(targets, func(i, j int) bool { return targets[i].kind < targets[j].kind })
+	} else {
+		var err error
+		if targets, err = parseTargets(args); err != nil {
+			return err
+		}
+	}
+
+	clientset, err := common.InitClientSet()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	var objects []client.Object
+	for _, t := range targets {
+		found, err := o.get(ctx, clientset.CtrlCli, t)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, found...)
+	}
+
+	return o.print(objects)
+}
+
+// get returns the experiment of target, or all experiments of the kind if its name is empty
+func (o *GetOptions) get(ctx context.Context, c client.Client, t target) ([]client.Object, error) {
+	if t.name != "" {
+		obj := t.chaosKind.SpawnObject()
+		if err := c.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: t.name}, obj); err != nil {
+			return nil, errors.Wrapf(err, "get %s", resourceName(t.kind, t.name))
+		}
+		setKind(obj, t.kind)
+		return []client.Object{obj}, nil
+	}
+
+	var opts []client.ListOption
+	if !o.allNamespaces {
+		opts = append(opts, client.InNamespace(o.namespace))
+	}
+	list := t.chaosKind.SpawnList()
+	if err := c.List(ctx, list, opts...); err != nil {
+		return nil, errors.Wrapf(err, "list %s", t.kind)
+	}
+
+	var objects []client.Object
+	for _, item := range list.GetItems() {
+		obj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		setKind(obj, t.kind)
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+// setKind fills the kind dropped by the typed client, so that the output could be applied again
+func setKind(obj client.Object, kind string) {
+	obj.GetObjectKind().SetGroupVersionKind(v1alpha1.GroupVersion.WithKind(kind))
+}
+
+func (o *GetOptions) print(objects []client.Object) error {
+	switch o.output {
+	case outputJSON:
+		data, err := json.MarshalIndent(itemsOf(objects), "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "marshal objects")
+		}
+		fmt.Println(string(data))
+	case outputYAML:
+		data, err := yaml.Marshal(itemsOf(objects))
+		if err != nil {
+			return errors.Wrap(err, "marshal objects")
+		}
+		fmt.Print(string(data))
+	default:
+		if len(objects) == 0 {
+			fmt.Println("No resources found")
+			return nil
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		header := []string{"KIND", "NAME", "PHASE", "TARGETS", "AGE"}
+		if o.allNamespaces {
+			header = append([]string{"NAMESPACE"}, header...)
+		}
+		fmt.Fprintln(w, strings.Join(header, "\t"))
+		for _, obj := range objects {
+			row := []string{
+				obj.GetObjectKind().GroupVersionKind().Kind,
+				obj.GetName(),
+				phaseOf(obj),
+				targetsOf(obj),
+				duration.HumanDuration(time.Since(obj.GetCreationTimestamp().Time)),
+			}
+			if o.allNamespaces {
+				row = append([]string{obj.GetNamespace()}, row...)
+			}
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	}
+	return nil
+}
+
+// itemsOf returns a single object, or a list of objects in the form of kubectl
+func itemsOf(objects []client.Object) interface{} {
+	if len(objects) == 1 {
+		return objects[0]
+	}
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "List",
+		"items":      objects,
+	}
+}
+
+// phaseOf returns the phase of chaos, schedule or workflow, which is the same as the one shown in dashboard
+func phaseOf(obj client.Object) string {
+	switch o := obj.(type) {
+	case *v1alpha1.Schedule:
+		return string(status.GetScheduleStatus(*o))
+	case *v1alpha1.Workflow:
+		for _, condition := range o.Status.Conditions {
+			if condition.Type == v1alpha1.WorkflowConditionAccomplished && condition.Status == corev1.ConditionTrue {
+				return string(status.Finished)
+			}
+		}
+		return string(status.Running)
+	case v1alpha1.InnerObject:
+		return string(status.GetChaosStatus(o))
+	}
+	return "unknown"
+}
+
+// targetsOf returns the count of injected records and all records of a chaos
+func targetsOf(obj client.Object) string {
+	chaos, ok := obj.(v1alpha1.StatefulObject)
+	if !ok {
+		return "-"
+	}
+	records := chaos.GetStatus().Experiment.Records
+	injected := 0
+	for _, record := range records {
+		if record.Phase == v1alpha1.Injected {
+			injected++
+		}
+	}
+	return fmt.Sprintf("%d/%d", injected, len(records))
+}
@@ -0,0 +1,76 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/status"
+)
+
+func TestGet(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	c := newFakeClient(t, newPodChaos("default", "a"), newPodChaos("default", "b"), newPodChaos("test", "c"))
+	podChaos := v1alpha1.AllKinds()[v1alpha1.KindPodChaos]
+
+	objects, err := (&GetOptions{namespace: "default"}).get(ctx, c, target{kind: v1alpha1.KindPodChaos, chaosKind: podChaos, name: "a"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objects).To(gomega.HaveLen(1))
+	g.Expect(objects[0].GetName()).To(gomega.Equal("a"))
+	g.Expect(objects[0].GetObjectKind().GroupVersionKind().Kind).To(gomega.Equal(v1alpha1.KindPodChaos))
+
+	objects, err = (&GetOptions{namespace: "default"}).get(ctx, c, target{kind: v1alpha1.KindPodChaos, chaosKind: podChaos})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objects).To(gomega.HaveLen(2))
+
+	objects, err = (&GetOptions{namespace: "default", allNamespaces: true}).get(ctx, c, target{kind: v1alpha1.KindPodChaos, chaosKind: podChaos})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objects).To(gomega.HaveLen(3))
+
+	_, err = (&GetOptions{namespace: "test"}).get(ctx, c, target{kind: v1alpha1.KindPodChaos, chaosKind: podChaos, name: "a"})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestPhaseAndTargetsOf(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	chaos := newPodChaos("default", "a")
+	chaos.Status.Conditions = []v1alpha1.ChaosCondition{
+		{Type: v1alpha1.ConditionSelected, Status: corev1.ConditionTrue},
+		{Type: v1alpha1.ConditionAllInjected, Status: corev1.ConditionTrue},
+	}
+	chaos.Status.Experiment.DesiredPhase = v1alpha1.RunningPhase
+	chaos.Status.Experiment.Records = []*v1alpha1.Record{
+		{Id: "default/a", Phase: v1alpha1.Injected},
+		{Id: "default/b", Phase: v1alpha1.NotInjected},
+	}
+	g.Expect(phaseOf(chaos)).To(gomega.Equal(string(status.Running)))
+	g.Expect(targetsOf(chaos)).To(gomega.Equal("1/2"))
+
+	workflow := &v1alpha1.Workflow{}
+	g.Expect(phaseOf(workflow)).To(gomega.Equal(string(status.Running)))
+	g.Expect(targetsOf(workflow)).To(gomega.Equal("-"))
+	workflow.Status.Conditions = []v1alpha1.WorkflowCondition{
+		{Type: v1alpha1.WorkflowConditionAccomplished, Status: corev1.ConditionTrue},
+	}
+	g.Expect(phaseOf(workflow)).To(gomega.Equal(string(status.Finished)))
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+type PauseOptions struct {
+	namespace string
+	pause     bool
+}
+
+func NewPauseCmd() (*cobra.Command, error) {
+	return newPauseCmd(true), nil
+}
+
+func NewResumeCmd() (*cobra.Command, error) {
+	return newPauseCmd(false), nil
+}
+
+func newPauseCmd(pause bool) *cobra.Command {
+	pauseOption := &PauseOptions{pause: pause}
+
+	use, short := "pause", "Pause chaos or schedules"
+	if !pause {
+		use, short = "resume", "Resume paused chaos or schedules"
+	}
+	pauseCmd := &cobra.Command{
+		Use:   use + ` (KIND NAME... | KIND/NAME...) [-n NAMESPACE]`,
+		Short: short,
+		Long: short + ` by setting the annotation ` + v1alpha1.PauseAnnotationKey + `.
+
+Examples:
+  chaosctl ` + use + ` networkchaos delay -n test
+  chaosctl ` + use + ` networkchaos/delay schedule/nightly -n test`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return pauseOption.Run(args)
+		},
+	}
+	pauseCmd.Flags().StringVarP(&pauseOption.namespace, "namespace", "n", "default", "namespace of the objects")
+
+	return pauseCmd
+}
+
+func (o *PauseOptions) Run(args []string) error {
+	targets, err := parseTargets(args)
+	if err != nil {
+		return err
+	}
+
+	clientset, err := common.InitClientSet()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	for _, t := range targets {
+		if t.name == "" {
+			return errors.Errorf("name of %s is required", t.kind)
+		}
+		name := resourceName(t.kind, t.name)
+		changed, err := o.setPaused(ctx, clientset.CtrlCli, t)
+		if err != nil {
+			return errors.Wrapf(err, "%s %s", o.verb(), name)
+		}
+		if !changed {
+			fmt.Printf("%s is already %s\n", name, o.state())
+			continue
+		}
+		fmt.Printf("%s %s\n", name, o.state())
+	}
+	return nil
+}
+
+// setPaused patches the pause annotation of the target, and reports whether it is changed
+func (o *PauseOptions) setPaused(ctx context.Context, c client.Client, t target) (bool, error) {
+	obj, ok := t.chaosKind.SpawnObject().(common.Pausable)
+	if !ok {
+		return false, errors.Errorf("%s could not be paused", t.kind)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: t.name}, obj); err != nil {
+		return false, err
+	}
+	return common.SetPaused(ctx, c, obj, o.pause)
+}
+
+func (o *PauseOptions) verb() string {
+	if o.pause {
+		return "pause"
+	}
+	return "resume"
+}
+
+func (o *PauseOptions) state() string {
+	if o.pause {
+		return "paused"
+	}
+	return "resumed"
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestSetPaused(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	c := newFakeClient(t, newPodChaos("default", "a"))
+	podChaos := target{kind: v1alpha1.KindPodChaos, chaosKind: v1alpha1.AllKinds()[v1alpha1.KindPodChaos], name: "a"}
+	key := client.ObjectKey{Namespace: "default", Name: "a"}
+
+	changed, err := (&PauseOptions{namespace: "default", pause: true}).setPaused(ctx, c, podChaos)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeTrue())
+	chaos := &v1alpha1.PodChaos{}
+	g.Expect(c.Get(ctx, key, chaos)).To(gomega.Succeed())
+	g.Expect(chaos.IsPaused()).To(gomega.BeTrue())
+
+	changed, err = (&PauseOptions{namespace: "default", pause: true}).setPaused(ctx, c, podChaos)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeFalse())
+
+	changed, err = (&PauseOptions{namespace: "default", pause: false}).setPaused(ctx, c, podChaos)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changed).To(gomega.BeTrue())
+	g.Expect(c.Get(ctx, key, chaos)).To(gomega.Succeed())
+	g.Expect(chaos.IsPaused()).To(gomega.BeFalse())
+
+	_, err = (&PauseOptions{namespace: "test", pause: true}).setPaused(ctx, c, podChaos)
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	workflow := target{kind: v1alpha1.KindWorkflow, chaosKind: v1alpha1.AllKindsIncludeScheduleAndWorkflow()[v1alpha1.KindWorkflow], name: "a"}
+	_, err = (&PauseOptions{namespace: "default", pause: true}).setPaused(ctx, c, workflow)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
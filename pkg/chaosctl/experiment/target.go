@@ -0,0 +1,77 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+// target is an experiment, or all experiments of the kind if name is empty
+type target struct {
+	kind      string
+	chaosKind *v1alpha1.ChaosKind
+	name      string
+}
+
+// parseTargets parses arguments in the form of `KIND [NAME...]` or `KIND/NAME...`
+func parseTargets(args []string) ([]target, error) {
+	if len(args) == 0 {
+		return nil, errors.New("kind is required")
+	}
+
+	if !strings.Contains(args[0], "/") {
+		kind, chaosKind, err := common.ResolveKind(args[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(args) == 1 {
+			return []target{{kind: kind, chaosKind: chaosKind}}, nil
+		}
+
+		var targets []target
+		for _, name := range args[1:] {
+			if strings.Contains(name, "/") {
+				return nil, errors.Errorf("there is no need to specify a kind for %s when the kind %s is given", name, args[0])
+			}
+			targets = append(targets, target{kind: kind, chaosKind: chaosKind, name: name})
+		}
+		return targets, nil
+	}
+
+	var targets []target
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "/", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return nil, errors.Errorf("invalid argument %s, it should be in the form of KIND/NAME", arg)
+		}
+		kind, chaosKind, err := common.ResolveKind(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target{kind: kind, chaosKind: chaosKind, name: parts[1]})
+	}
+	return targets, nil
+}
+
+// resourceName returns the name of object in the form of `kind/name`
+func resourceName(kind, name string) string {
+	return strings.ToLower(kind) + "/" + name
+}
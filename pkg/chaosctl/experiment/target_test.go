@@ -0,0 +1,110 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newFakeClient(t *testing.T, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newPodChaos(namespace, name string) *v1alpha1.PodChaos {
+	return &v1alpha1.PodChaos{
+		TypeMeta:   metav1.TypeMeta{APIVersion: v1alpha1.GroupVersion.String(), Kind: v1alpha1.KindPodChaos},
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: v1alpha1.PodChaosSpec{
+			ContainerSelector: v1alpha1.ContainerSelector{
+				PodSelector: v1alpha1.PodSelector{
+					Selector: v1alpha1.PodSelectorSpec{
+						GenericSelectorSpec: v1alpha1.GenericSelectorSpec{Namespaces: []string{namespace}},
+					},
+					Mode: v1alpha1.AllMode,
+				},
+			},
+			Action: v1alpha1.PodFailureAction,
+		},
+	}
+}
+
+func TestParseTargets(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    []target
+		wantErr bool
+	}{
+		{
+			name: "kind only",
+			args: []string{"networkchaos"},
+			want: []target{{kind: v1alpha1.KindNetworkChaos}},
+		}, {
+			name: "kind and names",
+			args: []string{"PodChaos", "a", "b"},
+			want: []target{{kind: v1alpha1.KindPodChaos, name: "a"}, {kind: v1alpha1.KindPodChaos, name: "b"}},
+		}, {
+			name: "kind/name",
+			args: []string{"podchaos/a", "schedule/nightly"},
+			want: []target{{kind: v1alpha1.KindPodChaos, name: "a"}, {kind: v1alpha1.KindSchedule, name: "nightly"}},
+		}, {
+			name:    "no argument",
+			wantErr: true,
+		}, {
+			name:    "unknown kind",
+			args:    []string{"foochaos"},
+			wantErr: true,
+		}, {
+			name:    "kind/name after kind",
+			args:    []string{"podchaos", "podchaos/a"},
+			wantErr: true,
+		}, {
+			name:    "empty name",
+			args:    []string{"podchaos/"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			targets, err := parseTargets(tt.args)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(targets).To(gomega.HaveLen(len(tt.want)))
+			for i := range targets {
+				g.Expect(targets[i].kind).To(gomega.Equal(tt.want[i].kind))
+				g.Expect(targets[i].name).To(gomega.Equal(tt.want[i].name))
+				g.Expect(targets[i].chaosKind).NotTo(gomega.BeNil())
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// snapshot is the state of an experiment and the objects related to it
+type snapshot struct {
+	object client.Object
+	// nodes, statusChecks and chaos are the objects created by a workflow
+	nodes        []v1alpha1.WorkflowNode
+	statusChecks []v1alpha1.StatusCheck
+	chaos        []client.Object
+	events       []corev1.Event
+}
+
+// timeline is the view of the experiment
+type timeline struct {
+	kind     string
+	snapshot *snapshot
+	err      error
+	// keys is true if the keys could be read from the terminal
+	keys       bool
+	confirming bool
+	message    string
+}
+
+// fetch gets the experiment and the objects related to it
+func (o *WatchOptions) fetch(ctx context.Context, c client.Client, kubeCli kubernetes.Interface, t target) (*snapshot, error) {
+	obj := t.chaosKind.SpawnObject()
+	if err := c.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: t.name}, obj); err != nil {
+		return nil, err
+	}
+	setKind(obj, t.kind)
+	snap := &snapshot{object: obj}
+	uids := map[types.UID]bool{obj.GetUID(): true}
+	// involved is the names of the objects related to the experiment by their kinds
+	involved := map[string][]string{t.kind: {t.name}}
+
+	if t.kind == v1alpha1.KindWorkflow {
+		inWorkflow := client.MatchingLabels{v1alpha1.LabelWorkflow: t.name}
+		var nodes v1alpha1.WorkflowNodeList
+		if err := c.List(ctx, &nodes, client.InNamespace(o.namespace), inWorkflow); err != nil {
+			return nil, errors.Wrap(err, "list workflow nodes")
+		}
+		snap.nodes = nodes.Items
+		sort.Slice(snap.nodes, func(i, j int) bool {
+			return snap.nodes[i].CreationTimestamp.Before(&snap.nodes[j].CreationTimestamp)
+		})
+
+		var statusChecks v1alpha1.StatusCheckList
+		if err := c.List(ctx, &statusChecks, client.InNamespace(o.namespace), inWorkflow); err != nil {
+			return nil, errors.Wrap(err, "list status checks")
+		}
+		snap.statusChecks = statusChecks.Items
+
+		for _, node := range snap.nodes {
+			uids[node.UID] = true
+			involved[v1alpha1.KindWorkflowNode] = append(involved[v1alpha1.KindWorkflowNode], node.Name)
+			ref := node.Status.ChaosResource
+			if ref == nil {
+				continue
+			}
+			chaosKind, ok := v1alpha1.AllKinds()[ref.Kind]
+			if !ok {
+				continue
+			}
+			chaos := chaosKind.SpawnObject()
+			if err := c.Get(ctx, client.ObjectKey{Namespace: o.namespace, Name: ref.Name}, chaos); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, errors.Wrapf(err, "get %s", resourceName(ref.Kind, ref.Name))
+			}
+			setKind(chaos, ref.Kind)
+			snap.chaos = append(snap.chaos, chaos)
+			uids[chaos.GetUID()] = true
+			involved[ref.Kind] = append(involved[ref.Kind], ref.Name)
+		}
+		for _, statusCheck := range snap.statusChecks {
+			uids[statusCheck.UID] = true
+			involved[v1alpha1.KindStatusCheck] = append(involved[v1alpha1.KindStatusCheck], statusCheck.Name)
+		}
+	}
+
+	kinds := make([]string, 0, len(involved))
+	for kind := range involved {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		events, err := kubeCli.CoreV1().Events(o.namespace).List(ctx, metav1.ListOptions{
+			FieldSelector: eventSelector(kind, involved[kind]...),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "list events of %s", kind)
+		}
+		// the objects which were deleted and created again with the same name are filtered out by the uid
+		for _, event := range events.Items {
+			if event.InvolvedObject.Kind == kind && uids[event.InvolvedObject.UID] {
+				snap.events = append(snap.events, event)
+			}
+		}
+	}
+	sort.Slice(snap.events, func(i, j int) bool {
+		return eventTime(snap.events[i]).Before(eventTime(snap.events[j]))
+	})
+	if len(snap.events) > maxEvents {
+		snap.events = snap.events[len(snap.events)-maxEvents:]
+	}
+
+	return snap, nil
+}
+
+// eventSelector returns the field selector of the events involving the objects of the kind. The name is
+// only selected if there is exactly one object, the others are filtered by the caller.
+func eventSelector(kind string, names ...string) string {
+	selector := fields.OneTermEqualSelector("involvedObject.kind", kind)
+	if len(names) == 1 {
+		selector = fields.AndSelectors(selector, fields.OneTermEqualSelector("involvedObject.name", names[0]))
+	}
+	return selector.String()
+}
+
+// render returns the lines of the view
+func (tl *timeline) render() []string {
+	var buf bytes.Buffer
+	if tl.snapshot == nil {
+		fmt.Fprintf(&buf, "Error: %s\n", tl.err)
+		return tl.withFooter(&buf)
+	}
+
+	snap := tl.snapshot
+	obj := snap.object
+	fmt.Fprintf(&buf, "%s %s/%s   PHASE: %s   AGE: %s\n", tl.kind, obj.GetNamespace(), obj.GetName(), phaseOf(obj), age(obj.GetCreationTimestamp().Time))
+	if tl.err != nil {
+		fmt.Fprintf(&buf, "Error: %s, the view may be outdated\n", tl.err)
+	}
+
+	switch obj := obj.(type) {
+	case *v1alpha1.Workflow:
+		renderWorkflow(&buf, snap)
+	case *v1alpha1.Schedule:
+		renderSchedule(&buf, obj)
+	case v1alpha1.InnerObject:
+		renderRecords(&buf, obj)
+	}
+
+	fmt.Fprintln(&buf)
+	fmt.Fprintln(&buf, "EVENTS")
+	if len(snap.events) == 0 {
+		fmt.Fprintln(&buf, "  <none>")
+	}
+	w := newTabWriter(&buf)
+	for _, event := range snap.events {
+		fmt.Fprintf(w, "  %s\t%s\t%s/%s\t%s\t%s\n", age(eventTime(event)), event.Type,
+			strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name, event.Reason, event.Message)
+	}
+	w.Flush()
+
+	return tl.withFooter(&buf)
+}
+
+func (tl *timeline) withFooter(buf *bytes.Buffer) []string {
+	fmt.Fprintln(buf)
+	switch {
+	case tl.confirming:
+		fmt.Fprintf(buf, "Abort the %s? (y/N)", strings.ToLower(tl.kind))
+	case tl.keys:
+		fmt.Fprint(buf, "[p] pause/resume  [a] abort  [q] quit")
+		if tl.message != "" {
+			fmt.Fprintf(buf, "   %s", tl.message)
+		}
+	default:
+		fmt.Fprintf(buf, "Updated at %s", time.Now().Format(time.RFC3339))
+	}
+	return strings.Split(buf.String(), "\n")
+}
+
+// renderRecords shows the records of a chaos with their events
+func renderRecords(buf *bytes.Buffer, chaos v1alpha1.InnerObject) {
+	experiment := chaos.GetStatus().Experiment
+	fmt.Fprintf(buf, "DESIRED PHASE: %s   PAUSED: %t\n", experiment.DesiredPhase, chaos.IsPaused())
+	fmt.Fprintln(buf)
+	fmt.Fprintf(buf, "TARGETS (%s)\n", targetsOf(chaos))
+	if len(experiment.Records) == 0 {
+		fmt.Fprintln(buf, "  <none>")
+		return
+	}
+	w := newTabWriter(buf)
+	for _, record := range experiment.Records {
+		target := record.Id
+		if record.RemoteCluster != "" {
+			target = record.RemoteCluster + "/" + target
+		}
+		fmt.Fprintf(w, "  %s\t%s\tinjected %d, recovered %d\n", target, record.Phase, record.InjectedCount, record.RecoveredCount)
+		for _, event := range record.Events {
+			timestamp := "-"
+			if event.Timestamp != nil {
+				timestamp = age(event.Timestamp.Time)
+			}
+			fmt.Fprintf(w, "    %s\t%s %s\t%s\n", timestamp, event.Operation, event.Type, event.Message)
+		}
+	}
+	w.Flush()
+}
+
+// renderSchedule shows the objects spawned by a schedule
+func renderSchedule(buf *bytes.Buffer, schedule *v1alpha1.Schedule) {
+	fmt.Fprintf(buf, "SCHEDULE: %s   TYPE: %s   PAUSED: %t\n", schedule.Spec.Schedule, schedule.Spec.Type, schedule.IsPaused())
+	if schedule.Status.LastScheduleTime.Time.IsZero() {
+		fmt.Fprintln(buf, "LAST SCHEDULED: never")
+	} else {
+		fmt.Fprintf(buf, "LAST SCHEDULED: %s ago\n", age(schedule.Status.LastScheduleTime.Time))
+	}
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "ACTIVE")
+	if len(schedule.Status.Active) == 0 {
+		fmt.Fprintln(buf, "  <none>")
+	}
+	for _, active := range schedule.Status.Active {
+		fmt.Fprintf(buf, "  %s\n", resourceName(active.Kind, active.Name))
+	}
+}
+
+// renderWorkflow shows the nodes, the status checks and the chaos of a workflow
+func renderWorkflow(buf *bytes.Buffer, snap *snapshot) {
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "NODES")
+	if len(snap.nodes) == 0 {
+		fmt.Fprintln(buf, "  <none>")
+	}
+	w := newTabWriter(buf)
+	for _, node := range snap.nodes {
+		var conditions []string
+		for _, condition := range node.Status.Conditions {
+			if condition.Status == corev1.ConditionTrue {
+				conditions = append(conditions, string(condition.Type))
+			}
+		}
+		state := "Running"
+		if len(conditions) > 0 {
+			state = strings.Join(conditions, ",")
+		}
+		chaos := ""
+		if ref := node.Status.ChaosResource; ref != nil {
+			chaos = resourceName(ref.Kind, ref.Name)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s\n", node.Name, node.Spec.Type, state, age(node.CreationTimestamp.Time), chaos)
+	}
+	w.Flush()
+
+	if len(snap.statusChecks) > 0 {
+		fmt.Fprintln(buf)
+		fmt.Fprintln(buf, "STATUS CHECKS")
+		w = newTabWriter(buf)
+		for _, statusCheck := range snap.statusChecks {
+			success, failure := 0, 0
+			last := "-"
+			for _, record := range statusCheck.Status.Records {
+				switch record.Outcome {
+				case v1alpha1.StatusCheckOutcomeSuccess:
+					success++
+				case v1alpha1.StatusCheckOutcomeFailure:
+					failure++
+				}
+				last = string(record.Outcome)
+			}
+			completed := false
+			for _, condition := range statusCheck.Status.Conditions {
+				if condition.Type == v1alpha1.StatusCheckConditionCompleted && condition.Status == corev1.ConditionTrue {
+					completed = true
+				}
+			}
+			fmt.Fprintf(w, "  %s\tsuccess %d, failure %d\tlast %s\tcompleted %t\n", statusCheck.Name, success, failure, last, completed)
+		}
+		w.Flush()
+	}
+
+	for _, chaos := range snap.chaos {
+		inner, ok := chaos.(v1alpha1.InnerObject)
+		if !ok {
+			continue
+		}
+		fmt.Fprintln(buf)
+		fmt.Fprintf(buf, "%s   PHASE: %s\n", resourceName(chaos.GetObjectKind().GroupVersionKind().Kind, chaos.GetName()), phaseOf(chaos))
+		renderRecords(buf, inner)
+	}
+}
+
+func newTabWriter(buf *bytes.Buffer) *tabwriter.Writer {
+	return tabwriter.NewWriter(buf, 0, 0, 2, ' ', 0)
+}
+
+// eventTime returns the last time the event occurred
+func eventTime(event corev1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.CreationTimestamp.Time
+	}
+}
+
+func age(t time.Time) string {
+	return duration.HumanDuration(time.Since(t))
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newEvent(name, kind, objName string, uid types.UID, reason string, at time.Time) *corev1.Event {
+	return &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Namespace: "default", Name: name},
+		InvolvedObject: corev1.ObjectReference{Kind: kind, Namespace: "default", Name: objName, UID: uid},
+		Reason:         reason,
+		LastTimestamp:  metav1.NewTime(at),
+	}
+}
+
+func TestEventSelector(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	g.Expect(eventSelector(v1alpha1.KindPodChaos, "a")).To(gomega.Equal("involvedObject.kind=PodChaos,involvedObject.name=a"))
+	g.Expect(eventSelector(v1alpha1.KindWorkflowNode, "a", "b")).To(gomega.Equal("involvedObject.kind=WorkflowNode"))
+	g.Expect(eventSelector(v1alpha1.KindWorkflowNode)).To(gomega.Equal("involvedObject.kind=WorkflowNode"))
+}
+
+func TestFetch(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	now := time.Now()
+
+	workflow := &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "wf", UID: "wf-uid"},
+		Spec:       v1alpha1.WorkflowSpec{Entry: "entry"},
+	}
+	inWorkflow := map[string]string{v1alpha1.LabelWorkflow: "wf"}
+	entry := &v1alpha1.WorkflowNode{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "entry-1", UID: "entry-uid", Labels: inWorkflow,
+			CreationTimestamp: metav1.NewTime(now.Add(-time.Minute))},
+		Spec: v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeSerial},
+	}
+	delay := &v1alpha1.WorkflowNode{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "delay-1", UID: "delay-uid", Labels: inWorkflow,
+			CreationTimestamp: metav1.NewTime(now)},
+		Spec: v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypePodChaos},
+		Status: v1alpha1.WorkflowNodeStatus{
+			ChaosResource: &corev1.TypedLocalObjectReference{
+				APIGroup: pointer.String(v1alpha1.GroupVersion.Group), Kind: v1alpha1.KindPodChaos, Name: "delay-1-chaos",
+			},
+		},
+	}
+	chaos := newPodChaos("default", "delay-1-chaos")
+	chaos.UID = "chaos-uid"
+	c := newFakeClient(t, workflow, entry, delay, chaos)
+
+	kubeCli := kubefake.NewSimpleClientset(
+		newEvent("e1", v1alpha1.KindWorkflow, "wf", "wf-uid", "Started", now.Add(-2*time.Minute)),
+		newEvent("e2", v1alpha1.KindWorkflowNode, "delay-1", "delay-uid", "ChaosCreated", now.Add(-time.Minute)),
+		newEvent("e3", v1alpha1.KindPodChaos, "delay-1-chaos", "chaos-uid", "Applied", now),
+		// the event of a removed workflow with the same name
+		newEvent("e4", v1alpha1.KindWorkflow, "wf", "old-uid", "Started", now),
+	)
+
+	o := &WatchOptions{namespace: "default"}
+	wf := target{kind: v1alpha1.KindWorkflow, chaosKind: v1alpha1.AllKindsIncludeScheduleAndWorkflow()[v1alpha1.KindWorkflow], name: "wf"}
+	snap, err := o.fetch(ctx, c, kubeCli, wf)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(snap.object.GetObjectKind().GroupVersionKind().Kind).To(gomega.Equal(v1alpha1.KindWorkflow))
+	g.Expect(snap.nodes).To(gomega.HaveLen(2))
+	g.Expect(snap.nodes[0].Name).To(gomega.Equal("entry-1"))
+	g.Expect(snap.chaos).To(gomega.HaveLen(1))
+	g.Expect(snap.chaos[0].GetName()).To(gomega.Equal("delay-1-chaos"))
+
+	var reasons []string
+	for _, event := range snap.events {
+		reasons = append(reasons, event.Reason)
+	}
+	g.Expect(reasons).To(gomega.Equal([]string{"Started", "ChaosCreated", "Applied"}))
+
+	var selectors []string
+	for _, action := range kubeCli.Actions() {
+		if list, ok := action.(k8stesting.ListAction); ok {
+			selectors = append(selectors, list.GetListRestrictions().Fields.String())
+		}
+	}
+	g.Expect(selectors).To(gomega.Equal([]string{
+		"involvedObject.kind=PodChaos,involvedObject.name=delay-1-chaos",
+		"involvedObject.kind=Workflow,involvedObject.name=wf",
+		"involvedObject.kind=WorkflowNode",
+	}))
+
+	_, err = o.fetch(ctx, c, kubeCli, target{kind: wf.kind, chaosKind: wf.chaosKind, name: "missing"})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestRender(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	chaos := newPodChaos("default", "a")
+	setKind(chaos, v1alpha1.KindPodChaos)
+	chaos.Status.Experiment.DesiredPhase = v1alpha1.RunningPhase
+	chaos.Status.Experiment.Records = []*v1alpha1.Record{{
+		Id:            "default/pod-1",
+		Phase:         v1alpha1.Injected,
+		InjectedCount: 1,
+		Events: []v1alpha1.RecordEvent{
+			*v1alpha1.NewRecordEvent(v1alpha1.TypeSucceeded, v1alpha1.Apply, "", metav1.Now()),
+		},
+	}}
+	tl := &timeline{
+		kind: v1alpha1.KindPodChaos,
+		snapshot: &snapshot{
+			object: chaos,
+			events: []corev1.Event{*newEvent("e1", v1alpha1.KindPodChaos, "a", "", "Applied", time.Now())},
+		},
+		keys:    true,
+		message: "paused",
+	}
+
+	view := strings.Join(tl.render(), "\n")
+	g.Expect(view).To(gomega.ContainSubstring("PodChaos default/a"))
+	g.Expect(view).To(gomega.ContainSubstring("DESIRED PHASE: Run"))
+	g.Expect(view).To(gomega.MatchRegexp(`default/pod-1\s+Injected\s+injected 1, recovered 0`))
+	g.Expect(view).To(gomega.MatchRegexp(`podchaos/a\s+Applied`))
+	g.Expect(view).To(gomega.HaveSuffix("[p] pause/resume  [a] abort  [q] quit   paused"))
+
+	tl.confirming = true
+	g.Expect(tl.render()).To(gomega.ContainElement("Abort the podchaos? (y/N)"))
+
+	view = strings.Join((&timeline{kind: v1alpha1.KindPodChaos, err: errors.New("forbidden")}).render(), "\n")
+	g.Expect(view).To(gomega.HavePrefix("Error: forbidden"))
+}
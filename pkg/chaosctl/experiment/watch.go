@@ -0,0 +1,361 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+const (
+	keyPause  = 'p'
+	keyAbort  = 'a'
+	keyQuit   = 'q'
+	keyYes    = 'y'
+	keyCtrlC  = 3
+	maxEvents = 10
+)
+
+type WatchOptions struct {
+	namespace string
+	interval  time.Duration
+}
+
+func NewWatchCmd() (*cobra.Command, error) {
+	watchOption := &WatchOptions{}
+
+	watchCmd := &cobra.Command{
+		Use:   `watch (KIND NAME | KIND/NAME) [-n NAMESPACE]`,
+		Short: `Watch the timeline of a chaos, schedule or workflow`,
+		Long: `Watch the timeline of a chaos, schedule or workflow in the terminal.
+
+It shows the records of the selected targets with their phase and the history of
+injections and recoveries, the nodes and status checks of workflows, and the related
+Kubernetes events. The view is updated once the objects are changed.
+
+Keys:
+  p  pause or resume the chaos or schedule
+  a  abort the experiment, which deletes the chaos or schedule, or aborts the workflow
+  q  quit
+
+Examples:
+  chaosctl watch networkchaos/delay -n test
+  chaosctl watch workflow/try-workflow-parallel`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			t, err := watchOption.Validate(args)
+			if err != nil {
+				return err
+			}
+			return watchOption.Run(t)
+		},
+	}
+	watchCmd.Flags().StringVarP(&watchOption.namespace, "namespace", "n", "default", "namespace of the object")
+	watchCmd.Flags().DurationVar(&watchOption.interval, "interval", 2*time.Second, "the interval to refresh the view besides the changes of objects")
+
+	return watchCmd, nil
+}
+
+func (o *WatchOptions) Validate(args []string) (target, error) {
+	targets, err := parseTargets(args)
+	if err != nil {
+		return target{}, err
+	}
+	if len(targets) != 1 || targets[0].name == "" {
+		return target{}, errors.New("exactly one object in the form of KIND/NAME is required")
+	}
+	if o.interval <= 0 {
+		return target{}, errors.New("interval should be positive")
+	}
+	return targets[0], nil
+}
+
+func (o *WatchOptions) Run(t target) error {
+	clientset, err := common.InitClientSet()
+	if err != nil {
+		return err
+	}
+	watchClient, err := common.InitWatchClient()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key := client.ObjectKey{Namespace: o.namespace, Name: t.name}
+	if err := clientset.CtrlCli.Get(ctx, key, t.chaosKind.SpawnObject()); err != nil {
+		return errors.Wrapf(err, "get %s", resourceName(t.kind, t.name))
+	}
+
+	screen, err := newScreen()
+	if err != nil {
+		return err
+	}
+	defer screen.restore()
+
+	changed := make(chan struct{}, 1)
+	for _, source := range o.sources(watchClient, clientset.KubeCli, t) {
+		go watchUntilDone(ctx, source, changed)
+	}
+	keys := screen.keys(ctx)
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+
+	tl := &timeline{kind: t.kind, keys: keys != nil}
+	redraw := true
+	for {
+		if redraw {
+			snap, err := o.fetch(ctx, clientset.CtrlCli, clientset.KubeCli, t)
+			if apierrors.IsNotFound(err) {
+				screen.println(fmt.Sprintf("%s is removed", resourceName(t.kind, t.name)))
+				return nil
+			}
+			if err == nil || tl.snapshot == nil {
+				tl.snapshot = snap
+			}
+			tl.err = err
+			screen.draw(tl.render())
+		}
+
+		redraw = true
+		select {
+		case <-changed:
+		case <-ticker.C:
+			// only the ages are changed, which are not worth printing again if the view could not be cleared
+			redraw = screen.raw
+		case <-signals:
+			return nil
+		case key := <-keys:
+			if quit := o.handleKey(ctx, clientset.CtrlCli, tl, key); quit {
+				return nil
+			}
+		}
+	}
+}
+
+// handleKey performs the action of the key on the object, and reports whether to quit
+func (o *WatchOptions) handleKey(ctx context.Context, c client.Client, tl *timeline, key byte) bool {
+	if tl.confirming {
+		tl.confirming = false
+		if key != keyYes {
+			tl.message = "abort is cancelled"
+			return false
+		}
+		tl.message = o.abort(ctx, c, tl)
+		return false
+	}
+
+	switch key {
+	case keyQuit, keyCtrlC:
+		return true
+	case keyPause:
+		tl.message = o.togglePause(ctx, c, tl)
+	case keyAbort:
+		if tl.snapshot != nil {
+			tl.confirming = true
+		}
+	}
+	return false
+}
+
+func (o *WatchOptions) togglePause(ctx context.Context, c client.Client, tl *timeline) string {
+	if tl.snapshot == nil {
+		return "the object is not fetched yet"
+	}
+	obj, ok := tl.snapshot.object.(common.Pausable)
+	if !ok {
+		return fmt.Sprintf("%s could not be paused", tl.kind)
+	}
+	pause := !obj.IsPaused()
+	if _, err := common.SetPaused(ctx, c, obj.DeepCopyObject().(common.Pausable), pause); err != nil {
+		return fmt.Sprintf("failed to set paused: %s", err)
+	}
+	if pause {
+		return "paused"
+	}
+	return "resumed"
+}
+
+// abort aborts a workflow with the annotation, or deletes the chaos and schedule
+func (o *WatchOptions) abort(ctx context.Context, c client.Client, tl *timeline) string {
+	obj := tl.snapshot.object.DeepCopyObject().(client.Object)
+	workflow, ok := obj.(*v1alpha1.Workflow)
+	if !ok {
+		if err := c.Delete(ctx, obj); err != nil {
+			return fmt.Sprintf("failed to delete: %s", err)
+		}
+		return "deleted, waiting for the recovery"
+	}
+
+	patch := client.MergeFrom(workflow.DeepCopy())
+	if workflow.Annotations == nil {
+		workflow.Annotations = make(map[string]string)
+	}
+	workflow.Annotations[v1alpha1.WorkflowAnnotationAbort] = "true"
+	if err := c.Patch(ctx, workflow, patch); err != nil {
+		return fmt.Sprintf("failed to abort: %s", err)
+	}
+	return "aborted"
+}
+
+// watchSource starts a watch on the objects related to the experiment
+type watchSource func(ctx context.Context) (watch.Interface, error)
+
+func (o *WatchOptions) sources(c client.WithWatch, kubeCli kubernetes.Interface, t target) []watchSource {
+	sources := []watchSource{
+		func(ctx context.Context) (watch.Interface, error) {
+			return c.Watch(ctx, t.chaosKind.SpawnList(), client.InNamespace(o.namespace),
+				client.MatchingFields{"metadata.name": t.name})
+		},
+		func(ctx context.Context) (watch.Interface, error) {
+			return kubeCli.CoreV1().Events(o.namespace).Watch(ctx, metav1.ListOptions{
+				FieldSelector: eventSelector(t.kind, t.name),
+			})
+		},
+	}
+	if t.kind != v1alpha1.KindWorkflow {
+		return sources
+	}
+
+	inWorkflow := client.MatchingLabels{v1alpha1.LabelWorkflow: t.name}
+	return append(sources,
+		func(ctx context.Context) (watch.Interface, error) {
+			return c.Watch(ctx, &v1alpha1.WorkflowNodeList{}, client.InNamespace(o.namespace), inWorkflow)
+		},
+		func(ctx context.Context) (watch.Interface, error) {
+			return c.Watch(ctx, &v1alpha1.StatusCheckList{}, client.InNamespace(o.namespace), inWorkflow)
+		},
+		func(ctx context.Context) (watch.Interface, error) {
+			return kubeCli.CoreV1().Events(o.namespace).Watch(ctx, metav1.ListOptions{
+				FieldSelector: eventSelector(v1alpha1.KindWorkflowNode),
+			})
+		},
+	)
+}
+
+// watchUntilDone notifies the changes from the source, and restarts the watch once it's closed by the server
+func watchUntilDone(ctx context.Context, source watchSource, changed chan<- struct{}) {
+	for {
+		w, err := source(ctx)
+		if err == nil {
+			for range w.ResultChan() {
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			}
+			w.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// screen draws the view in the terminal, and reads the keys if the stdin is a terminal
+type screen struct {
+	raw   bool
+	state *term.State
+}
+
+func newScreen() (*screen, error) {
+	s := &screen{}
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return s, nil
+	}
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return nil, errors.Wrap(err, "set terminal to raw mode")
+	}
+	s.raw, s.state = true, state
+	return s, nil
+}
+
+func (s *screen) restore() {
+	if s.state != nil {
+		term.Restore(int(os.Stdin.Fd()), s.state)
+	}
+}
+
+// keys returns the keys pressed, or nil if the keys could not be read
+func (s *screen) keys(ctx context.Context) <-chan byte {
+	if !s.raw {
+		return nil
+	}
+	keys := make(chan byte)
+	go func() {
+		buf := make([]byte, 1)
+		for {
+			if _, err := os.Stdin.Read(buf); err != nil {
+				return
+			}
+			select {
+			case keys <- buf[0]:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return keys
+}
+
+// draw clears the terminal and prints the lines, which are cut to fit the terminal
+func (s *screen) draw(lines []string) {
+	if !s.raw {
+		fmt.Println(strings.Join(lines, "\n"))
+		return
+	}
+	if _, height, err := term.GetSize(int(os.Stdout.Fd())); err == nil && height > 0 && len(lines) > height {
+		lines = lines[:height]
+	}
+	fmt.Print("\033[H\033[2J" + strings.Join(lines, "\r\n"))
+}
+
+func (s *screen) println(line string) {
+	if s.raw {
+		fmt.Print(line + "\r\n")
+		return
+	}
+	fmt.Println(line)
+}
@@ -0,0 +1,151 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package experiment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestWatchValidate(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	o := &WatchOptions{interval: time.Second}
+	got, err := o.Validate([]string{"podchaos/a"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(got.kind).To(gomega.Equal(v1alpha1.KindPodChaos))
+	g.Expect(got.name).To(gomega.Equal("a"))
+
+	for _, args := range [][]string{{"podchaos"}, {"podchaos", "a", "b"}, {"foochaos/a"}} {
+		_, err = o.Validate(args)
+		g.Expect(err).To(gomega.HaveOccurred(), "args %v", args)
+	}
+
+	_, err = (&WatchOptions{}).Validate([]string{"podchaos/a"})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestHandleKey(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	c := newFakeClient(t, newPodChaos("default", "a"))
+	key := client.ObjectKey{Namespace: "default", Name: "a"}
+	o := &WatchOptions{namespace: "default"}
+
+	tl := &timeline{kind: v1alpha1.KindPodChaos}
+	g.Expect(o.handleKey(ctx, c, tl, keyPause)).To(gomega.BeFalse())
+	g.Expect(tl.message).To(gomega.Equal("the object is not fetched yet"))
+	g.Expect(o.handleKey(ctx, c, tl, keyAbort)).To(gomega.BeFalse())
+	g.Expect(tl.confirming).To(gomega.BeFalse())
+
+	chaos := &v1alpha1.PodChaos{}
+	g.Expect(c.Get(ctx, key, chaos)).To(gomega.Succeed())
+	tl.snapshot = &snapshot{object: chaos}
+	g.Expect(o.handleKey(ctx, c, tl, keyPause)).To(gomega.BeFalse())
+	g.Expect(tl.message).To(gomega.Equal("paused"))
+	g.Expect(c.Get(ctx, key, chaos)).To(gomega.Succeed())
+	g.Expect(chaos.IsPaused()).To(gomega.BeTrue())
+
+	// the abort is cancelled by any key other than y
+	g.Expect(o.handleKey(ctx, c, tl, keyAbort)).To(gomega.BeFalse())
+	g.Expect(tl.confirming).To(gomega.BeTrue())
+	g.Expect(o.handleKey(ctx, c, tl, keyQuit)).To(gomega.BeFalse())
+	g.Expect(tl.message).To(gomega.Equal("abort is cancelled"))
+	g.Expect(c.Get(ctx, key, &v1alpha1.PodChaos{})).To(gomega.Succeed())
+
+	g.Expect(o.handleKey(ctx, c, tl, keyAbort)).To(gomega.BeFalse())
+	g.Expect(o.handleKey(ctx, c, tl, keyYes)).To(gomega.BeFalse())
+	g.Expect(tl.message).To(gomega.Equal("deleted, waiting for the recovery"))
+	g.Expect(apierrors.IsNotFound(c.Get(ctx, key, &v1alpha1.PodChaos{}))).To(gomega.BeTrue())
+
+	g.Expect(o.handleKey(ctx, c, tl, keyQuit)).To(gomega.BeTrue())
+	g.Expect(o.handleKey(ctx, c, tl, keyCtrlC)).To(gomega.BeTrue())
+}
+
+func TestAbortWorkflow(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx := context.Background()
+	workflow := &v1alpha1.Workflow{}
+	workflow.Namespace, workflow.Name = "default", "wf"
+	c := newFakeClient(t, workflow)
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(workflow), workflow)).To(gomega.Succeed())
+
+	o := &WatchOptions{namespace: "default"}
+	g.Expect(o.abort(ctx, c, &timeline{snapshot: &snapshot{object: workflow}})).To(gomega.Equal("aborted"))
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(workflow), workflow)).To(gomega.Succeed())
+	g.Expect(workflow.Annotations).To(gomega.HaveKeyWithValue(v1alpha1.WorkflowAnnotationAbort, "true"))
+}
+
+func TestSources(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1alpha1.AddToScheme(scheme)).To(gomega.Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	o := &WatchOptions{namespace: "default"}
+
+	tests := []struct {
+		kind      string
+		sources   int
+		selectors []string
+	}{
+		{
+			kind:      v1alpha1.KindPodChaos,
+			sources:   2,
+			selectors: []string{"involvedObject.kind=PodChaos,involvedObject.name=a"},
+		}, {
+			kind:    v1alpha1.KindWorkflow,
+			sources: 5,
+			selectors: []string{
+				"involvedObject.kind=Workflow,involvedObject.name=a",
+				"involvedObject.kind=WorkflowNode",
+			},
+		},
+	}
+	for _, tt := range tests {
+		kubeCli := kubefake.NewSimpleClientset()
+		tgt := target{kind: tt.kind, chaosKind: v1alpha1.AllKindsIncludeScheduleAndWorkflow()[tt.kind], name: "a"}
+		sources := o.sources(c, kubeCli, tgt)
+		g.Expect(sources).To(gomega.HaveLen(tt.sources), tt.kind)
+
+		for _, source := range sources {
+			w, err := source(ctx)
+			g.Expect(err).NotTo(gomega.HaveOccurred(), tt.kind)
+			w.Stop()
+		}
+		var selectors []string
+		for _, action := range kubeCli.Actions() {
+			if watch, ok := action.(k8stesting.WatchAction); ok {
+				g.Expect(watch.GetNamespace()).To(gomega.Equal("default"))
+				selectors = append(selectors, watch.GetWatchRestrictions().Fields.String())
+			}
+		}
+		g.Expect(selectors).To(gomega.Equal(tt.selectors), tt.kind)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosctl/common"
+)
+
+const (
+	LevelError   = "error"
+	LevelWarning = "warning"
+
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// Issue is a problem found in a manifest
+type Issue struct {
+	// Source is the file and the index of document in it, e.g. chaos.yaml#1
+	Source    string `json:"source"`
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Level     string `json:"level"`
+	Message   string `json:"message"`
+}
+
+type LintOptions struct {
+	namespace string
+	output    string
+}
+
+func NewLintCmd() (*cobra.Command, error) {
+	lintOption := &LintOptions{}
+
+	lintCmd := &cobra.Command{
+		Use:   `lint (FILE | DIRECTORY)... [-o text|json]`,
+		Short: `Validate chaos, schedule and workflow manifests offline`,
+		Long: `Validate chaos, schedule and workflow manifests offline.
+
+The manifests are defaulted and validated with the same logic as the admission webhook,
+including the cron syntax of schedules. The templates of workflows, workflow templates
+and schedules of workflows are also checked for missing children or branch targets,
+cycles and unreachable templates. The workflows referring to a workflow template are
+checked with the template if it's also linted.
+
+All files with the extension .yaml, .yml or .json in the directories are linted. It
+exits with non-zero status if any error is found.
+
+Examples:
+  chaosctl lint chaos.yaml workflows/
+
+  # output the issues in json for CI
+  chaosctl lint -o json manifests/`,
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := lintOption.Validate(args); err != nil {
+				return err
+			}
+			return lintOption.Run(args)
+		},
+	}
+	lintCmd.Flags().StringVarP(&lintOption.namespace, "namespace", "n", "default", "namespace of the objects which do not specify one")
+	lintCmd.Flags().StringVarP(&lintOption.output, "output", "o", outputText, "output format, one of text and json")
+
+	return lintCmd, nil
+}
+
+func (o *LintOptions) Validate(args []string) error {
+	if len(args) == 0 {
+		return errors.New("at least one file or directory is required")
+	}
+	if o.output != outputText && o.output != outputJSON {
+		return errors.Errorf("unsupported output format %s", o.output)
+	}
+	return nil
+}
+
+func (o *LintOptions) Run(args []string) error {
+	files, err := collectFiles(args)
+	if err != nil {
+		return err
+	}
+
+	var manifests []common.Manifest
+	for _, file := range files {
+		m, err := common.ReadManifests(file)
+		if err != nil {
+			return err
+		}
+		manifests = append(manifests, m...)
+	}
+
+	issues := o.lint(manifests)
+	if err := o.print(issues, len(manifests)); err != nil {
+		return err
+	}
+
+	errorCount := 0
+	for _, issue := range issues {
+		if issue.Level == LevelError {
+			errorCount++
+		}
+	}
+	if errorCount > 0 {
+		return errors.Errorf("%d errors found", errorCount)
+	}
+	return nil
+}
+
+// collectFiles returns the files and the manifests in the directories
+func collectFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		if arg == "-" {
+			files = append(files, arg)
+			continue
+		}
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+		err = filepath.WalkDir(arg, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			switch filepath.Ext(path) {
+			case ".yaml", ".yml", ".json":
+				if !d.IsDir() {
+					files = append(files, path)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "walk %s", arg)
+		}
+	}
+	return files, nil
+}
+
+func (o *LintOptions) lint(manifests []common.Manifest) []Issue {
+	// the workflow templates could be referred by the workflows in other files
+	workflowTemplates := make(map[client.ObjectKey]v1alpha1.WorkflowTemplateSpec)
+	clusterWorkflowTemplates := make(map[string]v1alpha1.WorkflowTemplateSpec)
+	for _, m := range manifests {
+		switch obj := m.Object.(type) {
+		case *v1alpha1.WorkflowTemplate:
+			workflowTemplates[client.ObjectKey{Namespace: o.namespaceOf(obj), Name: obj.Name}] = obj.Spec
+		case *v1alpha1.ClusterWorkflowTemplate:
+			clusterWorkflowTemplates[obj.Name] = obj.Spec
+		}
+	}
+
+	var issues []Issue
+	for _, m := range manifests {
+		if m.Err != nil {
+			issues = append(issues, Issue{Source: m.Source, Level: LevelError, Message: m.Err.Error()})
+			continue
+		}
+
+		var found []Issue
+		obj := m.Object
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(o.namespace)
+		}
+		if defaulter, ok := obj.(webhook.Defaulter); ok {
+			defaulter.Default()
+		}
+		if validator, ok := obj.(webhook.Validator); ok {
+			warnings, err := validator.ValidateCreate()
+			for _, warning := range warnings {
+				found = append(found, Issue{Level: LevelWarning, Message: warning})
+			}
+			if err != nil {
+				found = append(found, Issue{Level: LevelError, Message: err.Error()})
+			}
+		}
+
+		switch obj := obj.(type) {
+		case *v1alpha1.Workflow:
+			found = append(found, lintWorkflow(obj, workflowTemplates, clusterWorkflowTemplates)...)
+		case *v1alpha1.WorkflowTemplate:
+			found = append(found, lintTemplates(obj.Spec.Entry, obj.Spec.Templates)...)
+		case *v1alpha1.ClusterWorkflowTemplate:
+			found = append(found, lintTemplates(obj.Spec.Entry, obj.Spec.Templates)...)
+		case *v1alpha1.Schedule:
+			if obj.Spec.Type == v1alpha1.ScheduleTypeWorkflow && obj.Spec.Workflow != nil {
+				found = append(found, lintTemplates(obj.Spec.Workflow.Entry, obj.Spec.Workflow.Templates)...)
+			}
+		}
+
+		for _, issue := range found {
+			issue.Source = m.Source
+			issue.Kind = obj.GetObjectKind().GroupVersionKind().Kind
+			issue.Namespace = obj.GetNamespace()
+			issue.Name = obj.GetName()
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// lintWorkflow checks the templates of the workflow, which are resolved from the referred workflow template if any
+func lintWorkflow(workflow *v1alpha1.Workflow, workflowTemplates map[client.ObjectKey]v1alpha1.WorkflowTemplateSpec,
+	clusterWorkflowTemplates map[string]v1alpha1.WorkflowTemplateSpec) []Issue {
+	ref := workflow.Spec.WorkflowTemplateRef
+	if ref == nil || len(workflow.Spec.Templates) > 0 {
+		return lintTemplates(workflow.Spec.Entry, workflow.Spec.Templates)
+	}
+
+	var template v1alpha1.WorkflowTemplateSpec
+	var ok bool
+	if ref.ClusterScope {
+		template, ok = clusterWorkflowTemplates[ref.Name]
+	} else {
+		template, ok = workflowTemplates[client.ObjectKey{Namespace: workflow.Namespace, Name: ref.Name}]
+	}
+	if !ok {
+		return []Issue{{
+			Level:   LevelWarning,
+			Message: fmt.Sprintf("the referred workflow template %s is not linted, so the templates are not checked", ref.Name),
+		}}
+	}
+
+	spec := workflow.Spec.DeepCopy()
+	if err := spec.ApplyTemplate(template); err != nil {
+		return []Issue{{Level: LevelError, Message: err.Error()}}
+	}
+	resolved := &v1alpha1.Workflow{ObjectMeta: workflow.ObjectMeta, Spec: *spec}
+	resolved.Spec.WorkflowTemplateRef = nil
+	issues := lintTemplates(resolved.Spec.Entry, resolved.Spec.Templates)
+	if _, err := resolved.ValidateCreate(); err != nil {
+		issues = append(issues, Issue{Level: LevelError, Message: err.Error()})
+	}
+	return issues
+}
+
+func (o *LintOptions) namespaceOf(obj client.Object) string {
+	if obj.GetNamespace() == "" {
+		return o.namespace
+	}
+	return obj.GetNamespace()
+}
+
+func (o *LintOptions) print(issues []Issue, count int) error {
+	if o.output == outputJSON {
+		if issues == nil {
+			issues = []Issue{}
+		}
+		data, err := json.MarshalIndent(issues, "", "  ")
+		if err != nil {
+			return errors.Wrap(err, "marshal issues")
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	for _, issue := range issues {
+		object := ""
+		if issue.Kind != "" {
+			object = fmt.Sprintf(" %s %s/%s", issue.Kind, issue.Namespace, issue.Name)
+		}
+		fmt.Printf("%s%s: %s: %s\n", issue.Source, object, issue.Level, issue.Message)
+	}
+	if len(issues) == 0 {
+		fmt.Printf("%d manifests are valid\n", count)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package lint
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// references returns the names of templates referred by the template
+func references(template v1alpha1.Template) []string {
+	refs := append([]string{}, template.Children...)
+	for _, branch := range template.ConditionalBranches {
+		refs = append(refs, branch.Target)
+	}
+	return refs
+}
+
+// lintTemplates checks the references between templates, which are missing branch targets, cycles and
+// the templates unreachable from the entry.
+func lintTemplates(entry string, templates []v1alpha1.Template) []Issue {
+	var issues []Issue
+
+	byName := make(map[string]v1alpha1.Template)
+	for _, template := range templates {
+		byName[template.Name] = template
+	}
+
+	// the missing children are reported by the webhook validation, but the targets of branches are not
+	for _, template := range templates {
+		for _, branch := range template.ConditionalBranches {
+			if _, ok := byName[branch.Target]; !ok {
+				issues = append(issues, Issue{
+					Level:   LevelError,
+					Message: fmt.Sprintf("template %s has a branch to a missing template %s", template.Name, branch.Target),
+				})
+			}
+		}
+	}
+
+	// find cycles with depth-first search, each of them is reported once
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	reported := make(map[string]bool)
+	var path []string
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		path = append(path, name)
+		for _, ref := range references(byName[name]) {
+			if _, ok := byName[ref]; !ok {
+				continue
+			}
+			switch state[ref] {
+			case unvisited:
+				visit(ref)
+			case visiting:
+				var cycle []string
+				for i := len(path) - 1; i >= 0; i-- {
+					if path[i] == ref {
+						cycle = append(cycle, path[i:]...)
+						break
+					}
+				}
+				key := cycleKey(cycle)
+				if !reported[key] {
+					reported[key] = true
+					issues = append(issues, Issue{
+						Level:   LevelError,
+						Message: fmt.Sprintf("templates form a cycle: %s -> %s", strings.Join(cycle, " -> "), ref),
+					})
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+	}
+	for _, template := range templates {
+		if state[template.Name] == unvisited {
+			visit(template.Name)
+		}
+	}
+
+	if _, ok := byName[entry]; !ok {
+		// the missing entry is reported by the webhook validation
+		return issues
+	}
+	reachable := map[string]bool{entry: true}
+	queue := []string{entry}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		for _, ref := range references(byName[name]) {
+			if _, ok := byName[ref]; ok && !reachable[ref] {
+				reachable[ref] = true
+				queue = append(queue, ref)
+			}
+		}
+	}
+	for _, template := range templates {
+		if !reachable[template.Name] {
+			issues = append(issues, Issue{
+				Level:   LevelWarning,
+				Message: fmt.Sprintf("template %s is unreachable from the entry %s", template.Name, entry),
+			})
+		}
+	}
+
+	return issues
+}
+
+// cycleKey returns the same key for the rotations of a cycle
+func cycleKey(cycle []string) string {
+	sorted := append([]string{}, cycle...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package lint
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestLintTemplates(t *testing.T) {
+	serial := func(name string, children ...string) v1alpha1.Template {
+		return v1alpha1.Template{Name: name, Type: v1alpha1.TypeSerial, Children: children}
+	}
+	task := func(name string, targets ...string) v1alpha1.Template {
+		template := v1alpha1.Template{Name: name, Type: v1alpha1.TypeTask}
+		for _, target := range targets {
+			template.ConditionalBranches = append(template.ConditionalBranches, v1alpha1.ConditionalBranch{Target: target})
+		}
+		return template
+	}
+	suspend := func(name string) v1alpha1.Template {
+		return v1alpha1.Template{Name: name, Type: v1alpha1.TypeSuspend}
+	}
+
+	tests := []struct {
+		name      string
+		entry     string
+		templates []v1alpha1.Template
+		want      []Issue
+	}{
+		{
+			name:      "clean workflow",
+			entry:     "entry",
+			templates: []v1alpha1.Template{serial("entry", "check", "wait"), task("check", "wait"), suspend("wait")},
+		}, {
+			name:      "cycle",
+			entry:     "entry",
+			templates: []v1alpha1.Template{serial("entry", "a"), serial("a", "b"), serial("b", "a")},
+			want:      []Issue{{Level: LevelError, Message: "templates form a cycle: a -> b -> a"}},
+		}, {
+			name:      "unreachable template",
+			entry:     "entry",
+			templates: []v1alpha1.Template{serial("entry", "wait"), suspend("wait"), suspend("orphan")},
+			want:      []Issue{{Level: LevelWarning, Message: "template orphan is unreachable from the entry entry"}},
+		}, {
+			name:      "missing branch target",
+			entry:     "entry",
+			templates: []v1alpha1.Template{serial("entry", "check"), task("check", "missing")},
+			want:      []Issue{{Level: LevelError, Message: "template check has a branch to a missing template missing"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(lintTemplates(tt.entry, tt.templates)).To(Equal(tt.want))
+		})
+	}
+}
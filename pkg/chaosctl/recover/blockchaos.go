@@ -0,0 +1,77 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package recover
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type blockRecoverer struct {
+	client *ctrlclient.CtrlClient
+}
+
+func BlockRecoverer(client *ctrlclient.CtrlClient) Recoverer {
+	return &blockRecoverer{
+		client: client,
+	}
+}
+
+// Recover recovers block injections of all blockchaos still injected into the pod
+func (r *blockRecoverer) Recover(ctx context.Context, pod *PartialPod) error {
+	var query struct {
+		Namespace []struct {
+			BlockChaos []struct {
+				Namespace, Name string
+				Status          chaosStatus
+			} `graphql:"blockchaos"`
+		}
+	}
+
+	err := r.client.QueryClient.Query(ctx, &query, nil)
+	if err != nil {
+		return errors.Wrap(err, "list blockchaos")
+	}
+
+	referenced := false
+	for _, ns := range query.Namespace {
+		for _, chaos := range ns.BlockChaos {
+			if !chaos.Status.injectedOn(pod) {
+				continue
+			}
+			referenced = true
+			printStep(fmt.Sprintf("recovering block injections of blockchaos %s/%s", chaos.Namespace, chaos.Name))
+
+			records, err := r.client.RecoverBlockChaos(ctx, pod.Namespace, pod.Name, chaos.Namespace, chaos.Name)
+			if err != nil {
+				return err
+			}
+			if len(records) != 0 {
+				printStep(fmt.Sprintf("block injections(%s) are recovered", strings.Join(records, ", ")))
+			}
+		}
+	}
+
+	if !referenced {
+		return notReferenced("blockchaos", pod)
+	}
+	return nil
+}
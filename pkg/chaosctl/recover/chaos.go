@@ -0,0 +1,55 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package recover
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// chaosStatus is a subset of the ChaosStatus type.
+// It contains necessary information to find out whether a chaos is still injected into a pod.
+type chaosStatus struct {
+	Experiment struct {
+		Records []struct {
+			Id, Phase string
+		} `graphql:"Records"`
+	}
+}
+
+// injectedOn returns true if any record of the pod, or of its containers, is still injected
+func (s *chaosStatus) injectedOn(pod *PartialPod) bool {
+	podID := pod.Namespace + "/" + pod.Name
+	for _, record := range s.Experiment.Records {
+		if record.Phase != string(v1alpha1.Injected) {
+			continue
+		}
+		if record.Id == podID || strings.HasPrefix(record.Id, podID+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// notReferenced returns the error if no chaos of the kind references the pod. The faults left by
+// a force-deleted chaos could not be found without the chaos, so nothing is checked in the pod.
+func notReferenced(kind string, pod *PartialPod) error {
+	return errors.Errorf("no %s references pod %s/%s, so nothing is checked or recovered, "+
+		"the pod may still be affected if the chaos has been force-deleted", kind, pod.Namespace, pod.Name)
+}
@@ -0,0 +1,173 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package recover
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+// graphqlRequest is a request received by the fake ctrl server
+type graphqlRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables"`
+}
+
+// fakeCtrlServer replies the requests with the data of the first field found in the query
+type fakeCtrlServer struct {
+	sync.Mutex
+	data     map[string]string
+	requests []graphqlRequest
+}
+
+func (s *fakeCtrlServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req graphqlRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.Lock()
+	s.requests = append(s.requests, req)
+	s.Unlock()
+
+	for field, data := range s.data {
+		if strings.Contains(req.Query, field) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"data":` + data + `}`))
+			return
+		}
+	}
+	w.Write([]byte(`{"errors":[{"message":"unexpected query"}]}`))
+}
+
+func newFakeCtrlClient(t *testing.T, data map[string]string) (*ctrlclient.CtrlClient, *fakeCtrlServer) {
+	s := &fakeCtrlServer{data: data}
+	srv := httptest.NewServer(s)
+	t.Cleanup(srv.Close)
+	return ctrlclient.NewCtrlClient(srv.URL), s
+}
+
+func TestRecoverChaos(t *testing.T) {
+	pod := &PartialPod{Namespace: "default", Name: "pod"}
+
+	tests := []struct {
+		builder  RecovererBuilder
+		list     string
+		mutation string
+	}{
+		{builder: TimeRecoverer, list: "timechaos", mutation: "recoverTimeChaos"},
+		{builder: BlockRecoverer, list: "blockchaos", mutation: "recoverBlockChaos"},
+		{builder: KernelRecoverer, list: "kernelchaos", mutation: "recoverKernelChaos"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.list, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			// only the chaos still injected into the pod is recovered
+			client, server := newFakeCtrlClient(t, map[string]string{
+				tt.mutation: `{"pod":{"` + tt.mutation + `":["c1"]}}`,
+				tt.list: `{"namespace":[{"` + tt.list + `":[
+					{"namespace":"chaos","name":"injected","status":{"experiment":{"Records":[
+						{"id":"default/pod/c1","phase":"Injected"}]}}},
+					{"namespace":"chaos","name":"recovered","status":{"experiment":{"Records":[
+						{"id":"default/pod/c1","phase":"Not Injected"}]}}},
+					{"namespace":"chaos","name":"other","status":{"experiment":{"Records":[
+						{"id":"default/pod-1/c1","phase":"Injected"}]}}}
+				]}]}`,
+			})
+			Expect(tt.builder(client).Recover(context.Background(), pod)).To(Succeed())
+
+			Expect(server.requests).To(HaveLen(2))
+			Expect(server.requests[1].Query).To(HavePrefix("mutation"))
+			Expect(server.requests[1].Query).To(ContainSubstring(tt.mutation))
+			Expect(server.requests[1].Variables).To(Equal(map[string]interface{}{
+				"ns": "default", "name": "pod", "chaosNs": "chaos", "chaosName": "injected",
+			}))
+		})
+	}
+}
+
+func TestRecoverChaosError(t *testing.T) {
+	RegisterTestingT(t)
+	pod := &PartialPod{Namespace: "default", Name: "pod"}
+
+	client, server := newFakeCtrlClient(t, map[string]string{
+		"timechaos": `{"namespace":[{"timechaos":[
+			{"namespace":"chaos","name":"a","status":{"experiment":{"Records":[{"id":"default/pod","phase":"Injected"}]}}},
+			{"namespace":"chaos","name":"b","status":{"experiment":{"Records":[{"id":"default/pod","phase":"Injected"}]}}}
+		]}]}`,
+	})
+	err := TimeRecoverer(client).Recover(context.Background(), pod)
+	Expect(err).To(HaveOccurred())
+	Expect(err.Error()).To(ContainSubstring("recover timechaos chaos/a"))
+	// the recovery stops at the first failure
+	Expect(server.requests).To(HaveLen(2))
+}
+
+func TestRecoverNotReferencedPod(t *testing.T) {
+	pod := &PartialPod{Namespace: "default", Name: "pod"}
+
+	tests := []struct {
+		builder RecovererBuilder
+		list    string
+	}{
+		{builder: TimeRecoverer, list: "timechaos"},
+		{builder: BlockRecoverer, list: "blockchaos"},
+		{builder: KernelRecoverer, list: "kernelchaos"},
+		{builder: JVMRecoverer, list: "jvmchaos"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.list, func(t *testing.T) {
+			RegisterTestingT(t)
+
+			// the chaos has been force-deleted, so the faults left in the pod could not be found
+			client, server := newFakeCtrlClient(t, map[string]string{
+				tt.list: `{"namespace":[{"` + tt.list + `":[
+					{"namespace":"chaos","name":"other","status":{"experiment":{"Records":[
+						{"id":"default/pod-1/c1","phase":"Injected"}]}}}
+				]}]}`,
+			})
+			err := tt.builder(client).Recover(context.Background(), pod)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("no " + tt.list + " references pod default/pod"))
+			Expect(server.requests).To(HaveLen(1))
+		})
+	}
+}
+
+func TestInjectedOn(t *testing.T) {
+	RegisterTestingT(t)
+	pod := &PartialPod{Namespace: "default", Name: "pod"}
+
+	var status chaosStatus
+	Expect(status.injectedOn(pod)).To(BeFalse())
+
+	status.Experiment.Records = append(status.Experiment.Records, struct{ Id, Phase string }{"default/pod-1", "Injected"})
+	Expect(status.injectedOn(pod)).To(BeFalse())
+	status.Experiment.Records = append(status.Experiment.Records, struct{ Id, Phase string }{"default/pod/c1", "Not Injected"})
+	Expect(status.injectedOn(pod)).To(BeFalse())
+	status.Experiment.Records = append(status.Experiment.Records, struct{ Id, Phase string }{"default/pod", "Injected"})
+	Expect(status.injectedOn(pod)).To(BeTrue())
+}
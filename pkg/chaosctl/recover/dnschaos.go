@@ -0,0 +1,49 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package recover
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type dnsRecoverer struct {
+	client *ctrlclient.CtrlClient
+}
+
+func DNSRecoverer(client *ctrlclient.CtrlClient) Recoverer {
+	return &dnsRecoverer{
+		client: client,
+	}
+}
+
+// Recover restores /etc/resolv.conf from the backup created by chaos-daemon
+func (r *dnsRecoverer) Recover(ctx context.Context, pod *PartialPod) error {
+	printStep("restoring /etc/resolv.conf")
+	restored, err := r.client.RestoreResolvConf(ctx, pod.Namespace, pod.Name)
+	if err != nil {
+		return errors.Wrap(err, "restore resolv.conf")
+	}
+	if restored {
+		printStep("/etc/resolv.conf is restored")
+	} else {
+		printStep("no backup of /etc/resolv.conf is found, it has never been modified by dnschaos")
+	}
+	return nil
+}
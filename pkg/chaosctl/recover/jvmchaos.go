@@ -0,0 +1,80 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package recover
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type jvmRecoverer struct {
+	client *ctrlclient.CtrlClient
+}
+
+func JVMRecoverer(client *ctrlclient.CtrlClient) Recoverer {
+	return &jvmRecoverer{
+		client: client,
+	}
+}
+
+// Recover uninstalls byteman rules from the agents of all jvmchaos still injected into the pod
+func (r *jvmRecoverer) Recover(ctx context.Context, pod *PartialPod) error {
+	var query struct {
+		Namespace []struct {
+			JVMChaos []struct {
+				Namespace, Name string
+				Spec            struct {
+					Port int32
+				}
+				Status chaosStatus
+			} `graphql:"jvmchaos"`
+		}
+	}
+
+	err := r.client.QueryClient.Query(ctx, &query, nil)
+	if err != nil {
+		return errors.Wrap(err, "list jvmchaos")
+	}
+
+	ports := map[int32]bool{}
+	for _, ns := range query.Namespace {
+		for _, chaos := range ns.JVMChaos {
+			if chaos.Status.injectedOn(pod) {
+				ports[chaos.Spec.Port] = true
+			}
+		}
+	}
+
+	if len(ports) == 0 {
+		return notReferenced("jvmchaos", pod)
+	}
+
+	for port := range ports {
+		printStep(fmt.Sprintf("uninstalling byteman rules from the agent on port %d", port))
+		uninstalled, err := r.client.UninstallJVMRules(ctx, pod.Namespace, pod.Name, port)
+		if err != nil {
+			return err
+		}
+		if uninstalled {
+			printStep(fmt.Sprintf("byteman rules on port %d are uninstalled", port))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package recover
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+)
+
+type kernelRecoverer struct {
+	client *ctrlclient.CtrlClient
+}
+
+func KernelRecoverer(client *ctrlclient.CtrlClient) Recoverer {
+	return &kernelRecoverer{
+		client: client,
+	}
+}
+
+// Recover recovers kernel faults of all kernelchaos still injected into the pod
+func (r *kernelRecoverer) Recover(ctx context.Context, pod *PartialPod) error {
+	var query struct {
+		Namespace []struct {
+			KernelChaos []struct {
+				Namespace, Name string
+				Status          chaosStatus
+			} `graphql:"kernelchaos"`
+		}
+	}
+
+	err := r.client.QueryClient.Query(ctx, &query, nil)
+	if err != nil {
+		return errors.Wrap(err, "list kernelchaos")
+	}
+
+	referenced := false
+	for _, ns := range query.Namespace {
+		for _, chaos := range ns.KernelChaos {
+			if !chaos.Status.injectedOn(pod) {
+				continue
+			}
+			referenced = true
+			printStep(fmt.Sprintf("recovering kernel faults injected by kernelchaos %s/%s", chaos.Namespace, chaos.Name))
+
+			containers, err := r.client.RecoverKernelChaos(ctx, pod.Namespace, pod.Name, chaos.Namespace, chaos.Name)
+			if err != nil {
+				return err
+			}
+			if len(containers) != 0 {
+				printStep(fmt.Sprintf("kernel faults of containers %s are recovered", strings.Join(containers, ", ")))
+			}
+		}
+	}
+
+	if !referenced {
+		return notReferenced("kernelchaos", pod)
+	}
+	return nil
+}
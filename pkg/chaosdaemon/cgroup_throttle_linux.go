@@ -0,0 +1,157 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	containerdcgroups "github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+const (
+	cpuMaxFile     = "cpu.max"
+	memoryHighFile = "memory.high"
+	ioMaxFile      = "io.max"
+
+	// ioMaxUnlimited is written to io.max to remove the limits of a device
+	ioMaxUnlimited = "rbps=max wbps=max riops=max wiops=max"
+)
+
+var deviceNumberRegexp = regexp.MustCompile(`^\d+:\d+$`)
+
+// applyCgroupThrottle writes the throttle to the cgroup v2 of the container, and returns the original values, so
+// that they could be restored when recovering. The applied values are rolled back if any of them fails.
+func applyCgroupThrottle(pid uint32, path string, throttle *pb.CgroupThrottle) (*pb.CgroupThrottle, error) {
+	if mode := cgroups.HostMode(); mode != containerdcgroups.Unified {
+		return nil, errors.Errorf("cgroup throttle requires cgroup v2, but the cgroup of the node is %s", cgroups.ModeName(mode))
+	}
+
+	original := &pb.CgroupThrottle{}
+	rollback := func(err error) (*pb.CgroupThrottle, error) {
+		if rerr := restoreCgroupThrottle(path, original); rerr != nil {
+			return nil, errors.Wrapf(err, "rollback the cgroup throttle: %s", rerr.Error())
+		}
+		return nil, err
+	}
+
+	if throttle.CpuMax != "" {
+		value, err := cgroups.ReadV2File(path, cpuMaxFile)
+		if err != nil {
+			return nil, err
+		}
+		if err := cgroups.WriteV2File(path, cpuMaxFile, throttle.CpuMax); err != nil {
+			return nil, err
+		}
+		original.CpuMax = value
+	}
+
+	if throttle.MemoryHigh != "" {
+		value, err := cgroups.ReadV2File(path, memoryHighFile)
+		if err != nil {
+			return rollback(err)
+		}
+		if err := cgroups.WriteV2File(path, memoryHighFile, throttle.MemoryHigh); err != nil {
+			return rollback(err)
+		}
+		original.MemoryHigh = value
+	}
+
+	if len(throttle.IoMax) > 0 {
+		content, err := cgroups.ReadV2File(path, ioMaxFile)
+		if err != nil {
+			return rollback(err)
+		}
+		for _, line := range throttle.IoMax {
+			device, limits, _ := strings.Cut(strings.TrimSpace(line), " ")
+			device, err := resolveBlockDevice(pid, device)
+			if err != nil {
+				return rollback(err)
+			}
+			if err := cgroups.WriteV2File(path, ioMaxFile, device+" "+strings.TrimSpace(limits)); err != nil {
+				return rollback(err)
+			}
+			original.IoMax = append(original.IoMax, originalIOMax(content, device))
+		}
+	}
+
+	return original, nil
+}
+
+// restoreCgroupThrottle restores the original values of the cgroup v2 of the container. It's fine if the cgroup
+// has gone with the container.
+func restoreCgroupThrottle(path string, original *pb.CgroupThrottle) error {
+	var failed []string
+	restore := func(file string, value string) {
+		if err := cgroups.WriteV2File(path, file, value); err != nil && !errors.Is(err, os.ErrNotExist) {
+			failed = append(failed, err.Error())
+		}
+	}
+
+	if original.CpuMax != "" {
+		restore(cpuMaxFile, original.CpuMax)
+	}
+	if original.MemoryHigh != "" {
+		restore(memoryHighFile, original.MemoryHigh)
+	}
+	for _, line := range original.IoMax {
+		restore(ioMaxFile, line)
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("restore cgroup throttle: %s", strings.Join(failed, "; "))
+	}
+	return nil
+}
+
+// resolveBlockDevice returns the "<major>:<minor>" of the block device. The device could be the number already,
+// or the path of the device in the container.
+func resolveBlockDevice(pid uint32, device string) (string, error) {
+	if deviceNumberRegexp.MatchString(device) {
+		return device, nil
+	}
+
+	var st unix.Stat_t
+	devicePath := filepath.Join(bpm.DefaultProcPrefix, strconv.Itoa(int(pid)), "root", device)
+	if err := unix.Stat(devicePath, &st); err != nil {
+		return "", errors.Wrapf(err, "stat device %s", device)
+	}
+	if st.Mode&unix.S_IFMT != unix.S_IFBLK {
+		return "", errors.Errorf("%s is not a block device", device)
+	}
+	return fmt.Sprintf("%d:%d", unix.Major(uint64(st.Rdev)), unix.Minor(uint64(st.Rdev))), nil
+}
+
+// originalIOMax returns the line of the device in the content of io.max, which is used to restore the limits of
+// the device. The devices without limits are absent in io.max, so their limits are removed when restoring.
+func originalIOMax(content string, device string) string {
+	for _, line := range strings.Split(content, "\n") {
+		if fields := strings.Fields(line); len(fields) > 0 && fields[0] == device {
+			return strings.TrimSpace(line)
+		}
+	}
+	return device + " " + ioMaxUnlimited
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+var _ = Describe("cgroup throttle", func() {
+	Context("originalIOMax", func() {
+		It("should return the original limits of the device", func() {
+			content := "8:0 rbps=1048576 wbps=max riops=max wiops=100\n8:16 rbps=max wbps=max riops=50 wiops=max"
+
+			Expect(originalIOMax(content, "8:16")).To(Equal("8:16 rbps=max wbps=max riops=50 wiops=max"))
+			Expect(originalIOMax(content, "8:1")).To(Equal("8:1 rbps=max wbps=max riops=max wiops=max"))
+			Expect(originalIOMax("", "8:0")).To(Equal("8:0 rbps=max wbps=max riops=max wiops=max"))
+		})
+	})
+
+	Context("resolveBlockDevice", func() {
+		It("should keep the device number", func() {
+			device, err := resolveBlockDevice(1, "8:0")
+			Expect(err).To(BeNil())
+			Expect(device).To(Equal("8:0"))
+		})
+	})
+
+	Context("cgroup driver", func() {
+		It("should be detected from the cgroup path", func() {
+			Expect(cgroups.Driver("/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod1234.slice/cri-containerd-abcd.scope")).To(Equal(cgroups.DriverSystemd))
+			Expect(cgroups.Driver("/kubepods/burstable/pod1234/abcd")).To(Equal(cgroups.DriverCgroupfs))
+		})
+	})
+
+	Context("CancelStressors", func() {
+		It("should try all the steps and report the failures together", func() {
+			s := &DaemonServer{
+				backgroundProcessManager: bpm.StartBackgroundProcessManager(nil, logr.Discard()),
+				rootLogger:               logr.Discard(),
+			}
+
+			_, err := s.CancelStressors(context.Background(), &pb.CancelStressRequest{
+				CpuInstanceUid:    "missing-cpu",
+				MemoryInstanceUid: "missing-memory",
+				CgroupPath:        "/kubepods.slice/missing.scope",
+				OriginalThrottle:  &pb.CgroupThrottle{CpuMax: "max 100000", MemoryHigh: "max"},
+			})
+			Expect(err).NotTo(BeNil())
+			Expect(err.Error()).To(ContainSubstring("kill cpu stressors: failed to find process with uid missing-cpu"))
+			Expect(err.Error()).To(ContainSubstring("kill memory stressors: failed to find process with uid missing-memory"))
+		})
+	})
+})
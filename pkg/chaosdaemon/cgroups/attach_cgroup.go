@@ -83,7 +83,7 @@ func (a *AttachCGroupV2) TargetCGroup() CGroupInfo {
 func (a *AttachCGroupV2) AttachProcess(pid int) error {
 	// escape the CGroup Namespace, we could not modify cgroups across different cgroups namespace,
 	// resolve https://github.com/chaos-mesh/chaos-mesh/pull/2928#issuecomment-1049465242
-	targetFile := fmt.Sprintf("/host-sys/fs/cgroup%s/cgroup.procs", a.path)
+	targetFile := fmt.Sprintf("%s%s/cgroup.procs", HostCgroupRoot, a.path)
 	command := exec.Command("nsenter", "-C", "-t", "1", "--", "sh", "-c", fmt.Sprintf("echo %d >> %s", pid, targetFile))
 	output, err := command.CombinedOutput()
 	if err != nil {
@@ -94,7 +94,8 @@ func (a *AttachCGroupV2) AttachProcess(pid int) error {
 
 // GetAttacherForPID return a AttachCGroup, which could attach a process to the same cgroup of the target pid
 func GetAttacherForPID(targetPID int) (AttachCGroup, error) {
-	if cgroups.Mode() == cgroups.Unified {
+	mode := HostMode()
+	if mode == cgroups.Unified {
 		groupPath, err := V2PidGroupPath(targetPID)
 		if err != nil {
 			return nil, err
@@ -107,7 +108,7 @@ func GetAttacherForPID(targetPID int) (AttachCGroup, error) {
 
 	// By default it's cgroup v1
 	return &AttachCGroupV1{
-		mode: cgroups.Mode(),
+		mode: mode,
 		path: PidPath(targetPID),
 	}, nil
 }
@@ -0,0 +1,41 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"path/filepath"
+
+	"github.com/containerd/cgroups"
+	"golang.org/x/sys/unix"
+)
+
+// HostMode returns the cgroup mode of the host. It's detected through the cgroup file system of the host, which is
+// mounted at /host-sys/fs/cgroup, because the one of chaos-daemon itself could be different, e.g. when chaos-daemon
+// runs in a cgroup namespace.
+func HostMode() cgroups.CGMode {
+	var st unix.Statfs_t
+	if err := unix.Statfs(HostCgroupRoot, &st); err != nil {
+		// the cgroup file system of the host is not mounted, fallback to the one of chaos-daemon
+		return cgroups.Mode()
+	}
+	if st.Type == unix.CGROUP2_SUPER_MAGIC {
+		return cgroups.Unified
+	}
+	if err := unix.Statfs(filepath.Join(HostCgroupRoot, "unified"), &st); err == nil && st.Type == unix.CGROUP2_SUPER_MAGIC {
+		return cgroups.Hybrid
+	}
+	return cgroups.Legacy
+}
@@ -29,7 +29,7 @@ import (
 )
 
 func V1() ([]cgroups.Subsystem, error) {
-	subsystems, err := defaults("/host-sys/fs/cgroup")
+	subsystems, err := defaults(HostCgroupRoot)
 	if err != nil {
 		return nil, err
 	}
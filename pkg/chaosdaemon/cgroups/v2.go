@@ -0,0 +1,87 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package cgroups
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containerd/cgroups"
+	"github.com/pkg/errors"
+)
+
+// HostCgroupRoot is where the cgroup file system of the host is mounted in chaos-daemon
+const HostCgroupRoot = "/host-sys/fs/cgroup"
+
+const (
+	// DriverSystemd means the cgroups of the containers are managed by systemd, as slices and scopes
+	DriverSystemd = "systemd"
+	// DriverCgroupfs means the cgroups of the containers are managed by the container runtime directly
+	DriverCgroupfs = "cgroupfs"
+)
+
+// Driver returns the cgroup driver, according to the cgroup path of a container. The systemd driver places the
+// containers in scopes under slices, e.g.
+// "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod<uid>.slice/cri-containerd-<id>.scope", while
+// the cgroupfs driver uses plain directories, e.g. "/kubepods/burstable/pod<uid>/<id>".
+func Driver(path string) string {
+	for _, part := range strings.Split(path, "/") {
+		if strings.HasSuffix(part, ".slice") || strings.HasSuffix(part, ".scope") {
+			return DriverSystemd
+		}
+	}
+	return DriverCgroupfs
+}
+
+// ModeName returns the name of the cgroup mode, which is one of "v1", "v2" and "hybrid"
+func ModeName(mode cgroups.CGMode) string {
+	switch mode {
+	case cgroups.Unified:
+		return "v2"
+	case cgroups.Hybrid:
+		return "hybrid"
+	case cgroups.Legacy:
+		return "v1"
+	}
+	return ""
+}
+
+// Path returns the effective cgroup path. For cgroup v1, it's the path of the memory subsystem.
+func (c CGroupInfo) Path() (string, error) {
+	if c.V1Path == nil {
+		return c.V2CGroupPath, nil
+	}
+	return c.V1Path(cgroups.Memory)
+}
+
+// ReadV2File reads the interface file of the cgroup v2 in the host
+func ReadV2File(path string, file string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(HostCgroupRoot, path, file))
+	if err != nil {
+		return "", errors.Wrapf(err, "read %s of cgroup %s", file, path)
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+// WriteV2File writes the interface file of the cgroup v2 in the host
+func WriteV2File(path string, file string, value string) error {
+	err := os.WriteFile(filepath.Join(HostCgroupRoot, path, file), []byte(value), 0)
+	if err != nil {
+		return errors.Wrapf(err, "write %q to %s of cgroup %s", value, file, path)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+var PodFaultCmd = &cobra.Command{
+	Use:   "pod-fault",
+	Short: "inject or recover the faults of PodFaultChaos",
+	Long: `Inject or recover the faults of PodFaultChaos.
+It's expected to be executed inside the namespaces of the target container.`,
+}
+
+var podFaultApplyCmd = &cobra.Command{
+	Use:   "apply [action] [spec]",
+	Short: "inject the fault",
+	Long: `Inject the fault described by the json encoded spec.
+The state which is required to recover the fault will be printed out.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 2 {
+			cmd.Help()
+			os.Exit(1)
+		}
+
+		fault, err := newPodFault(args[0], args[1])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		state, err := fault.Apply()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		fmt.Println(state)
+	},
+}
+
+var podFaultRecoverCmd = &cobra.Command{
+	Use:   "recover [action] [spec] [state]",
+	Short: "recover the fault",
+	Long:  `Recover the fault with the state printed out by the apply command.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) != 3 {
+			cmd.Help()
+			os.Exit(1)
+		}
+
+		fault, err := newPodFault(args[0], args[1])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+
+		if err := fault.Recover(args[2]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	PodFaultCmd.AddCommand(podFaultApplyCmd, podFaultRecoverCmd)
+}
+
+// podFault is a fault which could be injected inside a container. The state returned by Apply is
+// passed back to Recover, so the implementations should not keep anything in memory.
+type podFault interface {
+	Apply() (string, error)
+	Recover(state string) error
+}
+
+func newPodFault(action string, spec string) (podFault, error) {
+	var fault podFault
+	switch v1alpha1.PodFaultChaosAction(action) {
+	case v1alpha1.PodFaultFileCreateAction:
+		fault = &fileCreateFault{}
+	case v1alpha1.PodFaultFileModifyPrivilegeAction:
+		fault = &fileModifyPrivilegeFault{}
+	case v1alpha1.PodFaultFileDeleteAction:
+		fault = &fileDeleteFault{}
+	case v1alpha1.PodFaultFileRenameAction:
+		fault = &fileRenameFault{}
+	case v1alpha1.PodFaultFileAppendAction:
+		fault = &fileAppendFault{}
+	case v1alpha1.PodFaultFileReplaceAction:
+		fault = &fileReplaceFault{}
+	case v1alpha1.PodFaultProcessAction:
+		fault = &processFault{}
+	case v1alpha1.PodFaultRedisExpirationAction:
+		fault = &redisExpirationFault{}
+	case v1alpha1.PodFaultRedisPenetrationAction:
+		fault = &redisPenetrationFault{}
+	case v1alpha1.PodFaultRedisCacheLimitAction:
+		fault = &redisCacheLimitFault{}
+	case v1alpha1.PodFaultKafkaFillAction:
+		fault = &kafkaFillFault{}
+	case v1alpha1.PodFaultKafkaFloodAction:
+		fault = &kafkaFloodFault{}
+	case v1alpha1.PodFaultKafkaIOAction:
+		fault = &kafkaIOFault{}
+	default:
+		return nil, errors.Errorf("unknown action %s", action)
+	}
+
+	if err := json.Unmarshal([]byte(spec), fault); err != nil {
+		return nil, errors.Wrapf(err, "decode the spec of %s", action)
+	}
+
+	return fault, nil
+}
@@ -0,0 +1,231 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const backupSuffix = ".chaos-mesh.bak"
+
+// backupPath returns a hidden path in the same directory, so that the file could be restored through rename
+func backupPath(name string) string {
+	return filepath.Join(filepath.Dir(name), "."+filepath.Base(name)+backupSuffix)
+}
+
+type fileCreateFault struct {
+	v1alpha1.FileCreateSpec
+}
+
+func (f *fileCreateFault) Apply() (string, error) {
+	if len(f.FileName) != 0 {
+		// O_EXCL makes sure that an existing file will not be removed while recovering
+		file, err := os.OpenFile(f.FileName, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err != nil {
+			return "", errors.Wrapf(err, "create file %s", f.FileName)
+		}
+		return "", file.Close()
+	}
+
+	if err := os.Mkdir(f.DirName, 0755); err != nil {
+		return "", errors.Wrapf(err, "create directory %s", f.DirName)
+	}
+	return "", nil
+}
+
+func (f *fileCreateFault) Recover(string) error {
+	var err error
+	if len(f.FileName) != 0 {
+		err = os.Remove(f.FileName)
+	} else {
+		err = os.RemoveAll(f.DirName)
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.Wrap(err, "remove the created file")
+	}
+	return nil
+}
+
+type fileModifyPrivilegeFault struct {
+	v1alpha1.FileModifyPrivilegeSpec
+}
+
+func (f *fileModifyPrivilegeFault) Apply() (string, error) {
+	// the privilege is written in the same way as the argument of chmod, e.g. 777
+	privilege, err := strconv.ParseUint(strconv.FormatUint(uint64(f.Privilege), 10), 8, 32)
+	if err != nil {
+		return "", errors.Wrapf(err, "parse privilege %d", f.Privilege)
+	}
+
+	stat, err := os.Stat(f.FileName)
+	if err != nil {
+		return "", errors.Wrapf(err, "get stat of %s", f.FileName)
+	}
+
+	if err := os.Chmod(f.FileName, os.FileMode(privilege)); err != nil {
+		return "", errors.Wrapf(err, "chmod %s", f.FileName)
+	}
+	return strconv.FormatUint(uint64(stat.Mode().Perm()), 8), nil
+}
+
+func (f *fileModifyPrivilegeFault) Recover(state string) error {
+	mode, err := strconv.ParseUint(state, 8, 32)
+	if err != nil {
+		return errors.Wrapf(err, "parse original mode %s", state)
+	}
+
+	return errors.Wrapf(os.Chmod(f.FileName, os.FileMode(mode)), "chmod %s", f.FileName)
+}
+
+type fileDeleteFault struct {
+	v1alpha1.FileDeleteSpec
+}
+
+func (f *fileDeleteFault) target() string {
+	if len(f.FileName) != 0 {
+		return f.FileName
+	}
+	return f.DirName
+}
+
+// Apply moves the target to a backup path instead of removing it, so that it could be recovered
+func (f *fileDeleteFault) Apply() (string, error) {
+	target := f.target()
+	backup := backupPath(target)
+	if err := os.Rename(target, backup); err != nil {
+		return "", errors.Wrapf(err, "delete %s", target)
+	}
+	return backup, nil
+}
+
+func (f *fileDeleteFault) Recover(state string) error {
+	if _, err := os.Stat(state); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return errors.Wrapf(os.Rename(state, f.target()), "restore %s", f.target())
+}
+
+type fileRenameFault struct {
+	v1alpha1.FileRenameSpec
+}
+
+func (f *fileRenameFault) Apply() (string, error) {
+	// overwriting the destination file could not be recovered
+	if _, err := os.Stat(f.DestFile); err == nil {
+		return "", errors.Errorf("destination file %s already exists", f.DestFile)
+	}
+
+	if err := os.Rename(f.SourceFile, f.DestFile); err != nil {
+		return "", errors.Wrapf(err, "rename %s to %s", f.SourceFile, f.DestFile)
+	}
+	return "", nil
+}
+
+func (f *fileRenameFault) Recover(string) error {
+	if _, err := os.Stat(f.DestFile); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return errors.Wrapf(os.Rename(f.DestFile, f.SourceFile), "rename %s to %s", f.DestFile, f.SourceFile)
+}
+
+type fileAppendFault struct {
+	v1alpha1.FileAppendSpec
+}
+
+// Apply appends the data as lines, and returns the original size of the file
+func (f *fileAppendFault) Apply() (string, error) {
+	stat, err := os.Stat(f.FileName)
+	if err != nil {
+		return "", errors.Wrapf(err, "get stat of %s", f.FileName)
+	}
+
+	count := f.Count
+	if count <= 0 {
+		count = 1
+	}
+
+	file, err := os.OpenFile(f.FileName, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		return "", errors.Wrapf(err, "open file %s", f.FileName)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(strings.Repeat(f.Data+"\n", count)); err != nil {
+		return "", errors.Wrapf(err, "append to %s", f.FileName)
+	}
+	return strconv.FormatInt(stat.Size(), 10), nil
+}
+
+func (f *fileAppendFault) Recover(state string) error {
+	size, err := strconv.ParseInt(state, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "parse original size %s", state)
+	}
+
+	return errors.Wrapf(os.Truncate(f.FileName, size), "truncate %s", f.FileName)
+}
+
+type fileReplaceFault struct {
+	v1alpha1.FileReplaceSpec
+}
+
+// Apply keeps a backup of the original file, and replaces the string in the specified line, or in all
+// lines if the line is not specified
+func (f *fileReplaceFault) Apply() (string, error) {
+	stat, err := os.Stat(f.FileName)
+	if err != nil {
+		return "", errors.Wrapf(err, "get stat of %s", f.FileName)
+	}
+
+	content, err := os.ReadFile(f.FileName)
+	if err != nil {
+		return "", errors.Wrapf(err, "read file %s", f.FileName)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	if f.Line > len(lines) {
+		return "", errors.Errorf("file %s has only %d lines", f.FileName, len(lines))
+	}
+	for i := range lines {
+		if f.Line <= 0 || i == f.Line-1 {
+			lines[i] = strings.ReplaceAll(lines[i], f.OriginStr, f.DestStr)
+		}
+	}
+
+	backup := backupPath(f.FileName)
+	if err := os.WriteFile(backup, content, stat.Mode().Perm()); err != nil {
+		return "", errors.Wrapf(err, "backup file %s", f.FileName)
+	}
+
+	if err := os.WriteFile(f.FileName, []byte(strings.Join(lines, "\n")), stat.Mode().Perm()); err != nil {
+		return "", errors.Wrapf(err, "write file %s", f.FileName)
+	}
+	return backup, nil
+}
+
+func (f *fileReplaceFault) Recover(state string) error {
+	if _, err := os.Stat(state); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return errors.Wrapf(os.Rename(state, f.FileName), "restore %s", f.FileName)
+}
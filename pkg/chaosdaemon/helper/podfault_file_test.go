@@ -0,0 +1,118 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func TestFileFaults(t *testing.T) {
+	g := NewWithT(t)
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "app.conf")
+	const content = "port=80\nhost=localhost\nport=81"
+
+	type TestCase struct {
+		name   string
+		action v1alpha1.PodFaultChaosAction
+		spec   interface{}
+		check  func()
+	}
+
+	tcs := []TestCase{
+		{
+			name:   "create",
+			action: v1alpha1.PodFaultFileCreateAction,
+			spec:   v1alpha1.FileCreateSpec{FileName: filepath.Join(dir, "new")},
+			check: func() {
+				g.Expect(filepath.Join(dir, "new")).To(BeAnExistingFile())
+			},
+		},
+		{
+			name:   "modify privilege",
+			action: v1alpha1.PodFaultFileModifyPrivilegeAction,
+			spec:   v1alpha1.FileModifyPrivilegeSpec{FileName: file, Privilege: 600},
+			check: func() {
+				stat, err := os.Stat(file)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(stat.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			},
+		},
+		{
+			name:   "delete",
+			action: v1alpha1.PodFaultFileDeleteAction,
+			spec:   v1alpha1.FileDeleteSpec{FileName: file},
+			check: func() {
+				g.Expect(file).NotTo(BeAnExistingFile())
+			},
+		},
+		{
+			name:   "rename",
+			action: v1alpha1.PodFaultFileRenameAction,
+			spec:   v1alpha1.FileRenameSpec{SourceFile: file, DestFile: file + ".renamed"},
+			check: func() {
+				g.Expect(file).NotTo(BeAnExistingFile())
+				g.Expect(file + ".renamed").To(BeAnExistingFile())
+			},
+		},
+		{
+			name:   "append",
+			action: v1alpha1.PodFaultFileAppendAction,
+			spec:   v1alpha1.FileAppendSpec{FileName: file, Data: "chaos", Count: 2},
+			check: func() {
+				g.Expect(os.ReadFile(file)).To(Equal([]byte(content + "chaos\nchaos\n")))
+			},
+		},
+		{
+			name:   "replace",
+			action: v1alpha1.PodFaultFileReplaceAction,
+			spec:   v1alpha1.FileReplaceSpec{FileName: file, OriginStr: "port", DestStr: "ports", Line: 3},
+			check: func() {
+				g.Expect(os.ReadFile(file)).To(Equal([]byte("port=80\nhost=localhost\nports=81")))
+			},
+		},
+	}
+
+	for _, tc := range tcs {
+		g.Expect(os.WriteFile(file, []byte(content), 0644)).To(Succeed(), tc.name)
+
+		spec, err := json.Marshal(tc.spec)
+		g.Expect(err).NotTo(HaveOccurred(), tc.name)
+		fault, err := newPodFault(string(tc.action), string(spec))
+		g.Expect(err).NotTo(HaveOccurred(), tc.name)
+
+		state, err := fault.Apply()
+		g.Expect(err).NotTo(HaveOccurred(), tc.name)
+		tc.check()
+
+		g.Expect(fault.Recover(state)).To(Succeed(), tc.name)
+		g.Expect(os.ReadFile(file)).To(Equal([]byte(content)), tc.name)
+		stat, err := os.Stat(file)
+		g.Expect(err).NotTo(HaveOccurred(), tc.name)
+		g.Expect(stat.Mode().Perm()).To(Equal(os.FileMode(0644)), tc.name)
+		entries, err := os.ReadDir(dir)
+		g.Expect(err).NotTo(HaveOccurred(), tc.name)
+		g.Expect(entries).To(HaveLen(1), tc.name)
+	}
+}
@@ -0,0 +1,351 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// kafkaProducerTools are the names of the producer performance tool shipped with kafka, which is used to
+// produce the messages inside the container
+var kafkaProducerTools = []string{"kafka-producer-perf-test.sh", "kafka-producer-perf-test"}
+
+// kafkaBinDirs are the directories of the kafka scripts in the common images, which are usually not in PATH
+var kafkaBinDirs = []string{"/opt/kafka/bin", "/opt/bitnami/kafka/bin", "/usr/bin"}
+
+type kafkaFillFault struct {
+	v1alpha1.KafkaFillSpec
+}
+
+// Apply starts the producer in a new session without waiting for it, as filling the topic may take minutes, and
+// returns its pid. The producer exits once the max bytes are written.
+func (f *kafkaFillFault) Apply() (string, error) {
+	records := f.MaxBytes / uint64(f.MessageSize)
+	if records == 0 {
+		records = 1
+	}
+
+	cmd, err := kafkaProducerCommand(f.KafkaCommonSpec, f.MessageSize, records)
+	if err != nil {
+		return "", err
+	}
+	pids, err := startKafkaProducers([]*exec.Cmd{cmd}, f.Topic)
+	if err != nil {
+		return "", err
+	}
+	// the producer has filled the topic during the startup
+	if len(pids) == 0 {
+		return "", nil
+	}
+	return strconv.Itoa(pids[0]), nil
+}
+
+// Recover kills the producer if it is still filling the topic, then executes the reload command. The filled
+// messages are kept until they are removed by the retention of kafka.
+func (f *kafkaFillFault) Recover(state string) error {
+	// the state is empty if the producer has exited during the startup, or the fault is applied by an older
+	// chaos daemon, which waits for the producer
+	if len(state) > 0 {
+		pid, err := strconv.Atoi(state)
+		if err != nil {
+			return errors.Wrapf(err, "decode the pid of producer %s", state)
+		}
+		if err := killProcessGroups([]int{pid}); err != nil {
+			return err
+		}
+	}
+
+	if len(f.ReloadCommand) == 0 {
+		return nil
+	}
+
+	if output, err := exec.Command("sh", "-c", f.ReloadCommand).CombinedOutput(); err != nil {
+		return errors.Wrapf(err, "execute reload command %s: %s", f.ReloadCommand, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+type kafkaFloodFault struct {
+	v1alpha1.KafkaFloodSpec
+}
+
+// Apply starts the producers in new sessions without waiting for them, and returns their pids
+func (f *kafkaFloodFault) Apply() (string, error) {
+	var cmds []*exec.Cmd
+	for i := uint(0); i < f.Threads; i++ {
+		cmd, err := kafkaProducerCommand(f.KafkaCommonSpec, f.MessageSize, math.MaxInt64)
+		if err != nil {
+			return "", err
+		}
+		cmds = append(cmds, cmd)
+	}
+	pids, err := startKafkaProducers(cmds, f.Topic)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := json.Marshal(pids)
+	if err != nil {
+		killProcessGroups(pids)
+		return "", errors.Wrap(err, "encode the pids of producers")
+	}
+	return string(state), nil
+}
+
+// Recover kills the producers and their children
+func (f *kafkaFloodFault) Recover(state string) error {
+	var pids []int
+	if err := json.Unmarshal([]byte(state), &pids); err != nil {
+		return errors.Wrapf(err, "decode the pids of producers %s", state)
+	}
+	return killProcessGroups(pids)
+}
+
+// kafkaProducerStartupTimeout is how long the producers are watched after they are started, so that a producer
+// failing immediately, e.g. the topic doesn't exist, is reported rather than leaving the fault ineffective
+var kafkaProducerStartupTimeout = 2 * time.Second
+
+// startKafkaProducers starts the producers in new sessions, and returns the pids of the producers which are still
+// running after the startup timeout. The producers are killed if any of them exits with error during the startup,
+// and the error is returned with their output.
+func startKafkaProducers(cmds []*exec.Cmd, topic string) ([]int, error) {
+	// the producers may outlive the helper, so the output is written to a file rather than a pipe, which is
+	// removed once the startup is watched
+	output, err := os.CreateTemp("", "chaos-kafka-producer-*.log")
+	if err != nil {
+		return nil, errors.Wrap(err, "create the output file of producers")
+	}
+	defer os.Remove(output.Name())
+	defer output.Close()
+
+	var pids []int
+	for _, cmd := range cmds {
+		cmd.Stdout = output
+		cmd.Stderr = output
+		cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+		if err := cmd.Start(); err != nil {
+			killProcessGroups(pids)
+			return nil, errors.Wrapf(err, "start producer of topic %s", topic)
+		}
+		pids = append(pids, cmd.Process.Pid)
+		cmd.Process.Release()
+	}
+
+	deadline := time.Now().Add(kafkaProducerStartupTimeout)
+	for len(pids) > 0 && time.Now().Before(deadline) {
+		time.Sleep(50 * time.Millisecond)
+
+		var running []int
+		for _, pid := range pids {
+			var status syscall.WaitStatus
+			wpid, err := syscall.Wait4(pid, &status, syscall.WNOHANG, nil)
+			if err != nil {
+				killProcessGroups(pids)
+				return nil, errors.Wrapf(err, "wait for producer %d", pid)
+			}
+			if wpid == 0 {
+				running = append(running, pid)
+				continue
+			}
+			if !status.Exited() || status.ExitStatus() != 0 {
+				killProcessGroups(pids)
+				reason := fmt.Sprintf("exit code %d", status.ExitStatus())
+				if status.Signaled() {
+					reason = "signal " + status.Signal().String()
+				}
+				message, _ := os.ReadFile(output.Name())
+				return nil, errors.Errorf("producer of topic %s exited with %s: %s", topic, reason, strings.TrimSpace(string(message)))
+			}
+		}
+		pids = running
+	}
+
+	return pids, nil
+}
+
+// killProcessGroups kills the process groups led by the pids, the groups which have exited are ignored
+func killProcessGroups(pids []int) error {
+	for _, pid := range pids {
+		if err := syscall.Kill(-pid, syscall.SIGKILL); err != nil && !errors.Is(err, syscall.ESRCH) {
+			return errors.Wrapf(err, "kill process group %d", pid)
+		}
+	}
+	return nil
+}
+
+// kafkaProducerCommand returns the command which produces the records with the producer performance tool
+// as fast as possible
+func kafkaProducerCommand(spec v1alpha1.KafkaCommonSpec, messageSize uint, records uint64) (*exec.Cmd, error) {
+	tool, err := lookupKafkaTool(kafkaProducerTools)
+	if err != nil {
+		return nil, err
+	}
+
+	args := []string{
+		"--topic", spec.Topic,
+		"--num-records", strconv.FormatUint(records, 10),
+		"--record-size", strconv.FormatUint(uint64(messageSize), 10),
+		"--throughput", "-1",
+		"--producer-props", "bootstrap.servers=" + net.JoinHostPort(spec.Host, strconv.Itoa(int(spec.Port))),
+	}
+	if len(spec.Username) > 0 {
+		args = append(args,
+			"security.protocol=SASL_PLAINTEXT",
+			"sasl.mechanism=PLAIN",
+			fmt.Sprintf(`sasl.jaas.config=org.apache.kafka.common.security.plain.PlainLoginModule required username=%q password=%q;`,
+				spec.Username, spec.Password),
+		)
+	}
+	return exec.Command(tool, args...), nil
+}
+
+// lookupKafkaTool finds the tool in PATH, or in the directories of kafka scripts
+func lookupKafkaTool(names []string) (string, error) {
+	for _, name := range names {
+		if path, err := exec.LookPath(name); err == nil {
+			return path, nil
+		}
+	}
+	for _, dir := range kafkaBinDirs {
+		for _, name := range names {
+			path := filepath.Join(dir, name)
+			if info, err := os.Stat(path); err == nil && info.Mode().IsRegular() {
+				return path, nil
+			}
+		}
+	}
+	return "", errors.Errorf("%s not found in the container", names[0])
+}
+
+type kafkaIOFault struct {
+	v1alpha1.KafkaIOSpec
+}
+
+// Apply removes the read or write permission of the partitions of the topic, and returns the original
+// modes of them
+func (f *kafkaIOFault) Apply() (string, error) {
+	logDirs, err := kafkaLogDirs(f.ConfigFile)
+	if err != nil {
+		return "", err
+	}
+
+	var mask os.FileMode
+	if f.NonReadable {
+		mask |= 0444
+	}
+	if f.NonWritable {
+		mask |= 0222
+	}
+
+	modes := map[string]os.FileMode{}
+	for _, logDir := range logDirs {
+		partitions, err := filepath.Glob(filepath.Join(logDir, f.Topic+"-*"))
+		if err != nil {
+			return "", errors.Wrapf(err, "list partitions in %s", logDir)
+		}
+
+		for _, partition := range partitions {
+			err := filepath.Walk(partition, func(path string, info os.FileInfo, err error) error {
+				if err != nil {
+					return err
+				}
+				modes[path] = info.Mode().Perm()
+				return nil
+			})
+			if err != nil {
+				return "", errors.Wrapf(err, "walk partition %s", partition)
+			}
+		}
+	}
+	if len(modes) == 0 {
+		return "", errors.Errorf("partitions of topic %s not found", f.Topic)
+	}
+
+	// the directories are walked before chmod, as they may become unreadable
+	for path, mode := range modes {
+		if err := os.Chmod(path, mode&^mask); err != nil {
+			return "", errors.Wrapf(err, "chmod %s", path)
+		}
+	}
+
+	state, err := json.Marshal(modes)
+	if err != nil {
+		return "", errors.Wrap(err, "encode the original modes")
+	}
+	return string(state), nil
+}
+
+func (f *kafkaIOFault) Recover(state string) error {
+	modes := map[string]os.FileMode{}
+	if err := json.Unmarshal([]byte(state), &modes); err != nil {
+		return errors.Wrapf(err, "decode the original modes %s", state)
+	}
+
+	for path, mode := range modes {
+		if err := os.Chmod(path, mode); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return errors.Wrapf(err, "chmod %s", path)
+		}
+	}
+	return nil
+}
+
+// kafkaLogDirs reads the log.dirs, or log.dir if the former is absent, from the server config
+func kafkaLogDirs(configFile string) ([]string, error) {
+	file, err := os.Open(configFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "open kafka config %s", configFile)
+	}
+	defer file.Close()
+
+	properties := map[string]string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if key, value, ok := strings.Cut(line, "="); ok {
+			properties[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrapf(err, "read kafka config %s", configFile)
+	}
+
+	dirs, ok := properties["log.dirs"]
+	if !ok {
+		dirs, ok = properties["log.dir"]
+	}
+	if !ok || len(dirs) == 0 {
+		return nil, errors.Errorf("log.dirs not found in %s", configFile)
+	}
+	return strings.Split(dirs, ","), nil
+}
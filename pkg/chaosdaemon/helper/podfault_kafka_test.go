@@ -0,0 +1,142 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// fakeKafkaProducer installs a producer tool which records its arguments and then runs the body
+func fakeKafkaProducer(t *testing.T, body string) string {
+	dir := t.TempDir()
+	args := filepath.Join(dir, "args")
+	script := "#!/bin/sh\necho \"$@\" >> " + args + "\n" + body + "\n"
+	if err := os.WriteFile(filepath.Join(dir, kafkaProducerTools[0]), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return args
+}
+
+func TestKafkaFillFault(t *testing.T) {
+	g := NewWithT(t)
+	args := fakeKafkaProducer(t, "exec sleep 60")
+	reloaded := filepath.Join(t.TempDir(), "reloaded")
+
+	spec, err := json.Marshal(v1alpha1.KafkaFillSpec{
+		KafkaCommonSpec: v1alpha1.KafkaCommonSpec{Topic: "test", Host: "localhost", Port: 9092, Username: "user", Password: "secret"},
+		MessageSize:     1024,
+		MaxBytes:        4096,
+		ReloadCommand:   "touch " + reloaded,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	fault, err := newPodFault(string(v1alpha1.PodFaultKafkaFillAction), string(spec))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the apply returns while the producer is still filling the topic
+	state, err := fault.Apply()
+	g.Expect(err).NotTo(HaveOccurred())
+	pid, err := strconv.Atoi(state)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Eventually(func() string {
+		recorded, _ := os.ReadFile(args)
+		return string(recorded)
+	}, time.Second*5, time.Millisecond*50).Should(HavePrefix("--topic test --num-records 4 --record-size 1024 --throughput -1 --producer-props bootstrap.servers=localhost:9092 "))
+	recorded, err := os.ReadFile(args)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(recorded)).To(ContainSubstring(`username="user" password="secret"`))
+
+	g.Expect(fault.Recover(state)).To(Succeed())
+	// the producer is not reaped by the helper, so wait for it to exit
+	var status syscall.WaitStatus
+	_, err = syscall.Wait4(pid, &status, 0, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(status.Signal()).To(Equal(syscall.SIGKILL))
+	g.Expect(reloaded).To(BeAnExistingFile())
+
+	// the producer which has exited is ignored
+	g.Expect(fault.Recover(state)).To(Succeed())
+}
+
+func TestKafkaFillFaultFailed(t *testing.T) {
+	g := NewWithT(t)
+	t.Setenv("PATH", t.TempDir())
+
+	fault := &kafkaFillFault{v1alpha1.KafkaFillSpec{
+		KafkaCommonSpec: v1alpha1.KafkaCommonSpec{Topic: "test", Host: "localhost", Port: 9092},
+		MessageSize:     1024,
+		MaxBytes:        4096,
+	}}
+	_, err := fault.Apply()
+	g.Expect(err).To(MatchError(ContainSubstring("not found in the container")))
+
+	// the producer failing immediately is reported with its output
+	fakeKafkaProducer(t, "echo 'Topic test not present in metadata' >&2\nexit 1")
+	_, err = fault.Apply()
+	g.Expect(err).To(MatchError(ContainSubstring("exited with exit code 1: Topic test not present in metadata")))
+
+	// the producer which has filled the topic during the startup leaves nothing to kill
+	fakeKafkaProducer(t, "exit 0")
+	g.Expect(fault.Apply()).To(BeEmpty())
+
+	g.Expect(fault.Recover("producer")).To(MatchError(ContainSubstring("decode the pid of producer")))
+	// the fault applied by an older chaos daemon has no producer to kill
+	g.Expect(fault.Recover("")).To(Succeed())
+}
+
+func TestKafkaFloodFault(t *testing.T) {
+	g := NewWithT(t)
+	args := fakeKafkaProducer(t, "exec sleep 60")
+
+	fault := &kafkaFloodFault{v1alpha1.KafkaFloodSpec{
+		KafkaCommonSpec: v1alpha1.KafkaCommonSpec{Topic: "test", Host: "localhost", Port: 9092},
+		MessageSize:     1024,
+		Threads:         2,
+	}}
+	state, err := fault.Apply()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var pids []int
+	g.Expect(json.Unmarshal([]byte(state), &pids)).To(Succeed())
+	g.Expect(pids).To(HaveLen(2))
+	g.Eventually(func() int {
+		recorded, _ := os.ReadFile(args)
+		return strings.Count(string(recorded), "--topic test")
+	}, time.Second*5, time.Millisecond*50).Should(Equal(2))
+
+	g.Expect(fault.Recover(state)).To(Succeed())
+	for _, pid := range pids {
+		// the producers are not reaped by the helper, so wait for them to exit
+		var status syscall.WaitStatus
+		_, err := syscall.Wait4(pid, &status, 0, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(status.Signal()).To(Equal(syscall.SIGKILL))
+	}
+
+	// the producers which have exited are ignored
+	g.Expect(fault.Recover(state)).To(Succeed())
+}
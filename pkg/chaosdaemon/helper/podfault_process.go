@@ -0,0 +1,97 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+type processFault struct {
+	v1alpha1.ProcessSpec
+}
+
+// Apply sends the signal to the process with the given pid, or to all the processes with the given name
+func (f *processFault) Apply() (string, error) {
+	pids, err := f.findProcesses()
+	if err != nil {
+		return "", err
+	}
+	if len(pids) == 0 {
+		return "", errors.Errorf("process %s not found", f.Process)
+	}
+
+	for _, pid := range pids {
+		if err := syscall.Kill(pid, syscall.Signal(f.Signal)); err != nil {
+			return "", errors.Wrapf(err, "send signal %d to %d", f.Signal, pid)
+		}
+	}
+	return "", nil
+}
+
+func (f *processFault) findProcesses() ([]int, error) {
+	if pid, err := strconv.Atoi(f.Process); err == nil {
+		return []int{pid}, nil
+	}
+
+	comms, err := filepath.Glob("/proc/[0-9]*/comm")
+	if err != nil {
+		return nil, errors.Wrap(err, "list processes")
+	}
+
+	self := os.Getpid()
+	var pids []int
+	for _, comm := range comms {
+		name, err := os.ReadFile(comm)
+		if err != nil {
+			// the process may have exited
+			continue
+		}
+		if strings.TrimSpace(string(name)) != f.Process {
+			continue
+		}
+
+		pid, err := strconv.Atoi(filepath.Base(filepath.Dir(comm)))
+		if err != nil || pid == self {
+			continue
+		}
+		pids = append(pids, pid)
+	}
+	return pids, nil
+}
+
+// Recover starts the recover command in a new session, and doesn't wait for it, as it usually starts a
+// long-running process
+func (f *processFault) Recover(string) error {
+	if len(f.RecoverCmd) == 0 {
+		return nil
+	}
+
+	cmd := exec.Command("sh", "-c", f.RecoverCmd)
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+	if err := cmd.Start(); err != nil {
+		return errors.Wrapf(err, "start recover command %s", f.RecoverCmd)
+	}
+	return cmd.Process.Release()
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package helper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const redisDialTimeout = 5 * time.Second
+
+func dialRedis(spec v1alpha1.RedisCommonSpec) (redis.Conn, error) {
+	conn, err := redis.Dial("tcp", spec.Addr,
+		redis.DialPassword(spec.Password),
+		redis.DialConnectTimeout(redisDialTimeout))
+	if err != nil {
+		return nil, errors.Wrapf(err, "connect to redis server %s", spec.Addr)
+	}
+	return conn, nil
+}
+
+type redisExpirationFault struct {
+	v1alpha1.RedisExpirationSpec
+}
+
+// Apply sets the expiration of the given key, or of all keys if the key is not specified. It could not
+// be recovered.
+func (f *redisExpirationFault) Apply() (string, error) {
+	expiration, err := parseRedisExpiration(f.Expiration)
+	if err != nil {
+		return "", err
+	}
+
+	conn, err := dialRedis(f.RedisCommonSpec)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	keys := []string{f.Key}
+	if len(f.Key) == 0 {
+		keys, err = redis.Strings(conn.Do("KEYS", "*"))
+		if err != nil {
+			return "", errors.Wrap(err, "list keys")
+		}
+	}
+
+	for _, key := range keys {
+		args := []interface{}{key, expiration}
+		if len(f.Option) != 0 {
+			args = append(args, f.Option)
+		}
+		if _, err := conn.Do("EXPIRE", args...); err != nil {
+			return "", errors.Wrapf(err, "expire key %s", key)
+		}
+	}
+	return "", nil
+}
+
+func (f *redisExpirationFault) Recover(string) error {
+	return nil
+}
+
+// parseRedisExpiration accepts both a duration and the seconds, and returns the seconds
+func parseRedisExpiration(expiration string) (int64, error) {
+	if len(expiration) == 0 {
+		return 0, nil
+	}
+	if seconds, err := strconv.ParseInt(expiration, 10, 64); err == nil {
+		return seconds, nil
+	}
+
+	duration, err := time.ParseDuration(expiration)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse expiration %s", expiration)
+	}
+	return int64(duration.Seconds()), nil
+}
+
+type redisPenetrationFault struct {
+	v1alpha1.RedisPenetrationSpec
+}
+
+// Apply sends requests of the keys which don't exist, to penetrate the cache. It could not be recovered.
+func (f *redisPenetrationFault) Apply() (string, error) {
+	conn, err := dialRedis(f.RedisCommonSpec)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	prefix := fmt.Sprintf("chaos-mesh-penetration-%d-", time.Now().UnixNano())
+	for i := 0; i < f.RequestNum; i++ {
+		if err := conn.Send("GET", prefix+strconv.Itoa(i)); err != nil {
+			return "", errors.Wrap(err, "send request")
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return "", errors.Wrap(err, "send requests")
+	}
+	for i := 0; i < f.RequestNum; i++ {
+		if _, err := conn.Receive(); err != nil {
+			return "", errors.Wrap(err, "receive response")
+		}
+	}
+	return "", nil
+}
+
+func (f *redisPenetrationFault) Recover(string) error {
+	return nil
+}
+
+type redisCacheLimitFault struct {
+	v1alpha1.RedisCacheLimitSpec
+}
+
+// Apply sets the maxmemory, and returns the original one
+func (f *redisCacheLimitFault) Apply() (string, error) {
+	conn, err := dialRedis(f.RedisCommonSpec)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	config, err := redis.Strings(conn.Do("CONFIG", "GET", "maxmemory"))
+	if err != nil {
+		return "", errors.Wrap(err, "get maxmemory")
+	}
+	if len(config) != 2 {
+		return "", errors.Errorf("unexpected reply of maxmemory %v", config)
+	}
+	original := config[1]
+
+	size := f.Size
+	if len(f.Percent) != 0 {
+		percent, err := strconv.ParseFloat(strings.TrimSuffix(f.Percent, "%"), 64)
+		if err != nil {
+			return "", errors.Wrapf(err, "parse percent %s", f.Percent)
+		}
+		maxmemory, err := strconv.ParseInt(original, 10, 64)
+		if err != nil || maxmemory == 0 {
+			return "", errors.Errorf("percent could not be applied to maxmemory %s", original)
+		}
+		size = strconv.FormatInt(int64(float64(maxmemory)*percent/100), 10)
+	}
+	if len(size) == 0 || size == "0" {
+		return "", errors.New("one of cacheSize and percent is required")
+	}
+
+	if _, err := conn.Do("CONFIG", "SET", "maxmemory", size); err != nil {
+		return "", errors.Wrapf(err, "set maxmemory to %s", size)
+	}
+	return original, nil
+}
+
+func (f *redisCacheLimitFault) Recover(state string) error {
+	conn, err := dialRedis(f.RedisCommonSpec)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = conn.Do("CONFIG", "SET", "maxmemory", state)
+	return errors.Wrapf(err, "set maxmemory to %s", state)
+}
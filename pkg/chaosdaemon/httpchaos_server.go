@@ -24,9 +24,14 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
 	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
@@ -36,6 +41,13 @@ import (
 const (
 	tproxyBin = "/usr/local/bin/tproxy"
 	pathEnv   = "PATH"
+
+	// tproxyEgressPort is the port tproxy listens on for the outbound traffic redirected by iptables
+	tproxyEgressPort uint32 = 15006
+	// tproxyEgressMark marks the connections created by tproxy, which should not be redirected again
+	tproxyEgressMark uint32 = 0x6368
+	// httpEgressChain is the chain in nat table to redirect the outbound traffic to tproxy
+	httpEgressChain = "CHAOS-HTTP-EGRESS"
 )
 
 type stdioTransport struct {
@@ -97,6 +109,10 @@ func (s *DaemonServer) ApplyHttpChaos(ctx context.Context, in *pb.ApplyHttpChaos
 
 	resp, err := s.applyHttpChaos(ctx, in)
 	if err != nil {
+		// the outbound traffic could not be redirected to a killed tproxy
+		if redirectError := s.setHttpEgressRedirect(ctx, in, nil); redirectError != nil {
+			log.Error(redirectError, "remove iptables rules of outbound traffic")
+		}
 		if killError := s.backgroundProcessManager.KillBackgroundProcess(ctx, in.InstanceUid); killError != nil {
 			log.Error(killError, "kill tproxy", "uid", in.InstanceUid)
 		}
@@ -132,6 +148,33 @@ func (s *DaemonServer) applyHttpChaos(ctx context.Context, in *pb.ApplyHttpChaos
 		Rules:      rules,
 	}
 
+	features, err := tproxyFeatures(transport)
+	if err != nil {
+		return nil, errors.Wrap(err, "get capabilities of tproxy")
+	}
+
+	if err := checkRuleLimits(rules, features); err != nil {
+		return nil, err
+	}
+
+	ports := egressPorts(rules)
+	if len(ports) > 0 {
+		// an older tproxy ignores the egress config, and the redirected traffic would reach a closed port
+		if !features[tproxyconfig.FeatureEgress] {
+			return nil, errors.New("tproxy does not support the outbound traffic")
+		}
+		httpChaosSpec.Egress = &tproxyconfig.EgressConfig{
+			ListenPort: tproxyEgressPort,
+			Ports:      ports,
+			Mark:       tproxyEgressMark,
+		}
+	} else {
+		// stop redirecting the outbound traffic before tproxy stops listening on it
+		if err := s.setHttpEgressRedirect(ctx, in, nil); err != nil {
+			return nil, errors.Wrap(err, "remove iptables rules of outbound traffic")
+		}
+	}
+
 	if len(in.Tls) != 0 {
 		httpChaosSpec.TLS = new(tproxyconfig.TLSConfig)
 		err = json.Unmarshal([]byte(in.Tls), httpChaosSpec.TLS)
@@ -159,6 +202,12 @@ func (s *DaemonServer) applyHttpChaos(ctx context.Context, in *pb.ApplyHttpChaos
 
 	log.Info("http chaos applied")
 
+	if len(ports) > 0 && resp.StatusCode == http.StatusOK {
+		if err := s.setHttpEgressRedirect(ctx, in, ports); err != nil {
+			return nil, errors.Wrap(err, "redirect outbound traffic")
+		}
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, errors.Wrap(err, "read response body")
@@ -173,6 +222,68 @@ func (s *DaemonServer) applyHttpChaos(ctx context.Context, in *pb.ApplyHttpChaos
 	}, nil
 }
 
+func (s *DaemonServer) GetHttpChaosCounters(ctx context.Context, in *pb.GetHttpChaosCountersRequest) (*pb.GetHttpChaosCountersResponse, error) {
+	uid := in.InstanceUid
+	if uid == "" {
+		var ok bool
+		uid, ok = s.backgroundProcessManager.GetUID(bpm.ProcessPair{Pid: int(in.Instance), CreateTime: in.StartTime})
+		if !ok {
+			return nil, errors.Errorf("fail to get uid of process(%d)", in.Instance)
+		}
+	}
+
+	pipes, ok := s.backgroundProcessManager.GetPipes(uid)
+	if !ok {
+		return nil, errors.Errorf("fail to get process(%s)", uid)
+	}
+
+	transport := &stdioTransport{
+		uid:    uid,
+		locker: s.tproxyLocker,
+		pipes:  pipes,
+	}
+
+	features, err := tproxyFeatures(transport)
+	if err != nil {
+		return nil, errors.Wrap(err, "get capabilities of tproxy")
+	}
+	if !features[tproxyconfig.FeatureCounters] {
+		return nil, status.Error(codes.Unimplemented, "tproxy does not report the counters of rules")
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/counters", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "create http request")
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("get counters, status(%d): %s", resp.StatusCode, body)
+	}
+
+	var counters []tproxyconfig.RuleCounter
+	if err := json.Unmarshal(body, &counters); err != nil {
+		return nil, errors.Wrap(err, "unmarshal counters")
+	}
+
+	res := &pb.GetHttpChaosCountersResponse{}
+	for _, counter := range counters {
+		res.Counters = append(res.Counters, &pb.HttpChaosRuleCounter{
+			Matched:  counter.Matched,
+			Injected: counter.Injected,
+		})
+	}
+	return res, nil
+}
+
 func (s *DaemonServer) createHttpChaos(ctx context.Context, in *pb.ApplyHttpChaosRequest) error {
 	pid, err := s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
 	if err != nil {
@@ -200,3 +311,115 @@ func (s *DaemonServer) createHttpChaos(ctx context.Context, in *pb.ApplyHttpChao
 	in.InstanceUid = proc.Uid
 	return nil
 }
+
+// egressPorts returns the destination ports of the rules on the outbound traffic
+func egressPorts(rules []tproxyconfig.PodHttpChaosBaseRule) []uint32 {
+	var ports []uint32
+	found := make(map[uint32]bool)
+	for _, rule := range rules {
+		if rule.Direction != tproxyconfig.Outbound || rule.Selector.Port == nil {
+			continue
+		}
+		port := uint32(*rule.Selector.Port)
+		if !found[port] {
+			found[port] = true
+			ports = append(ports, port)
+		}
+	}
+	sort.Slice(ports, func(i, j int) bool { return ports[i] < ports[j] })
+	return ports
+}
+
+// setHttpEgressRedirect redirects the outbound traffic to the ports to tproxy, except the connections
+// created by tproxy itself. All the outbound traffic is not redirected if the ports are empty.
+func (s *DaemonServer) setHttpEgressRedirect(ctx context.Context, in *pb.ApplyHttpChaosRequest, ports []uint32) error {
+	var pid uint32
+	if in.EnterNS {
+		var err error
+		pid, err = s.crClient.GetPidFromContainerID(ctx, in.ContainerId)
+		if err != nil {
+			return errors.Wrapf(err, "get PID of container(%s)", in.ContainerId)
+		}
+	}
+
+	iptables := buildIptablesClient(ctx, in.EnterNS, pid)
+	err := iptables.createNewChain(&iptablesChain{
+		Table: "nat",
+		Name:  httpEgressChain,
+		Rules: httpEgressRules(ports),
+	})
+	if err != nil {
+		return err
+	}
+	return iptables.ensureRule(&iptablesChain{
+		Table: "nat",
+		Name:  "OUTPUT",
+	}, "-A OUTPUT -j "+httpEgressChain)
+}
+
+// httpEgressRules returns the rules of the egress chain to redirect the outbound traffic to the ports
+func httpEgressRules(ports []uint32) []string {
+	if len(ports) == 0 {
+		return nil
+	}
+
+	var destinations []string
+	for _, port := range ports {
+		destinations = append(destinations, strconv.FormatUint(uint64(port), 10))
+	}
+	return []string{
+		fmt.Sprintf("-A %s -p tcp -m mark --mark 0x%x -j RETURN", httpEgressChain, tproxyEgressMark),
+		fmt.Sprintf("-A %s -p tcp -m multiport --dports %s -j REDIRECT --to-ports %d",
+			httpEgressChain, strings.Join(destinations, ","), tproxyEgressPort),
+	}
+}
+
+// checkRuleLimits returns error if any rule has percent, times or rate, which are not supported by tproxy.
+// An older tproxy ignores the limits, and would inject all the selected requests.
+func checkRuleLimits(rules []tproxyconfig.PodHttpChaosBaseRule, features map[string]bool) error {
+	if features[tproxyconfig.FeatureRuleLimits] {
+		return nil
+	}
+	for i, rule := range rules {
+		if rule.Percent != nil || rule.Times != nil || rule.Rate != nil {
+			return errors.Errorf("tproxy does not support the percent, times and rate of rule(%d)", i)
+		}
+	}
+	return nil
+}
+
+// tproxyFeatures returns the features reported by tproxy
+func tproxyFeatures(transport http.RoundTripper) (map[string]bool, error) {
+	req, err := http.NewRequest(http.MethodGet, "/capabilities", nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "create http request")
+	}
+
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send http request")
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+	if resp.StatusCode == http.StatusLocked {
+		return nil, errors.Errorf("tproxy is locked: %s", body)
+	}
+
+	features := make(map[string]bool)
+	// the bundled tproxy (v0.5.3) doesn't serve the capabilities
+	if resp.StatusCode != http.StatusOK {
+		return features, nil
+	}
+
+	var capabilities tproxyconfig.Capabilities
+	if err := json.Unmarshal(body, &capabilities); err != nil {
+		return nil, errors.Wrap(err, "unmarshal capabilities")
+	}
+	for _, feature := range capabilities.Features {
+		features[feature] = true
+	}
+	return features, nil
+}
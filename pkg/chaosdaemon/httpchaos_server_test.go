@@ -0,0 +1,121 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/tproxyconfig"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func respondWith(statusCode int, body string) roundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		Expect(req.Method).To(Equal(http.MethodGet))
+		Expect(req.URL.Path).To(Equal("/capabilities"))
+		return &http.Response{
+			StatusCode: statusCode,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			Request:    req,
+		}, nil
+	}
+}
+
+var _ = Describe("http chaos server", func() {
+	port := func(port int32) *int32 {
+		return &port
+	}
+
+	Context("egressPorts", func() {
+		It("should return the sorted ports of outbound rules", func() {
+			rules := []tproxyconfig.PodHttpChaosBaseRule{
+				{Direction: tproxyconfig.Outbound, Selector: tproxyconfig.PodHttpChaosSelector{Port: port(8080)}},
+				{Direction: tproxyconfig.Inbound, Selector: tproxyconfig.PodHttpChaosSelector{Port: port(80)}},
+				{Selector: tproxyconfig.PodHttpChaosSelector{Port: port(8000)}},
+				{Direction: tproxyconfig.Outbound, Selector: tproxyconfig.PodHttpChaosSelector{Port: port(443)}},
+				{Direction: tproxyconfig.Outbound, Selector: tproxyconfig.PodHttpChaosSelector{Port: port(8080)}},
+				{Direction: tproxyconfig.Outbound},
+			}
+			Expect(egressPorts(rules)).To(Equal([]uint32{443, 8080}))
+		})
+
+		It("should return nothing without outbound rules", func() {
+			rules := []tproxyconfig.PodHttpChaosBaseRule{
+				{Direction: tproxyconfig.Inbound, Selector: tproxyconfig.PodHttpChaosSelector{Port: port(80)}},
+			}
+			Expect(egressPorts(rules)).To(BeEmpty())
+		})
+	})
+
+	Context("httpEgressRules", func() {
+		It("should skip the connections of tproxy and redirect the others", func() {
+			Expect(httpEgressRules([]uint32{443, 8080})).To(Equal([]string{
+				"-A CHAOS-HTTP-EGRESS -p tcp -m mark --mark 0x6368 -j RETURN",
+				"-A CHAOS-HTTP-EGRESS -p tcp -m multiport --dports 443,8080 -j REDIRECT --to-ports 15006",
+			}))
+		})
+
+		It("should flush the chain without ports", func() {
+			Expect(httpEgressRules(nil)).To(BeEmpty())
+		})
+	})
+
+	Context("checkRuleLimits", func() {
+		percent := int32(5)
+		rules := []tproxyconfig.PodHttpChaosBaseRule{
+			{Selector: tproxyconfig.PodHttpChaosSelector{Port: port(80)}},
+			{Selector: tproxyconfig.PodHttpChaosSelector{Port: port(80)}, Percent: &percent},
+		}
+
+		It("should reject the limits if tproxy does not support them", func() {
+			Expect(checkRuleLimits(rules, map[string]bool{tproxyconfig.FeatureEgress: true})).NotTo(BeNil())
+			Expect(checkRuleLimits(rules[:1], map[string]bool{})).To(BeNil())
+		})
+
+		It("should pass the limits if tproxy supports them", func() {
+			Expect(checkRuleLimits(rules, map[string]bool{tproxyconfig.FeatureRuleLimits: true})).To(BeNil())
+		})
+	})
+
+	Context("tproxyFeatures", func() {
+		It("should return the reported features", func() {
+			features, err := tproxyFeatures(respondWith(http.StatusOK, `{"version":"v0.6.0","features":["egress"]}`))
+			Expect(err).To(BeNil())
+			Expect(features).To(Equal(map[string]bool{tproxyconfig.FeatureEgress: true}))
+		})
+
+		It("should return no feature for an older tproxy", func() {
+			features, err := tproxyFeatures(respondWith(http.StatusBadRequest, "unknown method"))
+			Expect(err).To(BeNil())
+			Expect(features[tproxyconfig.FeatureEgress]).To(BeFalse())
+		})
+
+		It("should return error if tproxy is locked", func() {
+			_, err := tproxyFeatures(respondWith(http.StatusLocked, ""))
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
@@ -67,10 +67,20 @@ type iptablesClient struct {
 }
 
 type iptablesChain struct {
+	// Table is the table of the chain, the default table filter is used if it's empty
+	Table string
 	Name  string
 	Rules []string
 }
 
+// args returns the arguments of iptables command on the table of chain
+func (chain *iptablesChain) args(args ...string) []string {
+	if chain.Table == "" {
+		return append([]string{"-w"}, args...)
+	}
+	return append([]string{"-w", "-t", chain.Table}, args...)
+}
+
 func buildIptablesClient(ctx context.Context, enterNS bool, pid uint32) iptablesClient {
 	return iptablesClient{
 		ctx,
@@ -193,7 +203,7 @@ func (iptables *iptablesClient) initializeEnv() error {
 
 // createNewChain will cover existing chain
 func (iptables *iptablesClient) createNewChain(chain *iptablesChain) error {
-	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, "-w", "-N", chain.Name).SetContext(iptables.ctx)
+	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, chain.args("-N", chain.Name)...).SetContext(iptables.ctx)
 	if iptables.enterNS {
 		processBuilder = processBuilder.SetNS(iptables.pid, bpm.NetNS)
 	}
@@ -230,7 +240,7 @@ func (iptables *iptablesClient) deleteAndWriteRules(chain *iptablesChain) error
 }
 
 func (iptables *iptablesClient) ensureRule(chain *iptablesChain, rule string) error {
-	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, "-w", "-S", chain.Name).SetContext(iptables.ctx)
+	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, chain.args("-S", chain.Name)...).SetContext(iptables.ctx)
 	if iptables.enterNS {
 		processBuilder = processBuilder.SetNS(iptables.pid, bpm.NetNS)
 	}
@@ -246,7 +256,7 @@ func (iptables *iptablesClient) ensureRule(chain *iptablesChain, rule string) er
 	}
 
 	// TODO: lock on every container but not on chaos-daemon's `/run/xtables.lock`
-	processBuilder = bpm.DefaultProcessBuilder(iptablesCmd, strings.Split("-w "+rule, " ")...).SetContext(iptables.ctx)
+	processBuilder = bpm.DefaultProcessBuilder(iptablesCmd, chain.args(strings.Split(rule, " ")...)...).SetContext(iptables.ctx)
 	if iptables.enterNS {
 		processBuilder = processBuilder.SetNS(iptables.pid, bpm.NetNS)
 	}
@@ -260,7 +270,7 @@ func (iptables *iptablesClient) ensureRule(chain *iptablesChain, rule string) er
 }
 
 func (iptables *iptablesClient) flushIptablesChain(chain *iptablesChain) error {
-	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, "-w", "-F", chain.Name).SetContext(iptables.ctx)
+	processBuilder := bpm.DefaultProcessBuilder(iptablesCmd, chain.args("-F", chain.Name)...).SetContext(iptables.ctx)
 	if iptables.enterNS {
 		processBuilder = processBuilder.SetNS(iptables.pid, bpm.NetNS)
 	}
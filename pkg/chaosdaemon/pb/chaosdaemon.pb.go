@@ -212,7 +212,7 @@ func (x Tc_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Tc_Type.Descriptor instead.
 func (Tc_Type) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{28, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{32, 0}
 }
 
 type ApplyBlockChaosRequest_Action int32
@@ -255,7 +255,7 @@ func (x ApplyBlockChaosRequest_Action) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ApplyBlockChaosRequest_Action.Descriptor instead.
 func (ApplyBlockChaosRequest_Action) EnumDescriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{32, 0}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{36, 0}
 }
 
 type TcHandle struct {
@@ -1649,6 +1649,7 @@ type ExecStressRequest struct {
 	EnterNS         bool                    `protobuf:"varint,4,opt,name=enterNS,proto3" json:"enterNS,omitempty"`
 	MemoryStressors string                  `protobuf:"bytes,5,opt,name=memoryStressors,proto3" json:"memoryStressors,omitempty"`
 	OomScoreAdj     int32                   `protobuf:"varint,7,opt,name=oomScoreAdj,proto3" json:"oomScoreAdj,omitempty"`
+	Throttle        *CgroupThrottle         `protobuf:"bytes,8,opt,name=throttle,proto3" json:"throttle,omitempty"`
 }
 
 func (x *ExecStressRequest) Reset() {
@@ -1725,17 +1726,27 @@ func (x *ExecStressRequest) GetOomScoreAdj() int32 {
 	return 0
 }
 
+func (x *ExecStressRequest) GetThrottle() *CgroupThrottle {
+	if x != nil {
+		return x.Throttle
+	}
+	return nil
+}
+
 type ExecStressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CpuInstance       string `protobuf:"bytes,1,opt,name=cpuInstance,proto3" json:"cpuInstance,omitempty"`
-	CpuStartTime      int64  `protobuf:"varint,2,opt,name=cpuStartTime,proto3" json:"cpuStartTime,omitempty"`
-	MemoryInstance    string `protobuf:"bytes,3,opt,name=memoryInstance,proto3" json:"memoryInstance,omitempty"`
-	MemoryStartTime   int64  `protobuf:"varint,4,opt,name=memoryStartTime,proto3" json:"memoryStartTime,omitempty"`
-	CpuInstanceUid    string `protobuf:"bytes,5,opt,name=cpuInstanceUid,proto3" json:"cpuInstanceUid,omitempty"`
-	MemoryInstanceUid string `protobuf:"bytes,6,opt,name=memoryInstanceUid,proto3" json:"memoryInstanceUid,omitempty"`
+	CpuInstance       string          `protobuf:"bytes,1,opt,name=cpuInstance,proto3" json:"cpuInstance,omitempty"`
+	CpuStartTime      int64           `protobuf:"varint,2,opt,name=cpuStartTime,proto3" json:"cpuStartTime,omitempty"`
+	MemoryInstance    string          `protobuf:"bytes,3,opt,name=memoryInstance,proto3" json:"memoryInstance,omitempty"`
+	MemoryStartTime   int64           `protobuf:"varint,4,opt,name=memoryStartTime,proto3" json:"memoryStartTime,omitempty"`
+	CpuInstanceUid    string          `protobuf:"bytes,5,opt,name=cpuInstanceUid,proto3" json:"cpuInstanceUid,omitempty"`
+	MemoryInstanceUid string          `protobuf:"bytes,6,opt,name=memoryInstanceUid,proto3" json:"memoryInstanceUid,omitempty"`
+	CgroupPath        string          `protobuf:"bytes,7,opt,name=cgroupPath,proto3" json:"cgroupPath,omitempty"`
+	CgroupDriver      string          `protobuf:"bytes,8,opt,name=cgroupDriver,proto3" json:"cgroupDriver,omitempty"`
+	OriginalThrottle  *CgroupThrottle `protobuf:"bytes,9,opt,name=originalThrottle,proto3" json:"originalThrottle,omitempty"`
 }
 
 func (x *ExecStressResponse) Reset() {
@@ -1812,17 +1823,40 @@ func (x *ExecStressResponse) GetMemoryInstanceUid() string {
 	return ""
 }
 
+func (x *ExecStressResponse) GetCgroupPath() string {
+	if x != nil {
+		return x.CgroupPath
+	}
+	return ""
+}
+
+func (x *ExecStressResponse) GetCgroupDriver() string {
+	if x != nil {
+		return x.CgroupDriver
+	}
+	return ""
+}
+
+func (x *ExecStressResponse) GetOriginalThrottle() *CgroupThrottle {
+	if x != nil {
+		return x.OriginalThrottle
+	}
+	return nil
+}
+
 type CancelStressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CpuInstance       string `protobuf:"bytes,1,opt,name=cpuInstance,proto3" json:"cpuInstance,omitempty"`
-	CpuStartTime      int64  `protobuf:"varint,2,opt,name=cpuStartTime,proto3" json:"cpuStartTime,omitempty"`
-	MemoryInstance    string `protobuf:"bytes,3,opt,name=memoryInstance,proto3" json:"memoryInstance,omitempty"`
-	MemoryStartTime   int64  `protobuf:"varint,4,opt,name=memoryStartTime,proto3" json:"memoryStartTime,omitempty"`
-	CpuInstanceUid    string `protobuf:"bytes,5,opt,name=cpuInstanceUid,proto3" json:"cpuInstanceUid,omitempty"`
-	MemoryInstanceUid string `protobuf:"bytes,6,opt,name=memoryInstanceUid,proto3" json:"memoryInstanceUid,omitempty"`
+	CpuInstance       string          `protobuf:"bytes,1,opt,name=cpuInstance,proto3" json:"cpuInstance,omitempty"`
+	CpuStartTime      int64           `protobuf:"varint,2,opt,name=cpuStartTime,proto3" json:"cpuStartTime,omitempty"`
+	MemoryInstance    string          `protobuf:"bytes,3,opt,name=memoryInstance,proto3" json:"memoryInstance,omitempty"`
+	MemoryStartTime   int64           `protobuf:"varint,4,opt,name=memoryStartTime,proto3" json:"memoryStartTime,omitempty"`
+	CpuInstanceUid    string          `protobuf:"bytes,5,opt,name=cpuInstanceUid,proto3" json:"cpuInstanceUid,omitempty"`
+	MemoryInstanceUid string          `protobuf:"bytes,6,opt,name=memoryInstanceUid,proto3" json:"memoryInstanceUid,omitempty"`
+	CgroupPath        string          `protobuf:"bytes,7,opt,name=cgroupPath,proto3" json:"cgroupPath,omitempty"`
+	OriginalThrottle  *CgroupThrottle `protobuf:"bytes,8,opt,name=originalThrottle,proto3" json:"originalThrottle,omitempty"`
 }
 
 func (x *CancelStressRequest) Reset() {
@@ -1899,6 +1933,83 @@ func (x *CancelStressRequest) GetMemoryInstanceUid() string {
 	return ""
 }
 
+func (x *CancelStressRequest) GetCgroupPath() string {
+	if x != nil {
+		return x.CgroupPath
+	}
+	return ""
+}
+
+func (x *CancelStressRequest) GetOriginalThrottle() *CgroupThrottle {
+	if x != nil {
+		return x.OriginalThrottle
+	}
+	return nil
+}
+
+type CgroupThrottle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpuMax     string   `protobuf:"bytes,1,opt,name=cpuMax,proto3" json:"cpuMax,omitempty"`
+	MemoryHigh string   `protobuf:"bytes,2,opt,name=memoryHigh,proto3" json:"memoryHigh,omitempty"`
+	IoMax      []string `protobuf:"bytes,3,rep,name=ioMax,proto3" json:"ioMax,omitempty"`
+}
+
+func (x *CgroupThrottle) Reset() {
+	*x = CgroupThrottle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupThrottle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupThrottle) ProtoMessage() {}
+
+func (x *CgroupThrottle) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupThrottle.ProtoReflect.Descriptor instead.
+func (*CgroupThrottle) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CgroupThrottle) GetCpuMax() string {
+	if x != nil {
+		return x.CpuMax
+	}
+	return ""
+}
+
+func (x *CgroupThrottle) GetMemoryHigh() string {
+	if x != nil {
+		return x.MemoryHigh
+	}
+	return ""
+}
+
+func (x *CgroupThrottle) GetIoMax() []string {
+	if x != nil {
+		return x.IoMax
+	}
+	return nil
+}
+
 type ApplyIOChaosRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1916,7 +2027,7 @@ type ApplyIOChaosRequest struct {
 func (x *ApplyIOChaosRequest) Reset() {
 	*x = ApplyIOChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[23]
+		mi := &file_chaosdaemon_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1929,7 +2040,7 @@ func (x *ApplyIOChaosRequest) String() string {
 func (*ApplyIOChaosRequest) ProtoMessage() {}
 
 func (x *ApplyIOChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[23]
+	mi := &file_chaosdaemon_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1942,7 +2053,7 @@ func (x *ApplyIOChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyIOChaosRequest.ProtoReflect.Descriptor instead.
 func (*ApplyIOChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{23}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ApplyIOChaosRequest) GetActions() string {
@@ -2007,7 +2118,7 @@ type ApplyIOChaosResponse struct {
 func (x *ApplyIOChaosResponse) Reset() {
 	*x = ApplyIOChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[24]
+		mi := &file_chaosdaemon_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2020,7 +2131,7 @@ func (x *ApplyIOChaosResponse) String() string {
 func (*ApplyIOChaosResponse) ProtoMessage() {}
 
 func (x *ApplyIOChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[24]
+	mi := &file_chaosdaemon_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2033,7 +2144,7 @@ func (x *ApplyIOChaosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyIOChaosResponse.ProtoReflect.Descriptor instead.
 func (*ApplyIOChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{24}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ApplyIOChaosResponse) GetInstance() int64 {
@@ -2075,7 +2186,7 @@ type ApplyHttpChaosRequest struct {
 func (x *ApplyHttpChaosRequest) Reset() {
 	*x = ApplyHttpChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[25]
+		mi := &file_chaosdaemon_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2088,7 +2199,7 @@ func (x *ApplyHttpChaosRequest) String() string {
 func (*ApplyHttpChaosRequest) ProtoMessage() {}
 
 func (x *ApplyHttpChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[25]
+	mi := &file_chaosdaemon_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2101,7 +2212,7 @@ func (x *ApplyHttpChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyHttpChaosRequest.ProtoReflect.Descriptor instead.
 func (*ApplyHttpChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{25}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ApplyHttpChaosRequest) GetRules() string {
@@ -2175,7 +2286,7 @@ type ApplyHttpChaosResponse struct {
 func (x *ApplyHttpChaosResponse) Reset() {
 	*x = ApplyHttpChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[26]
+		mi := &file_chaosdaemon_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2188,7 +2299,7 @@ func (x *ApplyHttpChaosResponse) String() string {
 func (*ApplyHttpChaosResponse) ProtoMessage() {}
 
 func (x *ApplyHttpChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[26]
+	mi := &file_chaosdaemon_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2201,7 +2312,7 @@ func (x *ApplyHttpChaosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyHttpChaosResponse.ProtoReflect.Descriptor instead.
 func (*ApplyHttpChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{26}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ApplyHttpChaosResponse) GetInstance() int64 {
@@ -2239,6 +2350,171 @@ func (x *ApplyHttpChaosResponse) GetInstanceUid() string {
 	return ""
 }
 
+type GetHttpChaosCountersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Instance    int64  `protobuf:"varint,1,opt,name=instance,proto3" json:"instance,omitempty"`
+	StartTime   int64  `protobuf:"varint,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	InstanceUid string `protobuf:"bytes,3,opt,name=instance_uid,json=instanceUid,proto3" json:"instance_uid,omitempty"`
+}
+
+func (x *GetHttpChaosCountersRequest) Reset() {
+	*x = GetHttpChaosCountersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHttpChaosCountersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHttpChaosCountersRequest) ProtoMessage() {}
+
+func (x *GetHttpChaosCountersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHttpChaosCountersRequest.ProtoReflect.Descriptor instead.
+func (*GetHttpChaosCountersRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetHttpChaosCountersRequest) GetInstance() int64 {
+	if x != nil {
+		return x.Instance
+	}
+	return 0
+}
+
+func (x *GetHttpChaosCountersRequest) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *GetHttpChaosCountersRequest) GetInstanceUid() string {
+	if x != nil {
+		return x.InstanceUid
+	}
+	return ""
+}
+
+type GetHttpChaosCountersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Counters []*HttpChaosRuleCounter `protobuf:"bytes,1,rep,name=counters,proto3" json:"counters,omitempty"`
+}
+
+func (x *GetHttpChaosCountersResponse) Reset() {
+	*x = GetHttpChaosCountersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHttpChaosCountersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHttpChaosCountersResponse) ProtoMessage() {}
+
+func (x *GetHttpChaosCountersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHttpChaosCountersResponse.ProtoReflect.Descriptor instead.
+func (*GetHttpChaosCountersResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetHttpChaosCountersResponse) GetCounters() []*HttpChaosRuleCounter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+type HttpChaosRuleCounter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Matched  int64 `protobuf:"varint,1,opt,name=matched,proto3" json:"matched,omitempty"`
+	Injected int64 `protobuf:"varint,2,opt,name=injected,proto3" json:"injected,omitempty"`
+}
+
+func (x *HttpChaosRuleCounter) Reset() {
+	*x = HttpChaosRuleCounter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpChaosRuleCounter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpChaosRuleCounter) ProtoMessage() {}
+
+func (x *HttpChaosRuleCounter) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpChaosRuleCounter.ProtoReflect.Descriptor instead.
+func (*HttpChaosRuleCounter) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HttpChaosRuleCounter) GetMatched() int64 {
+	if x != nil {
+		return x.Matched
+	}
+	return 0
+}
+
+func (x *HttpChaosRuleCounter) GetInjected() int64 {
+	if x != nil {
+		return x.Injected
+	}
+	return 0
+}
+
 type TcsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2252,7 +2528,7 @@ type TcsRequest struct {
 func (x *TcsRequest) Reset() {
 	*x = TcsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[27]
+		mi := &file_chaosdaemon_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2265,7 +2541,7 @@ func (x *TcsRequest) String() string {
 func (*TcsRequest) ProtoMessage() {}
 
 func (x *TcsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[27]
+	mi := &file_chaosdaemon_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2278,7 +2554,7 @@ func (x *TcsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TcsRequest.ProtoReflect.Descriptor instead.
 func (*TcsRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{27}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *TcsRequest) GetTcs() []*Tc {
@@ -2320,7 +2596,7 @@ type Tc struct {
 func (x *Tc) Reset() {
 	*x = Tc{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[28]
+		mi := &file_chaosdaemon_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2333,7 +2609,7 @@ func (x *Tc) String() string {
 func (*Tc) ProtoMessage() {}
 
 func (x *Tc) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[28]
+	mi := &file_chaosdaemon_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,7 +2622,7 @@ func (x *Tc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Tc.ProtoReflect.Descriptor instead.
 func (*Tc) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{28}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *Tc) GetType() Tc_Type {
@@ -2419,7 +2695,7 @@ type SetDNSServerRequest struct {
 func (x *SetDNSServerRequest) Reset() {
 	*x = SetDNSServerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[29]
+		mi := &file_chaosdaemon_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2432,7 +2708,7 @@ func (x *SetDNSServerRequest) String() string {
 func (*SetDNSServerRequest) ProtoMessage() {}
 
 func (x *SetDNSServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[29]
+	mi := &file_chaosdaemon_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2445,7 +2721,7 @@ func (x *SetDNSServerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetDNSServerRequest.ProtoReflect.Descriptor instead.
 func (*SetDNSServerRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{29}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SetDNSServerRequest) GetContainerId() string {
@@ -2490,7 +2766,7 @@ type InstallJVMRulesRequest struct {
 func (x *InstallJVMRulesRequest) Reset() {
 	*x = InstallJVMRulesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[30]
+		mi := &file_chaosdaemon_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2503,7 +2779,7 @@ func (x *InstallJVMRulesRequest) String() string {
 func (*InstallJVMRulesRequest) ProtoMessage() {}
 
 func (x *InstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[30]
+	mi := &file_chaosdaemon_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,7 +2792,7 @@ func (x *InstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallJVMRulesRequest.ProtoReflect.Descriptor instead.
 func (*InstallJVMRulesRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{30}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *InstallJVMRulesRequest) GetContainerId() string {
@@ -2561,7 +2837,7 @@ type UninstallJVMRulesRequest struct {
 func (x *UninstallJVMRulesRequest) Reset() {
 	*x = UninstallJVMRulesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[31]
+		mi := &file_chaosdaemon_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2574,7 +2850,7 @@ func (x *UninstallJVMRulesRequest) String() string {
 func (*UninstallJVMRulesRequest) ProtoMessage() {}
 
 func (x *UninstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[31]
+	mi := &file_chaosdaemon_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2587,7 +2863,7 @@ func (x *UninstallJVMRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UninstallJVMRulesRequest.ProtoReflect.Descriptor instead.
 func (*UninstallJVMRulesRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{31}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *UninstallJVMRulesRequest) GetContainerId() string {
@@ -2633,7 +2909,7 @@ type ApplyBlockChaosRequest struct {
 func (x *ApplyBlockChaosRequest) Reset() {
 	*x = ApplyBlockChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[32]
+		mi := &file_chaosdaemon_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2646,7 +2922,7 @@ func (x *ApplyBlockChaosRequest) String() string {
 func (*ApplyBlockChaosRequest) ProtoMessage() {}
 
 func (x *ApplyBlockChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[32]
+	mi := &file_chaosdaemon_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,7 +2935,7 @@ func (x *ApplyBlockChaosRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyBlockChaosRequest.ProtoReflect.Descriptor instead.
 func (*ApplyBlockChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{32}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ApplyBlockChaosRequest) GetContainerId() string {
@@ -2710,7 +2986,7 @@ type BlockDelaySpec struct {
 func (x *BlockDelaySpec) Reset() {
 	*x = BlockDelaySpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[33]
+		mi := &file_chaosdaemon_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2723,7 +2999,7 @@ func (x *BlockDelaySpec) String() string {
 func (*BlockDelaySpec) ProtoMessage() {}
 
 func (x *BlockDelaySpec) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[33]
+	mi := &file_chaosdaemon_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2736,7 +3012,7 @@ func (x *BlockDelaySpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockDelaySpec.ProtoReflect.Descriptor instead.
 func (*BlockDelaySpec) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{33}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *BlockDelaySpec) GetDelay() int64 {
@@ -2772,7 +3048,7 @@ type BlockLimitSpec struct {
 func (x *BlockLimitSpec) Reset() {
 	*x = BlockLimitSpec{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[34]
+		mi := &file_chaosdaemon_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2785,7 +3061,7 @@ func (x *BlockLimitSpec) String() string {
 func (*BlockLimitSpec) ProtoMessage() {}
 
 func (x *BlockLimitSpec) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[34]
+	mi := &file_chaosdaemon_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2798,7 +3074,7 @@ func (x *BlockLimitSpec) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlockLimitSpec.ProtoReflect.Descriptor instead.
 func (*BlockLimitSpec) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{34}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *BlockLimitSpec) GetQuota() uint64 {
@@ -2826,7 +3102,7 @@ type ApplyBlockChaosResponse struct {
 func (x *ApplyBlockChaosResponse) Reset() {
 	*x = ApplyBlockChaosResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[35]
+		mi := &file_chaosdaemon_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2839,7 +3115,7 @@ func (x *ApplyBlockChaosResponse) String() string {
 func (*ApplyBlockChaosResponse) ProtoMessage() {}
 
 func (x *ApplyBlockChaosResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[35]
+	mi := &file_chaosdaemon_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,7 +3128,7 @@ func (x *ApplyBlockChaosResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyBlockChaosResponse.ProtoReflect.Descriptor instead.
 func (*ApplyBlockChaosResponse) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{35}
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ApplyBlockChaosResponse) GetInjectionId() int32 {
@@ -2873,7 +3149,7 @@ type RecoverBlockChaosRequest struct {
 func (x *RecoverBlockChaosRequest) Reset() {
 	*x = RecoverBlockChaosRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaosdaemon_proto_msgTypes[36]
+		mi := &file_chaosdaemon_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2886,7 +3162,433 @@ func (x *RecoverBlockChaosRequest) String() string {
 func (*RecoverBlockChaosRequest) ProtoMessage() {}
 
 func (x *RecoverBlockChaosRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_chaosdaemon_proto_msgTypes[36]
+	mi := &file_chaosdaemon_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverBlockChaosRequest.ProtoReflect.Descriptor instead.
+func (*RecoverBlockChaosRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RecoverBlockChaosRequest) GetInjectionId() int32 {
+	if x != nil {
+		return x.InjectionId
+	}
+	return 0
+}
+
+type ApplyPodFaultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Spec        string `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *ApplyPodFaultRequest) Reset() {
+	*x = ApplyPodFaultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyPodFaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPodFaultRequest) ProtoMessage() {}
+
+func (x *ApplyPodFaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPodFaultRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPodFaultRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ApplyPodFaultRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *ApplyPodFaultRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ApplyPodFaultRequest) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+type ApplyPodFaultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *ApplyPodFaultResponse) Reset() {
+	*x = ApplyPodFaultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyPodFaultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPodFaultResponse) ProtoMessage() {}
+
+func (x *ApplyPodFaultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPodFaultResponse.ProtoReflect.Descriptor instead.
+func (*ApplyPodFaultResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ApplyPodFaultResponse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type RecoverPodFaultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+	Action      string `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Spec        string `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	State       string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *RecoverPodFaultRequest) Reset() {
+	*x = RecoverPodFaultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverPodFaultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverPodFaultRequest) ProtoMessage() {}
+
+func (x *RecoverPodFaultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverPodFaultRequest.ProtoReflect.Descriptor instead.
+func (*RecoverPodFaultRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RecoverPodFaultRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *RecoverPodFaultRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RecoverPodFaultRequest) GetSpec() string {
+	if x != nil {
+		return x.Spec
+	}
+	return ""
+}
+
+func (x *RecoverPodFaultRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+type ProbeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=container_id,json=containerId,proto3" json:"container_id,omitempty"`
+}
+
+func (x *ProbeRequest) Reset() {
+	*x = ProbeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeRequest) ProtoMessage() {}
+
+func (x *ProbeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeRequest.ProtoReflect.Descriptor instead.
+func (*ProbeRequest) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ProbeRequest) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+type ProbeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host      *HostCapabilities      `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Container *ContainerCapabilities `protobuf:"bytes,2,opt,name=container,proto3" json:"container,omitempty"`
+}
+
+func (x *ProbeResponse) Reset() {
+	*x = ProbeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProbeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProbeResponse) ProtoMessage() {}
+
+func (x *ProbeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProbeResponse.ProtoReflect.Descriptor instead.
+func (*ProbeResponse) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ProbeResponse) GetHost() *HostCapabilities {
+	if x != nil {
+		return x.Host
+	}
+	return nil
+}
+
+func (x *ProbeResponse) GetContainer() *ContainerCapabilities {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+type HostCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KernelRelease        string   `protobuf:"bytes,1,opt,name=kernel_release,json=kernelRelease,proto3" json:"kernel_release,omitempty"`
+	CgroupVersion        string   `protobuf:"bytes,2,opt,name=cgroup_version,json=cgroupVersion,proto3" json:"cgroup_version,omitempty"`
+	Runtime              string   `protobuf:"bytes,3,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	MissingKernelModules []string `protobuf:"bytes,4,rep,name=missing_kernel_modules,json=missingKernelModules,proto3" json:"missing_kernel_modules,omitempty"`
+	Fuse                 bool     `protobuf:"varint,5,opt,name=fuse,proto3" json:"fuse,omitempty"`
+	Bpf                  bool     `protobuf:"varint,6,opt,name=bpf,proto3" json:"bpf,omitempty"`
+	Ptrace               bool     `protobuf:"varint,7,opt,name=ptrace,proto3" json:"ptrace,omitempty"`
+}
+
+func (x *HostCapabilities) Reset() {
+	*x = HostCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostCapabilities) ProtoMessage() {}
+
+func (x *HostCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostCapabilities.ProtoReflect.Descriptor instead.
+func (*HostCapabilities) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *HostCapabilities) GetKernelRelease() string {
+	if x != nil {
+		return x.KernelRelease
+	}
+	return ""
+}
+
+func (x *HostCapabilities) GetCgroupVersion() string {
+	if x != nil {
+		return x.CgroupVersion
+	}
+	return ""
+}
+
+func (x *HostCapabilities) GetRuntime() string {
+	if x != nil {
+		return x.Runtime
+	}
+	return ""
+}
+
+func (x *HostCapabilities) GetMissingKernelModules() []string {
+	if x != nil {
+		return x.MissingKernelModules
+	}
+	return nil
+}
+
+func (x *HostCapabilities) GetFuse() bool {
+	if x != nil {
+		return x.Fuse
+	}
+	return false
+}
+
+func (x *HostCapabilities) GetBpf() bool {
+	if x != nil {
+		return x.Bpf
+	}
+	return false
+}
+
+func (x *HostCapabilities) GetPtrace() bool {
+	if x != nil {
+		return x.Ptrace
+	}
+	return false
+}
+
+type ContainerCapabilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid        uint32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	CgroupPath string `protobuf:"bytes,2,opt,name=cgroup_path,json=cgroupPath,proto3" json:"cgroup_path,omitempty"`
+}
+
+func (x *ContainerCapabilities) Reset() {
+	*x = ContainerCapabilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaosdaemon_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerCapabilities) ProtoMessage() {}
+
+func (x *ContainerCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_chaosdaemon_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2897,18 +3599,25 @@ func (x *RecoverBlockChaosRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverBlockChaosRequest.ProtoReflect.Descriptor instead.
-func (*RecoverBlockChaosRequest) Descriptor() ([]byte, []int) {
-	return file_chaosdaemon_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use ContainerCapabilities.ProtoReflect.Descriptor instead.
+func (*ContainerCapabilities) Descriptor() ([]byte, []int) {
+	return file_chaosdaemon_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RecoverBlockChaosRequest) GetInjectionId() int32 {
+func (x *ContainerCapabilities) GetPid() uint32 {
 	if x != nil {
-		return x.InjectionId
+		return x.Pid
 	}
 	return 0
 }
 
+func (x *ContainerCapabilities) GetCgroupPath() string {
+	if x != nil {
+		return x.CgroupPath
+	}
+	return ""
+}
+
 var File_chaosdaemon_proto protoreflect.FileDescriptor
 
 var file_chaosdaemon_proto_rawDesc = []byte{
@@ -3081,7 +3790,7 @@ var file_chaosdaemon_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
 	0x6e, 0x22, 0x1e, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04, 0x4b,
 	0x49, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x45, 0x54, 0x50, 0x49, 0x44, 0x10,
-	0x01, 0x22, 0x89, 0x02, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73,
+	0x01, 0x22, 0xb9, 0x02, 0x0a, 0x11, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63,
 	0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x63,
@@ -3095,9 +3804,12 @@ var file_chaosdaemon_proto_rawDesc = []byte{
 	0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
 	0x79, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6f,
 	0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0b, 0x6f, 0x6f, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x22, 0x1f, 0x0a, 0x05,
+	0x0b, 0x6f, 0x6f, 0x6d, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x41, 0x64, 0x6a, 0x12, 0x2e, 0x0a, 0x08,
+	0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x62, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74,
+	0x6c, 0x65, 0x52, 0x08, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x22, 0x1f, 0x0a, 0x05,
 	0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
-	0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x01, 0x22, 0x82, 0x02,
+	0x45, 0x52, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x01, 0x22, 0x86, 0x03,
 	0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61,
 	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e,
@@ -3114,223 +3826,326 @@ var file_chaosdaemon_proto_rawDesc = []byte{
 	0x65, 0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e,
 	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55,
-	0x69, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72,
-	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x70,
-	0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c,
-	0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x6f,
-	0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x70, 0x75, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x65,
-	0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0xe1, 0x01, 0x0a, 0x13, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f,
-	0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75,
-	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x14,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21,
-	0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69,
-	0x64, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43,
-	0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x6f, 0x72,
-	0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x22, 0xab,
-	0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x61, 0x6c, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x68, 0x72, 0x6f,
+	0x74, 0x74, 0x6c, 0x65, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x68,
+	0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x22, 0xe3, 0x02, 0x0a, 0x13, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x70, 0x75, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x70, 0x75, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x2c,
+	0x0a, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x55, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3e, 0x0a, 0x10,
+	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x52, 0x10, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x61, 0x6c, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x22, 0x5e, 0x0a, 0x0e,
+	0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x70, 0x75, 0x4d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x70, 0x75, 0x4d, 0x61, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x48, 0x69, 0x67, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x48, 0x69, 0x67, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6f, 0x4d, 0x61, 0x78, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6f, 0x4d, 0x61, 0x78, 0x22, 0xe1, 0x01, 0x0a,
+	0x13, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73,
 	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x63, 0x0a, 0x0a,
-	0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x03, 0x74, 0x63,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x52,
-	0x03, 0x74, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e,
-	0x53, 0x22, 0x8f, 0x02, 0x0a, 0x02, 0x54, 0x63, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x6e, 0x65, 0x74,
-	0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70, 0x62, 0x2e, 0x4e, 0x65,
-	0x74, 0x65, 0x6d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x03, 0x74, 0x62,
-	0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x62, 0x66,
-	0x52, 0x03, 0x74, 0x62, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x67, 0x72, 0x65,
-	0x73, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x22, 0x20, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x45, 0x54,
-	0x45, 0x4d, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54,
-	0x48, 0x10, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d,
-	0x0a, 0x0a, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x64, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22,
-	0x7d, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
-	0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x7f,
-	0x0a, 0x18, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x75, 0x6c,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22,
-	0xf0, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68,
-	0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64,
+	0x22, 0x73, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0xfa, 0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48,
+	0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x6c, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64,
+	0x22, 0x7a, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x55, 0x69, 0x64, 0x22, 0x54, 0x0a, 0x1c,
+	0x47, 0x65, 0x74, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x08,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x62, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x75, 0x6c,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x4c, 0x0a, 0x14, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
+	0x75, 0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0x63, 0x0a, 0x0a, 0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x03, 0x74, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x63, 0x52, 0x03, 0x74, 0x63, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x8f, 0x02, 0x0a, 0x02, 0x54, 0x63, 0x12, 0x1f, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x70, 0x62, 0x2e,
+	0x54, 0x63, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a,
+	0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x70,
+	0x62, 0x2e, 0x4e, 0x65, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x65, 0x6d, 0x12, 0x19,
+	0x0a, 0x03, 0x74, 0x62, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x70, 0x62,
+	0x2e, 0x54, 0x62, 0x66, 0x52, 0x03, 0x74, 0x62, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x70, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x70, 0x73, 0x65, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
+	0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x65, 0x67, 0x72, 0x65, 0x73, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x22, 0x20, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a,
+	0x05, 0x4e, 0x45, 0x54, 0x45, 0x4d, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x41, 0x4e, 0x44,
+	0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x01, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x44,
+	0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x6e, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x4e, 0x53, 0x22, 0x7d, 0x0a, 0x16, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56,
+	0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x72, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x4e, 0x53, 0x22, 0x7f, 0x0a, 0x18, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a,
+	0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x4e, 0x53, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65,
+	0x72, 0x4e, 0x53, 0x22, 0xf0, 0x01, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x12, 0x39, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70,
+	0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63,
+	0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x4e, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e,
+	0x53, 0x22, 0x13, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x10, 0x00, 0x22, 0x60, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44,
+	0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x20,
+	0x0a, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x12, 0x1b, 0x0a, 0x09, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x73, 0x22, 0x3c, 0x0a,
+	0x17, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x18, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69,
+	0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x14, 0x41, 0x70,
+	0x70, 0x6c, 0x79, 0x50, 0x6f, 0x64, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x22, 0x2d, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x64, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0x7d, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f,
 	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x39,
-	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68,
-	0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x05, 0x64, 0x65, 0x6c,
-	0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x62, 0x2e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x53, 0x70, 0x65, 0x63, 0x52, 0x05, 0x64, 0x65,
-	0x6c, 0x61, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x4e, 0x53, 0x22, 0x13, 0x0a,
-	0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x10, 0x00, 0x22, 0x60, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79,
-	0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
-	0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x0b, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
-	0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6a, 0x69,
-	0x74, 0x74, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x0e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x53, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09,
-	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x08, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x3d, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76,
-	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x6a, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x32, 0xd2, 0x08, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6f, 0x73,
-	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x54, 0x63, 0x73,
-	0x12, 0x0e, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22,
+	0x31, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x72, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x37, 0x0a,
+	0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0xee, 0x01, 0x0a, 0x10, 0x48, 0x6f, 0x73, 0x74, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6b,
+	0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x6b,
+	0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x14, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x72, 0x6e,
+	0x65, 0x6c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x73,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x73, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x62, 0x70, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x70, 0x66, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x74, 0x72, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x70, 0x74, 0x72, 0x61, 0x63, 0x65, 0x22, 0x4a, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x70,
+	0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x50,
+	0x61, 0x74, 0x68, 0x32, 0xf0, 0x0a, 0x0a, 0x0b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x44, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x53, 0x65, 0x74, 0x54, 0x63, 0x73, 0x12, 0x0e, 0x2e,
+	0x70, 0x62, 0x2e, 0x54, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x49, 0x50, 0x53, 0x65, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x50, 0x53, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x70,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a,
+	0x0d, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11, 0x52, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x0f,
+	0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x46, 0x6c,
-	0x75, 0x73, 0x68, 0x49, 0x50, 0x53, 0x65, 0x74, 0x73, 0x12, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x49,
-	0x50, 0x53, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x49, 0x70, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62,
-	0x2e, 0x49, 0x70, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00,
-	0x12, 0x3a, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x11,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x0f, 0x2e, 0x70, 0x62, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0d,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6c, 0x6c, 0x12, 0x14, 0x2e,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x69, 0x64, 0x12, 0x14, 0x2e,
 	0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x47, 0x65, 0x74, 0x50, 0x69, 0x64,
-	0x12, 0x14, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x40, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73,
-	0x12, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65,
-	0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x44, 0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73,
-	0x73, 0x6f, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70,
-	0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61,
-	0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x19,
-	0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61,
-	0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41,
-	0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c,
-	0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d,
+	0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x15, 0x2e,
+	0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x62, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x53, 0x74,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44,
+	0x0a, 0x0f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x6f, 0x72,
+	0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x74, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43,
+	0x68, 0x61, 0x6f, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49,
+	0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x4f, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x41, 0x70, 0x70,
+	0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x12, 0x19, 0x2e, 0x70, 0x62,
+	0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x48, 0x74, 0x74, 0x70, 0x43,
+	0x68, 0x61, 0x6f, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4c, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
+	0x68, 0x61, 0x6f, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4b, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43,
+	0x68, 0x61, 0x6f, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x68, 0x61, 0x6f, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c,
+	0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x17, 0x2e, 0x70,
+	0x62, 0x2e, 0x53, 0x65, 0x74, 0x44, 0x4e, 0x53, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x47, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a,
+	0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x11, 0x55, 0x6e, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e,
+	0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0f, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4b,
-	0x0a, 0x11, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x4a, 0x56, 0x4d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f,
+	0x64, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c,
+	0x79, 0x50, 0x6f, 0x64, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x19, 0x2e, 0x70, 0x62, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x6f, 0x64, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x0f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x64, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x1a, 0x2e, 0x70, 0x62, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x64,
+	0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12,
+	0x10, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3346,51 +4161,62 @@ func file_chaosdaemon_proto_rawDescGZIP() []byte {
 }
 
 var file_chaosdaemon_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_chaosdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
+var file_chaosdaemon_proto_msgTypes = make([]protoimpl.MessageInfo, 48)
 var file_chaosdaemon_proto_goTypes = []interface{}{
-	(Chain_Direction)(0),               // 0: pb.Chain.Direction
-	(ContainerAction_Action)(0),        // 1: pb.ContainerAction.Action
-	(ExecStressRequest_Scope)(0),       // 2: pb.ExecStressRequest.Scope
-	(Tc_Type)(0),                       // 3: pb.Tc.Type
-	(ApplyBlockChaosRequest_Action)(0), // 4: pb.ApplyBlockChaosRequest.Action
-	(*TcHandle)(nil),                   // 5: pb.TcHandle
-	(*ContainerRequest)(nil),           // 6: pb.ContainerRequest
-	(*ContainerResponse)(nil),          // 7: pb.ContainerResponse
-	(*NetemRequest)(nil),               // 8: pb.NetemRequest
-	(*Netem)(nil),                      // 9: pb.Netem
-	(*TbfRequest)(nil),                 // 10: pb.TbfRequest
-	(*Tbf)(nil),                        // 11: pb.Tbf
-	(*QdiscRequest)(nil),               // 12: pb.QdiscRequest
-	(*Qdisc)(nil),                      // 13: pb.Qdisc
-	(*EmatchFilterRequest)(nil),        // 14: pb.EmatchFilterRequest
-	(*EmatchFilter)(nil),               // 15: pb.EmatchFilter
-	(*TcFilterRequest)(nil),            // 16: pb.TcFilterRequest
-	(*TcFilter)(nil),                   // 17: pb.TcFilter
-	(*IPSetsRequest)(nil),              // 18: pb.IPSetsRequest
-	(*IPSet)(nil),                      // 19: pb.IPSet
-	(*CidrAndPort)(nil),                // 20: pb.CidrAndPort
-	(*IptablesChainsRequest)(nil),      // 21: pb.IptablesChainsRequest
-	(*Chain)(nil),                      // 22: pb.Chain
-	(*TimeRequest)(nil),                // 23: pb.TimeRequest
-	(*ContainerAction)(nil),            // 24: pb.ContainerAction
-	(*ExecStressRequest)(nil),          // 25: pb.ExecStressRequest
-	(*ExecStressResponse)(nil),         // 26: pb.ExecStressResponse
-	(*CancelStressRequest)(nil),        // 27: pb.CancelStressRequest
-	(*ApplyIOChaosRequest)(nil),        // 28: pb.ApplyIOChaosRequest
-	(*ApplyIOChaosResponse)(nil),       // 29: pb.ApplyIOChaosResponse
-	(*ApplyHttpChaosRequest)(nil),      // 30: pb.ApplyHttpChaosRequest
-	(*ApplyHttpChaosResponse)(nil),     // 31: pb.ApplyHttpChaosResponse
-	(*TcsRequest)(nil),                 // 32: pb.TcsRequest
-	(*Tc)(nil),                         // 33: pb.Tc
-	(*SetDNSServerRequest)(nil),        // 34: pb.SetDNSServerRequest
-	(*InstallJVMRulesRequest)(nil),     // 35: pb.InstallJVMRulesRequest
-	(*UninstallJVMRulesRequest)(nil),   // 36: pb.UninstallJVMRulesRequest
-	(*ApplyBlockChaosRequest)(nil),     // 37: pb.ApplyBlockChaosRequest
-	(*BlockDelaySpec)(nil),             // 38: pb.BlockDelaySpec
-	(*BlockLimitSpec)(nil),             // 39: pb.BlockLimitSpec
-	(*ApplyBlockChaosResponse)(nil),    // 40: pb.ApplyBlockChaosResponse
-	(*RecoverBlockChaosRequest)(nil),   // 41: pb.RecoverBlockChaosRequest
-	(*empty.Empty)(nil),                // 42: google.protobuf.Empty
+	(Chain_Direction)(0),                 // 0: pb.Chain.Direction
+	(ContainerAction_Action)(0),          // 1: pb.ContainerAction.Action
+	(ExecStressRequest_Scope)(0),         // 2: pb.ExecStressRequest.Scope
+	(Tc_Type)(0),                         // 3: pb.Tc.Type
+	(ApplyBlockChaosRequest_Action)(0),   // 4: pb.ApplyBlockChaosRequest.Action
+	(*TcHandle)(nil),                     // 5: pb.TcHandle
+	(*ContainerRequest)(nil),             // 6: pb.ContainerRequest
+	(*ContainerResponse)(nil),            // 7: pb.ContainerResponse
+	(*NetemRequest)(nil),                 // 8: pb.NetemRequest
+	(*Netem)(nil),                        // 9: pb.Netem
+	(*TbfRequest)(nil),                   // 10: pb.TbfRequest
+	(*Tbf)(nil),                          // 11: pb.Tbf
+	(*QdiscRequest)(nil),                 // 12: pb.QdiscRequest
+	(*Qdisc)(nil),                        // 13: pb.Qdisc
+	(*EmatchFilterRequest)(nil),          // 14: pb.EmatchFilterRequest
+	(*EmatchFilter)(nil),                 // 15: pb.EmatchFilter
+	(*TcFilterRequest)(nil),              // 16: pb.TcFilterRequest
+	(*TcFilter)(nil),                     // 17: pb.TcFilter
+	(*IPSetsRequest)(nil),                // 18: pb.IPSetsRequest
+	(*IPSet)(nil),                        // 19: pb.IPSet
+	(*CidrAndPort)(nil),                  // 20: pb.CidrAndPort
+	(*IptablesChainsRequest)(nil),        // 21: pb.IptablesChainsRequest
+	(*Chain)(nil),                        // 22: pb.Chain
+	(*TimeRequest)(nil),                  // 23: pb.TimeRequest
+	(*ContainerAction)(nil),              // 24: pb.ContainerAction
+	(*ExecStressRequest)(nil),            // 25: pb.ExecStressRequest
+	(*ExecStressResponse)(nil),           // 26: pb.ExecStressResponse
+	(*CancelStressRequest)(nil),          // 27: pb.CancelStressRequest
+	(*CgroupThrottle)(nil),               // 28: pb.CgroupThrottle
+	(*ApplyIOChaosRequest)(nil),          // 29: pb.ApplyIOChaosRequest
+	(*ApplyIOChaosResponse)(nil),         // 30: pb.ApplyIOChaosResponse
+	(*ApplyHttpChaosRequest)(nil),        // 31: pb.ApplyHttpChaosRequest
+	(*ApplyHttpChaosResponse)(nil),       // 32: pb.ApplyHttpChaosResponse
+	(*GetHttpChaosCountersRequest)(nil),  // 33: pb.GetHttpChaosCountersRequest
+	(*GetHttpChaosCountersResponse)(nil), // 34: pb.GetHttpChaosCountersResponse
+	(*HttpChaosRuleCounter)(nil),         // 35: pb.HttpChaosRuleCounter
+	(*TcsRequest)(nil),                   // 36: pb.TcsRequest
+	(*Tc)(nil),                           // 37: pb.Tc
+	(*SetDNSServerRequest)(nil),          // 38: pb.SetDNSServerRequest
+	(*InstallJVMRulesRequest)(nil),       // 39: pb.InstallJVMRulesRequest
+	(*UninstallJVMRulesRequest)(nil),     // 40: pb.UninstallJVMRulesRequest
+	(*ApplyBlockChaosRequest)(nil),       // 41: pb.ApplyBlockChaosRequest
+	(*BlockDelaySpec)(nil),               // 42: pb.BlockDelaySpec
+	(*BlockLimitSpec)(nil),               // 43: pb.BlockLimitSpec
+	(*ApplyBlockChaosResponse)(nil),      // 44: pb.ApplyBlockChaosResponse
+	(*RecoverBlockChaosRequest)(nil),     // 45: pb.RecoverBlockChaosRequest
+	(*ApplyPodFaultRequest)(nil),         // 46: pb.ApplyPodFaultRequest
+	(*ApplyPodFaultResponse)(nil),        // 47: pb.ApplyPodFaultResponse
+	(*RecoverPodFaultRequest)(nil),       // 48: pb.RecoverPodFaultRequest
+	(*ProbeRequest)(nil),                 // 49: pb.ProbeRequest
+	(*ProbeResponse)(nil),                // 50: pb.ProbeResponse
+	(*HostCapabilities)(nil),             // 51: pb.HostCapabilities
+	(*ContainerCapabilities)(nil),        // 52: pb.ContainerCapabilities
+	(*empty.Empty)(nil),                  // 53: google.protobuf.Empty
 }
 var file_chaosdaemon_proto_depIdxs = []int32{
 	24, // 0: pb.ContainerRequest.action:type_name -> pb.ContainerAction
@@ -3414,49 +4240,63 @@ var file_chaosdaemon_proto_depIdxs = []int32{
 	0,  // 18: pb.Chain.direction:type_name -> pb.Chain.Direction
 	1,  // 19: pb.ContainerAction.action:type_name -> pb.ContainerAction.Action
 	2,  // 20: pb.ExecStressRequest.scope:type_name -> pb.ExecStressRequest.Scope
-	33, // 21: pb.TcsRequest.tcs:type_name -> pb.Tc
-	3,  // 22: pb.Tc.type:type_name -> pb.Tc.Type
-	9,  // 23: pb.Tc.netem:type_name -> pb.Netem
-	11, // 24: pb.Tc.tbf:type_name -> pb.Tbf
-	4,  // 25: pb.ApplyBlockChaosRequest.action:type_name -> pb.ApplyBlockChaosRequest.Action
-	38, // 26: pb.ApplyBlockChaosRequest.delay:type_name -> pb.BlockDelaySpec
-	32, // 27: pb.ChaosDaemon.SetTcs:input_type -> pb.TcsRequest
-	18, // 28: pb.ChaosDaemon.FlushIPSets:input_type -> pb.IPSetsRequest
-	21, // 29: pb.ChaosDaemon.SetIptablesChains:input_type -> pb.IptablesChainsRequest
-	23, // 30: pb.ChaosDaemon.SetTimeOffset:input_type -> pb.TimeRequest
-	23, // 31: pb.ChaosDaemon.RecoverTimeOffset:input_type -> pb.TimeRequest
-	6,  // 32: pb.ChaosDaemon.ContainerKill:input_type -> pb.ContainerRequest
-	6,  // 33: pb.ChaosDaemon.ContainerGetPid:input_type -> pb.ContainerRequest
-	25, // 34: pb.ChaosDaemon.ExecStressors:input_type -> pb.ExecStressRequest
-	27, // 35: pb.ChaosDaemon.CancelStressors:input_type -> pb.CancelStressRequest
-	28, // 36: pb.ChaosDaemon.ApplyIOChaos:input_type -> pb.ApplyIOChaosRequest
-	30, // 37: pb.ChaosDaemon.ApplyHttpChaos:input_type -> pb.ApplyHttpChaosRequest
-	37, // 38: pb.ChaosDaemon.ApplyBlockChaos:input_type -> pb.ApplyBlockChaosRequest
-	41, // 39: pb.ChaosDaemon.RecoverBlockChaos:input_type -> pb.RecoverBlockChaosRequest
-	34, // 40: pb.ChaosDaemon.SetDNSServer:input_type -> pb.SetDNSServerRequest
-	35, // 41: pb.ChaosDaemon.InstallJVMRules:input_type -> pb.InstallJVMRulesRequest
-	36, // 42: pb.ChaosDaemon.UninstallJVMRules:input_type -> pb.UninstallJVMRulesRequest
-	42, // 43: pb.ChaosDaemon.SetTcs:output_type -> google.protobuf.Empty
-	42, // 44: pb.ChaosDaemon.FlushIPSets:output_type -> google.protobuf.Empty
-	42, // 45: pb.ChaosDaemon.SetIptablesChains:output_type -> google.protobuf.Empty
-	42, // 46: pb.ChaosDaemon.SetTimeOffset:output_type -> google.protobuf.Empty
-	42, // 47: pb.ChaosDaemon.RecoverTimeOffset:output_type -> google.protobuf.Empty
-	42, // 48: pb.ChaosDaemon.ContainerKill:output_type -> google.protobuf.Empty
-	7,  // 49: pb.ChaosDaemon.ContainerGetPid:output_type -> pb.ContainerResponse
-	26, // 50: pb.ChaosDaemon.ExecStressors:output_type -> pb.ExecStressResponse
-	42, // 51: pb.ChaosDaemon.CancelStressors:output_type -> google.protobuf.Empty
-	29, // 52: pb.ChaosDaemon.ApplyIOChaos:output_type -> pb.ApplyIOChaosResponse
-	31, // 53: pb.ChaosDaemon.ApplyHttpChaos:output_type -> pb.ApplyHttpChaosResponse
-	40, // 54: pb.ChaosDaemon.ApplyBlockChaos:output_type -> pb.ApplyBlockChaosResponse
-	42, // 55: pb.ChaosDaemon.RecoverBlockChaos:output_type -> google.protobuf.Empty
-	42, // 56: pb.ChaosDaemon.SetDNSServer:output_type -> google.protobuf.Empty
-	42, // 57: pb.ChaosDaemon.InstallJVMRules:output_type -> google.protobuf.Empty
-	42, // 58: pb.ChaosDaemon.UninstallJVMRules:output_type -> google.protobuf.Empty
-	43, // [43:59] is the sub-list for method output_type
-	27, // [27:43] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	28, // 21: pb.ExecStressRequest.throttle:type_name -> pb.CgroupThrottle
+	28, // 22: pb.ExecStressResponse.originalThrottle:type_name -> pb.CgroupThrottle
+	28, // 23: pb.CancelStressRequest.originalThrottle:type_name -> pb.CgroupThrottle
+	35, // 24: pb.GetHttpChaosCountersResponse.counters:type_name -> pb.HttpChaosRuleCounter
+	37, // 25: pb.TcsRequest.tcs:type_name -> pb.Tc
+	3,  // 26: pb.Tc.type:type_name -> pb.Tc.Type
+	9,  // 27: pb.Tc.netem:type_name -> pb.Netem
+	11, // 28: pb.Tc.tbf:type_name -> pb.Tbf
+	4,  // 29: pb.ApplyBlockChaosRequest.action:type_name -> pb.ApplyBlockChaosRequest.Action
+	42, // 30: pb.ApplyBlockChaosRequest.delay:type_name -> pb.BlockDelaySpec
+	51, // 31: pb.ProbeResponse.host:type_name -> pb.HostCapabilities
+	52, // 32: pb.ProbeResponse.container:type_name -> pb.ContainerCapabilities
+	36, // 33: pb.ChaosDaemon.SetTcs:input_type -> pb.TcsRequest
+	18, // 34: pb.ChaosDaemon.FlushIPSets:input_type -> pb.IPSetsRequest
+	21, // 35: pb.ChaosDaemon.SetIptablesChains:input_type -> pb.IptablesChainsRequest
+	23, // 36: pb.ChaosDaemon.SetTimeOffset:input_type -> pb.TimeRequest
+	23, // 37: pb.ChaosDaemon.RecoverTimeOffset:input_type -> pb.TimeRequest
+	6,  // 38: pb.ChaosDaemon.ContainerKill:input_type -> pb.ContainerRequest
+	6,  // 39: pb.ChaosDaemon.ContainerGetPid:input_type -> pb.ContainerRequest
+	25, // 40: pb.ChaosDaemon.ExecStressors:input_type -> pb.ExecStressRequest
+	27, // 41: pb.ChaosDaemon.CancelStressors:input_type -> pb.CancelStressRequest
+	29, // 42: pb.ChaosDaemon.ApplyIOChaos:input_type -> pb.ApplyIOChaosRequest
+	31, // 43: pb.ChaosDaemon.ApplyHttpChaos:input_type -> pb.ApplyHttpChaosRequest
+	33, // 44: pb.ChaosDaemon.GetHttpChaosCounters:input_type -> pb.GetHttpChaosCountersRequest
+	41, // 45: pb.ChaosDaemon.ApplyBlockChaos:input_type -> pb.ApplyBlockChaosRequest
+	45, // 46: pb.ChaosDaemon.RecoverBlockChaos:input_type -> pb.RecoverBlockChaosRequest
+	38, // 47: pb.ChaosDaemon.SetDNSServer:input_type -> pb.SetDNSServerRequest
+	39, // 48: pb.ChaosDaemon.InstallJVMRules:input_type -> pb.InstallJVMRulesRequest
+	40, // 49: pb.ChaosDaemon.UninstallJVMRules:input_type -> pb.UninstallJVMRulesRequest
+	46, // 50: pb.ChaosDaemon.ApplyPodFault:input_type -> pb.ApplyPodFaultRequest
+	48, // 51: pb.ChaosDaemon.RecoverPodFault:input_type -> pb.RecoverPodFaultRequest
+	49, // 52: pb.ChaosDaemon.Probe:input_type -> pb.ProbeRequest
+	53, // 53: pb.ChaosDaemon.SetTcs:output_type -> google.protobuf.Empty
+	53, // 54: pb.ChaosDaemon.FlushIPSets:output_type -> google.protobuf.Empty
+	53, // 55: pb.ChaosDaemon.SetIptablesChains:output_type -> google.protobuf.Empty
+	53, // 56: pb.ChaosDaemon.SetTimeOffset:output_type -> google.protobuf.Empty
+	53, // 57: pb.ChaosDaemon.RecoverTimeOffset:output_type -> google.protobuf.Empty
+	53, // 58: pb.ChaosDaemon.ContainerKill:output_type -> google.protobuf.Empty
+	7,  // 59: pb.ChaosDaemon.ContainerGetPid:output_type -> pb.ContainerResponse
+	26, // 60: pb.ChaosDaemon.ExecStressors:output_type -> pb.ExecStressResponse
+	53, // 61: pb.ChaosDaemon.CancelStressors:output_type -> google.protobuf.Empty
+	30, // 62: pb.ChaosDaemon.ApplyIOChaos:output_type -> pb.ApplyIOChaosResponse
+	32, // 63: pb.ChaosDaemon.ApplyHttpChaos:output_type -> pb.ApplyHttpChaosResponse
+	34, // 64: pb.ChaosDaemon.GetHttpChaosCounters:output_type -> pb.GetHttpChaosCountersResponse
+	44, // 65: pb.ChaosDaemon.ApplyBlockChaos:output_type -> pb.ApplyBlockChaosResponse
+	53, // 66: pb.ChaosDaemon.RecoverBlockChaos:output_type -> google.protobuf.Empty
+	53, // 67: pb.ChaosDaemon.SetDNSServer:output_type -> google.protobuf.Empty
+	53, // 68: pb.ChaosDaemon.InstallJVMRules:output_type -> google.protobuf.Empty
+	53, // 69: pb.ChaosDaemon.UninstallJVMRules:output_type -> google.protobuf.Empty
+	47, // 70: pb.ChaosDaemon.ApplyPodFault:output_type -> pb.ApplyPodFaultResponse
+	53, // 71: pb.ChaosDaemon.RecoverPodFault:output_type -> google.protobuf.Empty
+	50, // 72: pb.ChaosDaemon.Probe:output_type -> pb.ProbeResponse
+	53, // [53:73] is the sub-list for method output_type
+	33, // [33:53] is the sub-list for method input_type
+	33, // [33:33] is the sub-list for extension type_name
+	33, // [33:33] is the sub-list for extension extendee
+	0,  // [0:33] is the sub-list for field type_name
 }
 
 func init() { file_chaosdaemon_proto_init() }
@@ -3742,7 +4582,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyIOChaosRequest); i {
+			switch v := v.(*CgroupThrottle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3754,7 +4594,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyIOChaosResponse); i {
+			switch v := v.(*ApplyIOChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3766,7 +4606,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyHttpChaosRequest); i {
+			switch v := v.(*ApplyIOChaosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3778,7 +4618,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyHttpChaosResponse); i {
+			switch v := v.(*ApplyHttpChaosRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3790,7 +4630,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TcsRequest); i {
+			switch v := v.(*ApplyHttpChaosResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3802,7 +4642,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tc); i {
+			switch v := v.(*GetHttpChaosCountersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3814,7 +4654,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetDNSServerRequest); i {
+			switch v := v.(*GetHttpChaosCountersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3826,7 +4666,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InstallJVMRulesRequest); i {
+			switch v := v.(*HttpChaosRuleCounter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3838,7 +4678,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UninstallJVMRulesRequest); i {
+			switch v := v.(*TcsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3850,7 +4690,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyBlockChaosRequest); i {
+			switch v := v.(*Tc); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3862,7 +4702,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockDelaySpec); i {
+			switch v := v.(*SetDNSServerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3874,7 +4714,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockLimitSpec); i {
+			switch v := v.(*InstallJVMRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3886,7 +4726,7 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ApplyBlockChaosResponse); i {
+			switch v := v.(*UninstallJVMRulesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3898,6 +4738,54 @@ func file_chaosdaemon_proto_init() {
 			}
 		}
 		file_chaosdaemon_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBlockChaosRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockDelaySpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockLimitSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyBlockChaosResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RecoverBlockChaosRequest); i {
 			case 0:
 				return &v.state
@@ -3909,6 +4797,90 @@ func file_chaosdaemon_proto_init() {
 				return nil
 			}
 		}
+		file_chaosdaemon_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyPodFaultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyPodFaultResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverPodFaultRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostCapabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaosdaemon_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerCapabilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3916,7 +4888,7 @@ func file_chaosdaemon_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chaosdaemon_proto_rawDesc,
 			NumEnums:      5,
-			NumMessages:   37,
+			NumMessages:   48,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -3954,11 +4926,15 @@ type ChaosDaemonClient interface {
 	CancelStressors(ctx context.Context, in *CancelStressRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	ApplyIOChaos(ctx context.Context, in *ApplyIOChaosRequest, opts ...grpc.CallOption) (*ApplyIOChaosResponse, error)
 	ApplyHttpChaos(ctx context.Context, in *ApplyHttpChaosRequest, opts ...grpc.CallOption) (*ApplyHttpChaosResponse, error)
+	GetHttpChaosCounters(ctx context.Context, in *GetHttpChaosCountersRequest, opts ...grpc.CallOption) (*GetHttpChaosCountersResponse, error)
 	ApplyBlockChaos(ctx context.Context, in *ApplyBlockChaosRequest, opts ...grpc.CallOption) (*ApplyBlockChaosResponse, error)
 	RecoverBlockChaos(ctx context.Context, in *RecoverBlockChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	SetDNSServer(ctx context.Context, in *SetDNSServerRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	InstallJVMRules(ctx context.Context, in *InstallJVMRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	UninstallJVMRules(ctx context.Context, in *UninstallJVMRulesRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	ApplyPodFault(ctx context.Context, in *ApplyPodFaultRequest, opts ...grpc.CallOption) (*ApplyPodFaultResponse, error)
+	RecoverPodFault(ctx context.Context, in *RecoverPodFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Probe(ctx context.Context, in *ProbeRequest, opts ...grpc.CallOption) (*ProbeResponse, error)
 }
 
 type chaosDaemonClient struct {
@@ -4068,6 +5044,15 @@ func (c *chaosDaemonClient) ApplyHttpChaos(ctx context.Context, in *ApplyHttpCha
 	return out, nil
 }
 
+func (c *chaosDaemonClient) GetHttpChaosCounters(ctx context.Context, in *GetHttpChaosCountersRequest, opts ...grpc.CallOption) (*GetHttpChaosCountersResponse, error) {
+	out := new(GetHttpChaosCountersResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/GetHttpChaosCounters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *chaosDaemonClient) ApplyBlockChaos(ctx context.Context, in *ApplyBlockChaosRequest, opts ...grpc.CallOption) (*ApplyBlockChaosResponse, error) {
 	out := new(ApplyBlockChaosResponse)
 	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyBlockChaos", in, out, opts...)
@@ -4113,6 +5098,33 @@ func (c *chaosDaemonClient) UninstallJVMRules(ctx context.Context, in *Uninstall
 	return out, nil
 }
 
+func (c *chaosDaemonClient) ApplyPodFault(ctx context.Context, in *ApplyPodFaultRequest, opts ...grpc.CallOption) (*ApplyPodFaultResponse, error) {
+	out := new(ApplyPodFaultResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/ApplyPodFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) RecoverPodFault(ctx context.Context, in *RecoverPodFaultRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/RecoverPodFault", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chaosDaemonClient) Probe(ctx context.Context, in *ProbeRequest, opts ...grpc.CallOption) (*ProbeResponse, error) {
+	out := new(ProbeResponse)
+	err := c.cc.Invoke(ctx, "/pb.ChaosDaemon/Probe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChaosDaemonServer is the server API for ChaosDaemon service.
 type ChaosDaemonServer interface {
 	SetTcs(context.Context, *TcsRequest) (*empty.Empty, error)
@@ -4126,11 +5138,15 @@ type ChaosDaemonServer interface {
 	CancelStressors(context.Context, *CancelStressRequest) (*empty.Empty, error)
 	ApplyIOChaos(context.Context, *ApplyIOChaosRequest) (*ApplyIOChaosResponse, error)
 	ApplyHttpChaos(context.Context, *ApplyHttpChaosRequest) (*ApplyHttpChaosResponse, error)
+	GetHttpChaosCounters(context.Context, *GetHttpChaosCountersRequest) (*GetHttpChaosCountersResponse, error)
 	ApplyBlockChaos(context.Context, *ApplyBlockChaosRequest) (*ApplyBlockChaosResponse, error)
 	RecoverBlockChaos(context.Context, *RecoverBlockChaosRequest) (*empty.Empty, error)
 	SetDNSServer(context.Context, *SetDNSServerRequest) (*empty.Empty, error)
 	InstallJVMRules(context.Context, *InstallJVMRulesRequest) (*empty.Empty, error)
 	UninstallJVMRules(context.Context, *UninstallJVMRulesRequest) (*empty.Empty, error)
+	ApplyPodFault(context.Context, *ApplyPodFaultRequest) (*ApplyPodFaultResponse, error)
+	RecoverPodFault(context.Context, *RecoverPodFaultRequest) (*empty.Empty, error)
+	Probe(context.Context, *ProbeRequest) (*ProbeResponse, error)
 }
 
 // UnimplementedChaosDaemonServer can be embedded to have forward compatible implementations.
@@ -4170,6 +5186,9 @@ func (*UnimplementedChaosDaemonServer) ApplyIOChaos(context.Context, *ApplyIOCha
 func (*UnimplementedChaosDaemonServer) ApplyHttpChaos(context.Context, *ApplyHttpChaosRequest) (*ApplyHttpChaosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyHttpChaos not implemented")
 }
+func (*UnimplementedChaosDaemonServer) GetHttpChaosCounters(context.Context, *GetHttpChaosCountersRequest) (*GetHttpChaosCountersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHttpChaosCounters not implemented")
+}
 func (*UnimplementedChaosDaemonServer) ApplyBlockChaos(context.Context, *ApplyBlockChaosRequest) (*ApplyBlockChaosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyBlockChaos not implemented")
 }
@@ -4185,6 +5204,15 @@ func (*UnimplementedChaosDaemonServer) InstallJVMRules(context.Context, *Install
 func (*UnimplementedChaosDaemonServer) UninstallJVMRules(context.Context, *UninstallJVMRulesRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UninstallJVMRules not implemented")
 }
+func (*UnimplementedChaosDaemonServer) ApplyPodFault(context.Context, *ApplyPodFaultRequest) (*ApplyPodFaultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPodFault not implemented")
+}
+func (*UnimplementedChaosDaemonServer) RecoverPodFault(context.Context, *RecoverPodFaultRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverPodFault not implemented")
+}
+func (*UnimplementedChaosDaemonServer) Probe(context.Context, *ProbeRequest) (*ProbeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Probe not implemented")
+}
 
 func RegisterChaosDaemonServer(s *grpc.Server, srv ChaosDaemonServer) {
 	s.RegisterService(&_ChaosDaemon_serviceDesc, srv)
@@ -4388,6 +5416,24 @@ func _ChaosDaemon_ApplyHttpChaos_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaosDaemon_GetHttpChaosCounters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHttpChaosCountersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).GetHttpChaosCounters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/GetHttpChaosCounters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).GetHttpChaosCounters(ctx, req.(*GetHttpChaosCountersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ChaosDaemon_ApplyBlockChaos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ApplyBlockChaosRequest)
 	if err := dec(in); err != nil {
@@ -4478,6 +5524,60 @@ func _ChaosDaemon_UninstallJVMRules_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChaosDaemon_ApplyPodFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPodFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).ApplyPodFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/ApplyPodFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).ApplyPodFault(ctx, req.(*ApplyPodFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_RecoverPodFault_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverPodFaultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).RecoverPodFault(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/RecoverPodFault",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).RecoverPodFault(ctx, req.(*RecoverPodFaultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChaosDaemon_Probe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChaosDaemonServer).Probe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ChaosDaemon/Probe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChaosDaemonServer).Probe(ctx, req.(*ProbeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "pb.ChaosDaemon",
 	HandlerType: (*ChaosDaemonServer)(nil),
@@ -4526,6 +5626,10 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyHttpChaos",
 			Handler:    _ChaosDaemon_ApplyHttpChaos_Handler,
 		},
+		{
+			MethodName: "GetHttpChaosCounters",
+			Handler:    _ChaosDaemon_GetHttpChaosCounters_Handler,
+		},
 		{
 			MethodName: "ApplyBlockChaos",
 			Handler:    _ChaosDaemon_ApplyBlockChaos_Handler,
@@ -4546,6 +5650,18 @@ var _ChaosDaemon_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UninstallJVMRules",
 			Handler:    _ChaosDaemon_UninstallJVMRules_Handler,
 		},
+		{
+			MethodName: "ApplyPodFault",
+			Handler:    _ChaosDaemon_ApplyPodFault_Handler,
+		},
+		{
+			MethodName: "RecoverPodFault",
+			Handler:    _ChaosDaemon_RecoverPodFault_Handler,
+		},
+		{
+			MethodName: "Probe",
+			Handler:    _ChaosDaemon_Probe_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chaosdaemon.proto",
@@ -0,0 +1,84 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+func (s *DaemonServer) ApplyPodFault(ctx context.Context, req *pb.ApplyPodFaultRequest) (*pb.ApplyPodFaultResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("applying pod fault", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting PID")
+	}
+
+	state, err := execPodFault(ctx, pid, "apply", req.Action, req.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ApplyPodFaultResponse{State: state}, nil
+}
+
+func (s *DaemonServer) RecoverPodFault(ctx context.Context, req *pb.RecoverPodFaultRequest) (*empty.Empty, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("recovering pod fault", "request", req)
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting PID")
+	}
+
+	if _, err := execPodFault(ctx, pid, "recover", req.Action, req.Spec, req.State); err != nil {
+		return nil, err
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// execPodFault executes the pod-fault command of chaos-daemon-helper inside the namespaces of the
+// target process, and returns the trimmed output
+func execPodFault(ctx context.Context, pid uint32, args ...string) (string, error) {
+	args = append([]string{"pod-fault"}, args...)
+	output, err := bpm.DefaultProcessBuilder(chaosDaemonHelperCommand, args...).
+		SetContext(ctx).
+		SetNS(pid, bpm.MountNS).
+		SetNS(pid, bpm.PidNS).
+		SetNS(pid, bpm.NetNS).
+		EnableLocalMnt().
+		Build(ctx).
+		Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && len(exitErr.Stderr) > 0 {
+			return "", errors.Errorf("%s pod fault: %s", args[1], strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return "", errors.Wrapf(err, "%s pod fault", args[1])
+	}
+
+	return strings.Trim(string(output), "\n\x00"), nil
+}
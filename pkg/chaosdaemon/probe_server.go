@@ -0,0 +1,181 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/containerd"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/crio"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/crclients/docker"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+)
+
+// probedKernelModules are the kernel modules required by the network, IO and other chaos,
+// which may be missing on a minimal kernel
+var probedKernelModules = []string{"sch_netem", "sch_tbf", "ip_set", "xt_set", "fuse"}
+
+const (
+	capSysPtrace = 19
+	capSysAdmin  = 21
+	capBPF       = 39
+
+	// yamaPtraceNoAttach is the ptrace_scope of Yama which disables ptrace attach completely
+	yamaPtraceNoAttach = "3"
+)
+
+// Probe returns the capabilities of the host, and the ones of the container if the container id is set.
+func (s *DaemonServer) Probe(ctx context.Context, req *pb.ProbeRequest) (*pb.ProbeResponse, error) {
+	log := s.getLoggerFromContext(ctx)
+	log.Info("probing capabilities", "request", req)
+
+	host := &pb.HostCapabilities{
+		CgroupVersion: cgroupVersion(),
+		Runtime:       runtimeName(s.crClient),
+	}
+
+	var uname unix.Utsname
+	if err := unix.Uname(&uname); err == nil {
+		host.KernelRelease = unix.ByteSliceToString(uname.Release[:])
+	}
+
+	// chaos-daemon shares the pid namespace with the host, so that the file system of the host could be
+	// accessed through the root of the init process
+	moduleDir := filepath.Join(bpm.DefaultProcPrefix, "1/root/lib/modules", host.KernelRelease)
+	host.MissingKernelModules = missingKernelModules(probedKernelModules,
+		readFileOrEmpty(filepath.Join(bpm.DefaultProcPrefix, "modules")),
+		readFileOrEmpty(filepath.Join(moduleDir, "modules.builtin")),
+		readFileOrEmpty(filepath.Join(moduleDir, "modules.dep")))
+	host.Fuse = strings.Contains(readFileOrEmpty(filepath.Join(bpm.DefaultProcPrefix, "filesystems")), "\tfuse\n")
+	if !host.Fuse {
+		host.Fuse = !contains(host.MissingKernelModules, "fuse")
+	}
+
+	capEff, err := effectiveCapabilities(readFileOrEmpty(filepath.Join(bpm.DefaultProcPrefix, "self/status")))
+	if err != nil {
+		log.Error(err, "parse the capabilities of chaos-daemon")
+	}
+	host.Bpf = capEff&(1<<capBPF) != 0 || capEff&(1<<capSysAdmin) != 0
+	ptraceScope := strings.TrimSpace(readFileOrEmpty(filepath.Join(bpm.DefaultProcPrefix, "sys/kernel/yama/ptrace_scope")))
+	host.Ptrace = capEff&(1<<capSysPtrace) != 0 && ptraceScope != yamaPtraceNoAttach
+
+	resp := &pb.ProbeResponse{Host: host}
+	if req.ContainerId == "" {
+		return resp, nil
+	}
+
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.ContainerId)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting PID")
+	}
+	cgroupPath, err := containerCgroupPath(pid)
+	if err != nil {
+		return nil, err
+	}
+	resp.Container = &pb.ContainerCapabilities{
+		Pid:        pid,
+		CgroupPath: cgroupPath,
+	}
+
+	return resp, nil
+}
+
+func runtimeName(crClient crclients.ContainerRuntimeInfoClient) string {
+	switch crClient.(type) {
+	case *docker.DockerClient:
+		return crclients.ContainerRuntimeDocker
+	case *containerd.ContainerdClient:
+		return crclients.ContainerRuntimeContainerd
+	case *crio.CrioClient:
+		return crclients.ContainerRuntimeCrio
+	}
+	return ""
+}
+
+// missingKernelModules returns the modules which are neither loaded, built-in nor loadable. If the built-in
+// and loadable modules are unknown, no module is reported as missing, because it could be loaded on demand.
+func missingKernelModules(modules []string, loaded string, builtin string, loadable string) []string {
+	if len(builtin) == 0 && len(loadable) == 0 {
+		return nil
+	}
+
+	available := make(map[string]struct{})
+	scanner := bufio.NewScanner(strings.NewReader(loaded))
+	for scanner.Scan() {
+		// the format of /proc/modules is "<name> <size> <refcount> ..."
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			available[fields[0]] = struct{}{}
+		}
+	}
+	for _, content := range []string{builtin, loadable} {
+		scanner := bufio.NewScanner(strings.NewReader(content))
+		for scanner.Scan() {
+			// the format of modules.builtin and modules.dep is "kernel/net/sched/sch_netem.ko[.xz]: <deps>"
+			path, _, _ := strings.Cut(scanner.Text(), ":")
+			name := filepath.Base(strings.TrimSpace(path))
+			name, _, _ = strings.Cut(name, ".ko")
+			available[strings.ReplaceAll(name, "-", "_")] = struct{}{}
+		}
+	}
+
+	var missing []string
+	for _, module := range modules {
+		if _, ok := available[module]; !ok {
+			missing = append(missing, module)
+		}
+	}
+	return missing
+}
+
+// effectiveCapabilities parses the effective capabilities from the content of /proc/<pid>/status
+func effectiveCapabilities(status string) (uint64, error) {
+	scanner := bufio.NewScanner(strings.NewReader(status))
+	for scanner.Scan() {
+		value, ok := strings.CutPrefix(scanner.Text(), "CapEff:")
+		if !ok {
+			continue
+		}
+		return strconv.ParseUint(strings.TrimSpace(value), 16, 64)
+	}
+	return 0, errors.New("CapEff not found")
+}
+
+func readFileOrEmpty(path string) string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return string(content)
+}
+
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
+)
+
+// cgroupVersion returns the cgroup mode of the host, which is one of "v1", "v2" and "hybrid"
+func cgroupVersion() string {
+	return cgroups.ModeName(cgroups.HostMode())
+}
+
+// containerCgroupPath returns the cgroup of the process. For cgroup v1, the path of the memory
+// subsystem is returned.
+func containerCgroupPath(pid uint32) (string, error) {
+	attacher, err := cgroups.GetAttacherForPID(int(pid))
+	if err != nil {
+		return "", errors.Wrapf(err, "get cgroup of pid %d", pid)
+	}
+
+	path, err := attacher.TargetCGroup().Path()
+	if err != nil {
+		return "", errors.Wrapf(err, "get cgroup path of pid %d", pid)
+	}
+	return path, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaosdaemon
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("probe", func() {
+	Context("missingKernelModules", func() {
+		It("should report modules which are not available", func() {
+			loaded := "sch_netem 40960 1 - Live 0x0000000000000000\nip_set 57344 0 - Live 0x0000000000000000\n"
+			builtin := "kernel/fs/fuse/fuse.ko\n"
+			loadable := "kernel/net/sched/sch_tbf.ko.xz:\nkernel/net/netfilter/ipset/ip_set.ko.xz: kernel/net/netfilter/nfnetlink.ko.xz\n"
+
+			missing := missingKernelModules(probedKernelModules, loaded, builtin, loadable)
+			Expect(missing).To(Equal([]string{"xt_set"}))
+		})
+
+		It("should report nothing if the module directory is unreadable", func() {
+			missing := missingKernelModules(probedKernelModules, "sch_netem 40960 1 - Live 0x0000000000000000\n", "", "")
+			Expect(missing).To(BeEmpty())
+		})
+	})
+
+	Context("effectiveCapabilities", func() {
+		It("should parse CapEff", func() {
+			capEff, err := effectiveCapabilities("Name:\tchaos-daemon\nCapPrm:\t000001ffffffffff\nCapEff:\t00000000a80425fb\n")
+			Expect(err).To(BeNil())
+			Expect(capEff & (1 << capSysAdmin)).To(BeZero())
+			Expect(capEff & (1 << capSysPtrace)).To(BeZero())
+
+			capEff, err = effectiveCapabilities("CapEff:\t000001ffffffffff\n")
+			Expect(err).To(BeNil())
+			Expect(capEff & (1 << capBPF)).NotTo(BeZero())
+		})
+
+		It("should return error without CapEff", func() {
+			_, err := effectiveCapabilities("Name:\tchaos-daemon\n")
+			Expect(err).NotTo(BeNil())
+		})
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
 
 	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
 	"github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/cgroups"
@@ -35,21 +36,56 @@ func (s *DaemonServer) ExecStressors(ctx context.Context,
 	log := s.getLoggerFromContext(ctx)
 	log.Info("Executing stressors", "request", req)
 
+	resp := new(pb.ExecStressResponse)
+
+	// the effective cgroup of the container is reported, where the stresses are accounted and the throttle is applied
+	pid, err := s.crClient.GetPidFromContainerID(ctx, req.Target)
+	if err != nil {
+		return nil, err
+	}
+	cgroupPath, err := containerCgroupPath(pid)
+	if err != nil {
+		if req.Throttle != nil {
+			return nil, err
+		}
+		log.Error(err, "get cgroup path of the container", "containerID", req.Target)
+	} else {
+		resp.CgroupPath = cgroupPath
+		resp.CgroupDriver = cgroups.Driver(cgroupPath)
+	}
+
+	if req.Throttle != nil {
+		resp.OriginalThrottle, err = applyCgroupThrottle(pid, cgroupPath, req.Throttle)
+		if err != nil {
+			return nil, err
+		}
+		log.Info("Apply cgroup throttle successfully", "cgroup", cgroupPath, "original", resp.OriginalThrottle)
+	}
+	restoreThrottle := func() {
+		if resp.OriginalThrottle == nil {
+			return
+		}
+		if err := restoreCgroupThrottle(cgroupPath, resp.OriginalThrottle); err != nil {
+			log.Error(err, "restore cgroup throttle", "cgroup", cgroupPath)
+		}
+	}
+
 	// cpuStressors
 	cpuProc, err := s.ExecCPUStressors(ctx, req)
 	if err != nil {
 		// this error would be resolved by grpc server framework, it's the top level to print it.
 		s.rootLogger.Error(err, "exec cpu stressors", "containerID", req.Target, "cpuStressors", req.CpuStressors)
+		restoreThrottle()
 		return nil, err
 	}
 
 	// memoryStressor
 	memoryProc, err := s.ExecMemoryStressors(ctx, req)
 	if err != nil {
+		restoreThrottle()
 		return nil, err
 	}
 
-	resp := new(pb.ExecStressResponse)
 	if cpuProc != nil {
 		resp.CpuInstance = strconv.Itoa(cpuProc.Pair.Pid)
 		resp.CpuStartTime = cpuProc.Pair.CreateTime
@@ -91,20 +127,35 @@ func (s *DaemonServer) CancelStressors(ctx context.Context,
 
 	log.Info("Canceling stressors", "request", req)
 
+	// the throttle is restored even if the stressors fail to be killed, otherwise the container would be left
+	// throttled until the recovery is retried
+	var failed []string
 	if req.CpuInstanceUid != "" {
 		err = s.backgroundProcessManager.KillBackgroundProcess(ctx, req.CpuInstanceUid)
 		if err != nil {
-			return nil, err
+			failed = append(failed, errors.Wrap(err, "kill cpu stressors").Error())
 		}
 	}
 
 	if req.MemoryInstanceUid != "" {
 		err = s.backgroundProcessManager.KillBackgroundProcess(ctx, req.MemoryInstanceUid)
 		if err != nil {
-			return nil, err
+			failed = append(failed, errors.Wrap(err, "kill memory stressors").Error())
+		}
+	}
+
+	if req.OriginalThrottle != nil {
+		if err := restoreCgroupThrottle(req.CgroupPath, req.OriginalThrottle); err != nil {
+			failed = append(failed, err.Error())
+		} else {
+			log.Info("restore cgroup throttle successfully", "cgroup", req.CgroupPath)
 		}
 	}
 
+	if len(failed) > 0 {
+		return nil, errors.Errorf("cancel stressors: %s", strings.Join(failed, "; "))
+	}
+
 	log.Info("killing stressor successfully")
 	return &empty.Empty{}, nil
 }
@@ -21,10 +21,35 @@ import (
 
 type Config struct {
 	ProxyPorts []uint32               `json:"proxy_ports,omitempty"`
+	Egress     *EgressConfig          `json:"egress,omitempty"`
 	Rules      []PodHttpChaosBaseRule `json:"rules"`
 	TLS        *TLSConfig             `json:"tls,omitempty"`
 }
 
+// EgressConfig is the config to proxy the outbound traffic, which is redirected to the listen port
+// by iptables. The connections to the original destinations should be marked to skip the redirection.
+type EgressConfig struct {
+	ListenPort uint32   `json:"listen_port"`
+	Ports      []uint32 `json:"ports"`
+	Mark       uint32   `json:"mark"`
+}
+
+// Capabilities is reported by tproxy on `GET /capabilities`. The tproxy which doesn't serve it
+// supports none of the features.
+type Capabilities struct {
+	Version  string   `json:"version"`
+	Features []string `json:"features"`
+}
+
+const (
+	// FeatureEgress means tproxy listens on the egress port and marks its own upstream connections
+	FeatureEgress = "egress"
+	// FeatureRuleLimits means tproxy honours the percent, times and rate of rules
+	FeatureRuleLimits = "rule_limits"
+	// FeatureCounters means tproxy reports the counters of rules on `GET /counters`
+	FeatureCounters = "counters"
+)
+
 type TLSConfig struct {
 	CertFile TLSConfigItem  `json:"cert_file,omitempty"`
 	KeyFile  TLSConfigItem  `json:"key_file,omitempty"`
@@ -41,11 +66,41 @@ type PodHttpChaosBaseRule struct {
 	// Target is the object to be selected and injected, <Request|Response>.
 	Target PodHttpChaosTarget `json:"target"`
 
+	// Direction is the direction of the traffic to be injected, <Inbound|Outbound>.
+	Direction HTTPChaosDirection `json:"direction,omitempty"`
+
 	// Selector contains the rules to select target.
 	Selector PodHttpChaosSelector `json:"selector"`
 
 	// Actions contains rules to inject target.
 	Actions PodHttpChaosActions `json:"actions"`
+
+	// Percent is the percentage of the selected requests or responses to be injected, in [1, 100].
+	Percent *int32 `json:"percent,omitempty"`
+
+	// Times is the maximum times to inject.
+	Times *int64 `json:"times,omitempty"`
+
+	// Rate limits the injections with a token bucket.
+	Rate *PodHttpChaosRate `json:"rate,omitempty"`
+}
+
+// PodHttpChaosRate is a token bucket to limit the rate of injections.
+type PodHttpChaosRate struct {
+	// Limit is the number of injections allowed per second.
+	Limit int32 `json:"limit"`
+
+	// Burst is the maximum number of injections allowed at once.
+	Burst int32 `json:"burst,omitempty"`
+}
+
+// RuleCounter is the counter of a rule reported by tproxy, which is reset once the config is applied.
+type RuleCounter struct {
+	// Matched is the number of requests or responses selected by the rule.
+	Matched int64 `json:"matched"`
+
+	// Injected is the number of requests or responses injected by the rule.
+	Injected int64 `json:"injected"`
 }
 
 type PodHttpChaosSelector struct {
@@ -57,6 +112,10 @@ type PodHttpChaosSelector struct {
 	// +optional
 	Path *string `json:"path,omitempty"`
 
+	// Host is a rule to select target by the host in http request.
+	// +optional
+	Host *string `json:"host,omitempty"`
+
 	// Method is a rule to select target by http method in request.
 	// +optional
 	Method *string `json:"method,omitempty"`
@@ -203,3 +262,14 @@ type PodHttpChaosReplaceActions struct {
 
 // PodHttpChaosTarget represents the type of an HttpChaos Action
 type PodHttpChaosTarget string
+
+// HTTPChaosDirection represents the direction of the traffic to be injected
+type HTTPChaosDirection string
+
+const (
+	// Inbound represents the traffic received by the pod
+	Inbound HTTPChaosDirection = "Inbound"
+
+	// Outbound represents the traffic sent by the pod to its dependencies
+	Outbound HTTPChaosDirection = "Outbound"
+)
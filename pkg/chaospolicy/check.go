@@ -0,0 +1,298 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaospolicy
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/generic"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/pod"
+)
+
+// Checker checks whether a new chaos object violates the ChaosPolicies
+type Checker struct {
+	client client.Client
+	reader client.Reader
+
+	generic.Option
+}
+
+// NewChecker returns a new Checker
+func NewChecker(c client.Client, reader client.Reader, option generic.Option) *Checker {
+	return &Checker{
+		client: c,
+		reader: reader,
+		Option: option,
+	}
+}
+
+// Check returns the violations of the chaos object against all the policies governing it
+func (c *Checker) Check(ctx context.Context, kind string, obj v1alpha1.InnerObject, now time.Time) (field.ErrorList, error) {
+	matcher := newNamespaceMatcher(c.reader)
+
+	var policies v1alpha1.ChaosPolicyList
+	if err := c.reader.List(ctx, &policies); err != nil {
+		return nil, err
+	}
+
+	allErrs := field.ErrorList{}
+	namespaces := Namespaces(obj)
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		governed, err := matcher.governs(ctx, policy, namespaces)
+		if err != nil {
+			return nil, err
+		}
+		if len(governed) == 0 {
+			continue
+		}
+
+		errs, err := c.checkPolicy(ctx, matcher, policy, governed, kind, obj, now)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	return allErrs, nil
+}
+
+func (c *Checker) checkPolicy(ctx context.Context, matcher *namespaceMatcher, policy *v1alpha1.ChaosPolicy, governed []string,
+	kind string, obj v1alpha1.InnerObject, now time.Time) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	action := specAction(obj)
+	if !policy.Spec.Allows(kind, action) {
+		if action == "" {
+			allErrs = append(allErrs, field.Forbidden(specPath,
+				fmt.Sprintf("%s is not allowed by ChaosPolicy %s", kind, policy.Name)))
+		} else {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("action"),
+				fmt.Sprintf("%s with action %s is not allowed by ChaosPolicy %s", kind, action, policy.Name)))
+		}
+	}
+
+	for _, window := range policy.Spec.BlackoutWindows {
+		until, err := window.ActiveUntil(now)
+		if err != nil {
+			return nil, err
+		}
+		if until != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath,
+				fmt.Sprintf("namespace %v is in the blackout window of ChaosPolicy %s until %s",
+					governed, policy.Name, until.Format(time.RFC3339))))
+			break
+		}
+	}
+
+	if policy.Spec.MaxDuration != nil {
+		errs, err := checkDuration(policy, obj, specPath.Child("duration"))
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	if policy.Spec.MaxConcurrent != nil {
+		running, err := countRunning(ctx, c.reader, matcher, policy, obj)
+		if err != nil {
+			return nil, err
+		}
+		if running >= *policy.Spec.MaxConcurrent {
+			allErrs = append(allErrs, field.Forbidden(specPath,
+				fmt.Sprintf("there are already %d running experiments governed by ChaosPolicy %s, which allows at most %d",
+					running, policy.Name, *policy.Spec.MaxConcurrent)))
+		}
+	}
+
+	if policy.Spec.MaxPodsPercent != nil {
+		errs, err := c.checkPodsPercent(ctx, policy, governed, obj)
+		if err != nil {
+			return nil, err
+		}
+		allErrs = append(allErrs, errs...)
+	}
+
+	return allErrs, nil
+}
+
+func checkDuration(policy *v1alpha1.ChaosPolicy, obj v1alpha1.InnerObject, path *field.Path) (field.ErrorList, error) {
+	maxDuration, err := time.ParseDuration(*policy.Spec.MaxDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	spec, ok := specOf(obj).(v1alpha1.ContainsDuration)
+	if !ok {
+		return nil, nil
+	}
+	duration, err := spec.GetDuration()
+	if err != nil {
+		return nil, err
+	}
+
+	if duration == nil && !obj.IsOneShot() {
+		return field.ErrorList{field.Forbidden(path,
+			fmt.Sprintf("the duration is required by ChaosPolicy %s, which allows at most %s", policy.Name, maxDuration))}, nil
+	}
+	if duration != nil && *duration > maxDuration {
+		return field.ErrorList{field.Invalid(path, duration.String(),
+			fmt.Sprintf("exceeds the maximum duration %s of ChaosPolicy %s", maxDuration, policy.Name))}, nil
+	}
+
+	return nil, nil
+}
+
+// ConcurrencyExceeded returns the first policy whose MaxConcurrent has been reached by the other experiments, or
+// nil if the chaos could start running. It's checked before a stopped chaos starts again, e.g. once the blackout
+// window ends, as the stopped chaos is not counted as running.
+func ConcurrencyExceeded(ctx context.Context, reader client.Reader, policies []v1alpha1.ChaosPolicy, obj v1alpha1.InnerObject) (*v1alpha1.ChaosPolicy, error) {
+	matcher := newNamespaceMatcher(reader)
+	for i := range policies {
+		policy := &policies[i]
+		if policy.Spec.MaxConcurrent == nil {
+			continue
+		}
+
+		running, err := countRunning(ctx, reader, matcher, policy, obj)
+		if err != nil {
+			return nil, err
+		}
+		if running >= *policy.Spec.MaxConcurrent {
+			return policy, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// countRunning counts the experiments governed by the policy, which are not stopped
+func countRunning(ctx context.Context, reader client.Reader, matcher *namespaceMatcher, policy *v1alpha1.ChaosPolicy, obj v1alpha1.InnerObject) (int, error) {
+	running := 0
+	for _, kind := range v1alpha1.AllKinds() {
+		list := kind.SpawnList()
+		if err := reader.List(ctx, list); err != nil {
+			return 0, err
+		}
+
+		for _, item := range list.GetItems() {
+			chaos, ok := item.(v1alpha1.InnerObject)
+			if !ok {
+				continue
+			}
+			if chaos.GetUID() == obj.GetUID() && chaos.GetUID() != "" {
+				continue
+			}
+			if chaos.IsDeleted() || chaos.GetStatus().Experiment.DesiredPhase == v1alpha1.StoppedPhase {
+				continue
+			}
+
+			governed, err := matcher.governs(ctx, policy, Namespaces(chaos))
+			if err != nil {
+				return 0, err
+			}
+			if len(governed) > 0 {
+				running++
+			}
+		}
+	}
+
+	return running, nil
+}
+
+// checkPodsPercent checks the maximum number of pods that could be selected in
+// each governed namespace, against all the pods in that namespace.
+func (c *Checker) checkPodsPercent(ctx context.Context, policy *v1alpha1.ChaosPolicy, governed []string, obj v1alpha1.InnerObject) (field.ErrorList, error) {
+	maxPercent := *policy.Spec.MaxPodsPercent
+	isGoverned := make(map[string]bool)
+	for _, ns := range governed {
+		isGoverned[ns] = true
+	}
+
+	allErrs := field.ErrorList{}
+	for _, selector := range podSelectors(obj) {
+		candidates, err := pod.SelectPods(ctx, c.client, c.reader, selector.Selector, c.ClusterScoped, c.TargetNamespace, c.EnableFilterNamespace)
+		if err != nil {
+			return nil, err
+		}
+		maxSelected, err := generic.MaxObjectsByMode(selector.Mode, selector.Value, len(candidates))
+		if err != nil {
+			return nil, err
+		}
+
+		candidatesInNamespace := make(map[string]int)
+		for _, candidate := range candidates {
+			candidatesInNamespace[candidate.Namespace]++
+		}
+
+		for ns, count := range candidatesInNamespace {
+			if !isGoverned[ns] {
+				continue
+			}
+
+			var pods v1.PodList
+			if err := c.reader.List(ctx, &pods, client.InNamespace(ns)); err != nil {
+				return nil, err
+			}
+
+			affected := count
+			if maxSelected < affected {
+				affected = maxSelected
+			}
+			if affected*100 > maxPercent*len(pods.Items) {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "selector"),
+					fmt.Sprintf("up to %d of %d pods in namespace %s could be affected, exceeds %d%% allowed by ChaosPolicy %s",
+						affected, len(pods.Items), ns, maxPercent, policy.Name)))
+			}
+		}
+	}
+
+	return allErrs, nil
+}
+
+func specOf(obj v1alpha1.InnerObject) interface{} {
+	spec := reflect.Indirect(reflect.ValueOf(obj)).FieldByName("Spec")
+	if !spec.IsValid() {
+		return nil
+	}
+
+	return spec.Addr().Interface()
+}
+
+// specAction returns the action of the chaos, or an empty string if the kind
+// of chaos doesn't have actions.
+func specAction(obj v1alpha1.InnerObject) string {
+	spec := reflect.Indirect(reflect.ValueOf(obj)).FieldByName("Spec")
+	if !spec.IsValid() {
+		return ""
+	}
+
+	action := spec.FieldByName("Action")
+	if !action.IsValid() || action.Kind() != reflect.String {
+		return ""
+	}
+
+	return action.String()
+}
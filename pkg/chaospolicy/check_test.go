@@ -0,0 +1,220 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaospolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubectl/pkg/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/generic"
+	. "github.com/chaos-mesh/chaos-mesh/pkg/testutils"
+)
+
+func newNetworkChaos(name string, namespace string, mode v1alpha1.SelectorMode, value string, duration string) *v1alpha1.NetworkChaos {
+	return &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action: v1alpha1.DelayAction,
+			PodSelector: v1alpha1.PodSelector{
+				Selector: v1alpha1.PodSelectorSpec{
+					GenericSelectorSpec: v1alpha1.GenericSelectorSpec{
+						Namespaces: []string{namespace},
+					},
+				},
+				Mode:  mode,
+				Value: value,
+			},
+			Duration: &duration,
+		},
+	}
+}
+
+func TestBlackoutWindow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	now := time.Date(2026, 1, 1, 10, 30, 0, 0, time.UTC)
+	policies := []v1alpha1.ChaosPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "daily"},
+			Spec: v1alpha1.ChaosPolicySpec{
+				BlackoutWindows: []v1alpha1.BlackoutWindow{
+					{Schedule: "0 10 * * *", Duration: "1h"},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "later"},
+			Spec: v1alpha1.ChaosPolicySpec{
+				BlackoutWindows: []v1alpha1.BlackoutWindow{
+					{Schedule: "0 12 * * *", Duration: "1h"},
+				},
+			},
+		},
+	}
+
+	blackout, err := ActiveBlackout(policies, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(blackout).ToNot(BeNil())
+	g.Expect(blackout.Policy).To(Equal("daily"))
+	g.Expect(blackout.Until).To(Equal(time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC)))
+
+	blackout, err = ActiveBlackout(policies, now.Add(time.Hour))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(blackout).To(BeNil())
+
+	next, err := NextBlackout(policies, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(*next).To(Equal(time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)))
+}
+
+func TestCheck(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	err := v1alpha1.SchemeBuilder.AddToScheme(scheme.Scheme)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	maxConcurrent := 1
+	maxDuration := "1h"
+	maxPodsPercent := 50
+	objects, _ := GenerateNPods("p", 4, PodArg{Namespace: "prod", Labels: map[string]string{"app": "web"}})
+	objects = append(objects,
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}},
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+		&v1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-policy"},
+			Spec: v1alpha1.ChaosPolicySpec{
+				NamespaceSelectors: map[string]string{"env": "prod"},
+				Rules: []v1alpha1.ChaosPolicyRule{
+					{Kind: v1alpha1.KindNetworkChaos, Actions: []string{string(v1alpha1.DelayAction)}},
+				},
+				MaxConcurrent:  &maxConcurrent,
+				MaxDuration:    &maxDuration,
+				MaxPodsPercent: &maxPodsPercent,
+			},
+		},
+	)
+
+	newChecker := func(objects ...runtime.Object) *Checker {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithRuntimeObjects(objects...).
+			Build()
+		return NewChecker(c, c, generic.Option{ClusterScoped: true})
+	}
+	now := time.Now()
+
+	type TestCase struct {
+		name    string
+		objects []runtime.Object
+		chaos   *v1alpha1.NetworkChaos
+		errs    int
+	}
+
+	tcs := []TestCase{
+		{
+			name:  "allowed",
+			chaos: newNetworkChaos("allowed", "prod", v1alpha1.FixedMode, "2", "30m"),
+			errs:  0,
+		},
+		{
+			name:  "not governed",
+			chaos: newNetworkChaos("not-governed", "dev", v1alpha1.AllMode, "", "2h"),
+			errs:  0,
+		},
+		{
+			name:  "duration exceeded",
+			chaos: newNetworkChaos("too-long", "prod", v1alpha1.OneMode, "", "2h"),
+			errs:  1,
+		},
+		{
+			name:  "too many pods",
+			chaos: newNetworkChaos("too-many-pods", "prod", v1alpha1.AllMode, "", "30m"),
+			errs:  1,
+		},
+		{
+			name:    "too many experiments",
+			objects: []runtime.Object{newNetworkChaos("running", "prod", v1alpha1.OneMode, "", "30m")},
+			chaos:   newNetworkChaos("concurrent", "prod", v1alpha1.OneMode, "", "30m"),
+			errs:    1,
+		},
+	}
+
+	for _, tc := range tcs {
+		checker := newChecker(append(objects, tc.objects...)...)
+		errs, err := checker.Check(context.Background(), v1alpha1.KindNetworkChaos, tc.chaos, now)
+		g.Expect(err).ToNot(HaveOccurred(), tc.name)
+		g.Expect(errs).To(HaveLen(tc.errs), tc.name)
+	}
+
+	loss := newNetworkChaos("loss", "prod", v1alpha1.OneMode, "", "30m")
+	loss.Spec.Action = v1alpha1.LossAction
+	errs, err := newChecker(objects...).Check(context.Background(), v1alpha1.KindNetworkChaos, loss, now)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(errs).To(HaveLen(1))
+	g.Expect(errs[0].Error()).To(ContainSubstring("NetworkChaos with action loss is not allowed by ChaosPolicy prod-policy"))
+}
+
+func TestConcurrencyExceeded(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	err := v1alpha1.SchemeBuilder.AddToScheme(scheme.Scheme)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	maxConcurrent := 1
+	policies := []v1alpha1.ChaosPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "unlimited"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-policy"},
+			Spec: v1alpha1.ChaosPolicySpec{
+				NamespaceSelectors: map[string]string{"env": "prod"},
+				MaxConcurrent:      &maxConcurrent,
+			},
+		},
+	}
+	prod := &v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}}
+
+	stopped := newNetworkChaos("stopped", "prod", v1alpha1.OneMode, "", "30m")
+	stopped.UID = "stopped"
+	stopped.Status.Experiment.DesiredPhase = v1alpha1.StoppedPhase
+	running := newNetworkChaos("running", "prod", v1alpha1.OneMode, "", "30m")
+	running.UID = "running"
+	running.Status.Experiment.DesiredPhase = v1alpha1.RunningPhase
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(prod, stopped).Build()
+	policy, err := ConcurrencyExceeded(context.Background(), c, policies, stopped)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).To(BeNil())
+
+	c = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithRuntimeObjects(prod, stopped, running).Build()
+	policy, err = ConcurrencyExceeded(context.Background(), c, policies, stopped)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(policy).ToNot(BeNil())
+	g.Expect(policy.Name).To(Equal("prod-policy"))
+}
@@ -0,0 +1,203 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package chaospolicy
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/genericwebhook"
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// Blackout describes an active blackout window
+type Blackout struct {
+	// Policy is the name of the ChaosPolicy which declares the window
+	Policy string
+	// Until is the end of the window
+	Until time.Time
+}
+
+// Namespaces returns the namespace of the chaos object itself and all the
+// namespaces targeted by its pod selectors.
+func Namespaces(obj client.Object) []string {
+	namespaces := []string{obj.GetNamespace()}
+	seen := map[string]struct{}{obj.GetNamespace(): {}}
+	add := func(namespace string) {
+		if _, ok := seen[namespace]; ok {
+			return
+		}
+		seen[namespace] = struct{}{}
+		namespaces = append(namespaces, namespace)
+	}
+
+	for _, selector := range podSelectors(obj) {
+		for _, ns := range selector.Selector.Namespaces {
+			add(ns)
+		}
+		for ns := range selector.Selector.Pods {
+			add(ns)
+		}
+	}
+
+	return namespaces
+}
+
+func podSelectors(obj client.Object) []*v1alpha1.PodSelector {
+	var selectors []*v1alpha1.PodSelector
+
+	walker := genericwebhook.NewFieldWalker(obj, func(path *field.Path, obj interface{}, field *reflect.StructField) bool {
+		if field != nil && (field.Name == "Status" || field.Name == "TypeMeta" || field.Name == "ObjectMeta") {
+			return false
+		}
+
+		if selector, ok := obj.(*v1alpha1.PodSelector); ok && selector != nil {
+			selectors = append(selectors, selector)
+		}
+		return true
+	})
+	walker.Walk()
+
+	return selectors
+}
+
+// namespaceMatcher matches policies against namespaces, and caches the labels
+// of namespaces which are required by the NamespaceSelectors.
+type namespaceMatcher struct {
+	reader client.Reader
+	labels map[string]map[string]string
+}
+
+func newNamespaceMatcher(reader client.Reader) *namespaceMatcher {
+	return &namespaceMatcher{
+		reader: reader,
+		labels: make(map[string]map[string]string),
+	}
+}
+
+func (m *namespaceMatcher) namespaceLabels(ctx context.Context, namespace string) (map[string]string, error) {
+	if labels, ok := m.labels[namespace]; ok {
+		return labels, nil
+	}
+
+	var ns v1.Namespace
+	err := m.reader.Get(ctx, types.NamespacedName{Name: namespace}, &ns)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+	m.labels[namespace] = ns.Labels
+
+	return ns.Labels, nil
+}
+
+// governs returns the namespaces governed by the policy
+func (m *namespaceMatcher) governs(ctx context.Context, policy *v1alpha1.ChaosPolicy, namespaces []string) ([]string, error) {
+	var governed []string
+	for _, namespace := range namespaces {
+		var labels map[string]string
+		if len(policy.Spec.NamespaceSelectors) > 0 {
+			var err error
+			labels, err = m.namespaceLabels(ctx, namespace)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if policy.Spec.Matches(namespace, labels) {
+			governed = append(governed, namespace)
+		}
+	}
+
+	return governed, nil
+}
+
+// Governing returns the policies which govern any of the namespaces
+func Governing(ctx context.Context, reader client.Reader, namespaces []string) ([]v1alpha1.ChaosPolicy, error) {
+	var policies v1alpha1.ChaosPolicyList
+	if err := reader.List(ctx, &policies); err != nil {
+		return nil, err
+	}
+
+	matcher := newNamespaceMatcher(reader)
+	var governing []v1alpha1.ChaosPolicy
+	for _, policy := range policies.Items {
+		governed, err := matcher.governs(ctx, &policy, namespaces)
+		if err != nil {
+			return nil, err
+		}
+		if len(governed) > 0 {
+			governing = append(governing, policy)
+		}
+	}
+
+	return governing, nil
+}
+
+// ActiveBlackout returns the blackout window which ends the latest among the
+// active windows of the policies, or nil if there isn't any active window.
+func ActiveBlackout(policies []v1alpha1.ChaosPolicy, now time.Time) (*Blackout, error) {
+	var blackout *Blackout
+	for _, policy := range policies {
+		for _, window := range policy.Spec.BlackoutWindows {
+			until, err := window.ActiveUntil(now)
+			if err != nil {
+				return nil, err
+			}
+			if until == nil {
+				continue
+			}
+
+			if blackout == nil || until.After(blackout.Until) {
+				blackout = &Blackout{
+					Policy: policy.Name,
+					Until:  *until,
+				}
+			}
+		}
+	}
+
+	return blackout, nil
+}
+
+// NextBlackout returns the earliest beginning of the blackout windows after now,
+// or nil if there isn't any upcoming window.
+func NextBlackout(policies []v1alpha1.ChaosPolicy, now time.Time) (*time.Time, error) {
+	var next *time.Time
+	for _, policy := range policies {
+		for _, window := range policy.Spec.BlackoutWindows {
+			begin, err := window.NextBegin(now)
+			if err != nil {
+				return nil, err
+			}
+			if begin.IsZero() {
+				continue
+			}
+
+			if next == nil || begin.Before(*next) {
+				next = &begin
+			}
+		}
+	}
+
+	return next, nil
+}
@@ -100,6 +100,11 @@ type ChaosControllerConfig struct {
 
 	// ChaosdSecurityMode is used for enable mTLS connection between chaos-controller-manager and chaod
 	ChaosdSecurityMode bool `envconfig:"CHAOSD_SECURITY_MODE" default:"true" json:"chaosd_security_mode"`
+	// PhysicalMachineProbeInterval is the interval to probe chaosd on the physical machines
+	PhysicalMachineProbeInterval time.Duration `envconfig:"PHYSICAL_MACHINE_PROBE_INTERVAL" default:"30s"`
+	// PhysicalMachineOrphanGracePeriod is the minimum age of the attacks in chaosd without PhysicalMachineChaos
+	// to be recovered, zero disables the recovery
+	PhysicalMachineOrphanGracePeriod time.Duration `envconfig:"PHYSICAL_MACHINE_ORPHAN_GRACE_PERIOD" default:"5m"`
 
 	// Namespace is the namespace which the controller manager run in
 	Namespace string `envconfig:"NAMESPACE" default:""`
@@ -0,0 +1,86 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package client
+
+import (
+	"context"
+
+	"github.com/hasura/go-graphql-client"
+	"github.com/pkg/errors"
+)
+
+func (c *CtrlClient) RecoverTimeChaos(ctx context.Context, namespace, name, chaosNamespace, chaosName string) ([]string, error) {
+	var mutation struct {
+		Pod struct {
+			RecoverTimeChaos []string `graphql:"recoverTimeChaos(ns: $chaosNs, name: $chaosName)"`
+		} `graphql:"pod(ns: $ns, name: $name)"`
+	}
+
+	err := c.QueryClient.Mutate(ctx, &mutation, map[string]interface{}{
+		"chaosNs":   graphql.String(chaosNamespace),
+		"chaosName": graphql.String(chaosName),
+		"ns":        graphql.String(namespace),
+		"name":      graphql.String(name),
+	})
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "recover timechaos %s/%s", chaosNamespace, chaosName)
+	}
+
+	return mutation.Pod.RecoverTimeChaos, nil
+}
+
+func (c *CtrlClient) RecoverBlockChaos(ctx context.Context, namespace, name, chaosNamespace, chaosName string) ([]string, error) {
+	var mutation struct {
+		Pod struct {
+			RecoverBlockChaos []string `graphql:"recoverBlockChaos(ns: $chaosNs, name: $chaosName)"`
+		} `graphql:"pod(ns: $ns, name: $name)"`
+	}
+
+	err := c.QueryClient.Mutate(ctx, &mutation, map[string]interface{}{
+		"chaosNs":   graphql.String(chaosNamespace),
+		"chaosName": graphql.String(chaosName),
+		"ns":        graphql.String(namespace),
+		"name":      graphql.String(name),
+	})
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "recover blockchaos %s/%s", chaosNamespace, chaosName)
+	}
+
+	return mutation.Pod.RecoverBlockChaos, nil
+}
+
+func (c *CtrlClient) RecoverKernelChaos(ctx context.Context, namespace, name, chaosNamespace, chaosName string) ([]string, error) {
+	var mutation struct {
+		Pod struct {
+			RecoverKernelChaos []string `graphql:"recoverKernelChaos(ns: $chaosNs, name: $chaosName)"`
+		} `graphql:"pod(ns: $ns, name: $name)"`
+	}
+
+	err := c.QueryClient.Mutate(ctx, &mutation, map[string]interface{}{
+		"chaosNs":   graphql.String(chaosNamespace),
+		"chaosName": graphql.String(chaosName),
+		"ns":        graphql.String(namespace),
+		"name":      graphql.String(name),
+	})
+
+	if err != nil {
+		return nil, errors.Wrapf(err, "recover kernelchaos %s/%s", chaosNamespace, chaosName)
+	}
+
+	return mutation.Pod.RecoverKernelChaos, nil
+}
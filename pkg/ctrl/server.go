@@ -20,6 +20,7 @@ import (
 	"github.com/go-logr/logr"
 	"go.uber.org/fx"
 	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/chaosdaemon"
@@ -35,6 +36,7 @@ type ServerParams struct {
 	Client              client.Client
 	Clientset           *kubernetes.Clientset
 	DaemonClientBuilder *chaosdaemon.ChaosDaemonClientBuilder
+	Manager             ctrl.Manager
 }
 
 func New(param ServerParams) *handler.Server {
@@ -44,6 +46,7 @@ func New(param ServerParams) *handler.Server {
 		Client:        param.Client,
 		Clientset:     param.Clientset,
 		NoCacheReader: param.NoCacheReader,
+		Cache:         param.Manager.GetCache(),
 	}
 	return handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolvers}))
 }
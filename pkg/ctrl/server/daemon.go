@@ -27,7 +27,7 @@ import (
 )
 
 type DaemonHelper struct {
-	Builder *chaosdaemon.ChaosDaemonClientBuilder
+	Builder chaosdaemon.ChaosDaemonClientBuilderInterface
 }
 
 // GetPidFromPod returns pid given containerd ID in pod
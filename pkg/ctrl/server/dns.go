@@ -0,0 +1,56 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+)
+
+const (
+	// resolvConf is the resolver configuration modified by DNSChaos
+	resolvConf = "/etc/resolv.conf"
+
+	// resolvConfBackup is the backup of resolvConf created by chaos-daemon before DNSChaos is injected
+	resolvConfBackup = resolvConf + ".chaos.bak"
+)
+
+// GetResolvConf returns the content of /etc/resolv.conf in the target pod
+func (r *Resolver) GetResolvConf(ctx context.Context, pod *v1.Pod) (string, error) {
+	cmd := "cat " + resolvConf
+	out, err := r.ExecBypass(ctx, pod, cmd, bpm.PidNS, bpm.MountNS)
+	if err != nil {
+		return "", errors.Wrapf(err, "run command %s failed", cmd)
+	}
+	return out, nil
+}
+
+// restoreResolvConf restores /etc/resolv.conf from the backup created by chaos-daemon,
+// it returns false if there is no backup in the target pod
+func (r *Resolver) restoreResolvConf(ctx context.Context, pod *v1.Pod) (bool, error) {
+	cmd := fmt.Sprintf("sh -c 'if [ -f %s ]; then cat %s > %s && echo restored; fi'", resolvConfBackup, resolvConfBackup, resolvConf)
+	out, err := r.ExecBypass(ctx, pod, cmd, bpm.PidNS, bpm.MountNS)
+	if err != nil {
+		return false, errors.Wrapf(err, "run command %s", cmd)
+	}
+	return strings.TrimSpace(out) == "restored", nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/chaos-mesh/chaos-mesh/pkg/bpm"
+)
+
+const (
+	// bmListCommand lists all byteman rules loaded by the agent listening on the port
+	bmListCommand = "bmsubmit.sh -p %d -l"
+	// bmUnloadCommand unloads all byteman rules loaded by the agent listening on the port
+	bmUnloadCommand = "bmsubmit.sh -p %d -u"
+)
+
+// noJVMRules reports whether the output of bmsubmit means there is no rule to operate,
+// either because all rules are removed or because the agent is not attached
+func noJVMRules(output string) bool {
+	return strings.Contains(output, "No rule scripts to remove") || strings.Contains(output, "Connection refused")
+}
+
+// GetJVMRules returns the byteman rules loaded in the target pod
+func (r *Resolver) GetJVMRules(ctx context.Context, pod *v1.Pod, port int32) (string, error) {
+	cmd := fmt.Sprintf(bmListCommand, port)
+	out, err := r.ExecBypass(ctx, pod, cmd, bpm.NetNS)
+	if err != nil {
+		if noJVMRules(err.Error()) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "run command %s failed", cmd)
+	}
+	return out, nil
+}
+
+// uninstallJVMRules unloads all byteman rules in the target pod,
+// it returns false if there is no rule to unload
+func (r *Resolver) uninstallJVMRules(ctx context.Context, pod *v1.Pod, port int32) (bool, error) {
+	cmd := fmt.Sprintf(bmUnloadCommand, port)
+	if _, err := r.ExecBypass(ctx, pod, cmd, bpm.NetNS); err != nil {
+		if noJVMRules(err.Error()) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "run command %s", cmd)
+	}
+	return true, nil
+}
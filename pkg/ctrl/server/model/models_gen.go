@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
 
@@ -27,6 +28,15 @@ type CgroupsMemory struct {
 	Limit int64 `json:"limit"`
 }
 
+type ChaosStatusEvent struct {
+	Time      time.Time             `json:"time"`
+	Kind      string                `json:"kind"`
+	Namespace string                `json:"namespace"`
+	Name      string                `json:"name"`
+	Deleted   bool                  `json:"deleted"`
+	Status    *v1alpha1.ChaosStatus `json:"status"`
+}
+
 type Fd struct {
 	Fd     string `json:"fd"`
 	Target string `json:"target"`
@@ -41,10 +51,15 @@ type Logger struct {
 }
 
 type MutablePod struct {
-	Pod           *v1.Pod              `json:"pod"`
-	KillProcesses []*KillProcessResult `json:"killProcesses,omitempty"`
-	CleanTcs      []string             `json:"cleanTcs,omitempty"`
-	CleanIptables []string             `json:"cleanIptables,omitempty"`
+	Pod                *v1.Pod              `json:"pod"`
+	KillProcesses      []*KillProcessResult `json:"killProcesses,omitempty"`
+	CleanTcs           []string             `json:"cleanTcs,omitempty"`
+	CleanIptables      []string             `json:"cleanIptables,omitempty"`
+	RestoreResolvConf  bool                 `json:"restoreResolvConf"`
+	UninstallJVMRules  bool                 `json:"uninstallJVMRules"`
+	RecoverTimeChaos   []string             `json:"recoverTimeChaos,omitempty"`
+	RecoverBlockChaos  []string             `json:"recoverBlockChaos,omitempty"`
+	RecoverKernelChaos []string             `json:"recoverKernelChaos,omitempty"`
 }
 
 type Mutation struct {
@@ -61,6 +76,30 @@ type Namespace struct {
 	Podhttpchaos    []*v1alpha1.PodHttpChaos    `json:"podhttpchaos,omitempty"`
 	Networkchaos    []*v1alpha1.NetworkChaos    `json:"networkchaos,omitempty"`
 	Podnetworkchaos []*v1alpha1.PodNetworkChaos `json:"podnetworkchaos,omitempty"`
+	Timechaos       []*v1alpha1.TimeChaos       `json:"timechaos,omitempty"`
+	Dnschaos        []*v1alpha1.DNSChaos        `json:"dnschaos,omitempty"`
+	Jvmchaos        []*v1alpha1.JVMChaos        `json:"jvmchaos,omitempty"`
+	Blockchaos      []*v1alpha1.BlockChaos      `json:"blockchaos,omitempty"`
+	Kernelchaos     []*v1alpha1.KernelChaos     `json:"kernelchaos,omitempty"`
+	Podchaos        []*v1alpha1.PodChaos        `json:"podchaos,omitempty"`
+	Schedule        []*v1alpha1.Schedule        `json:"schedule,omitempty"`
+	Workflow        []*v1alpha1.Workflow        `json:"workflow,omitempty"`
+	Workflownode    []*v1alpha1.WorkflowNode    `json:"workflownode,omitempty"`
+	Statuscheck     []*v1alpha1.StatusCheck     `json:"statuscheck,omitempty"`
+}
+
+type PodJVMChaos struct {
+	JvmChaos *v1alpha1.JVMChaos `json:"jvmChaos"`
+	Pod      *v1.Pod            `json:"pod"`
+	Rules    string             `json:"rules"`
+}
+
+type PodNetworkState struct {
+	Time     time.Time `json:"time"`
+	Pod      *v1.Pod   `json:"pod"`
+	TcQdisc  []string  `json:"tcQdisc,omitempty"`
+	Iptables []string  `json:"iptables,omitempty"`
+	Ipset    string    `json:"ipset"`
 }
 
 type PodSelectorInput struct {
@@ -96,6 +135,12 @@ type ProcessStress struct {
 type Query struct {
 }
 
+type WorkflowNodeEvent struct {
+	Time    time.Time              `json:"time"`
+	Deleted bool                   `json:"deleted"`
+	Node    *v1alpha1.WorkflowNode `json:"node"`
+}
+
 type Component string
 
 const (
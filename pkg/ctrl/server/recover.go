@@ -0,0 +1,210 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/chaosimpl/utils"
+	ctrlconfig "github.com/chaos-mesh/chaos-mesh/controllers/config"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/controller"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	pb_kernel "github.com/chaos-mesh/chaos-mesh/pkg/chaoskernel/pb"
+	grpcUtils "github.com/chaos-mesh/chaos-mesh/pkg/grpc"
+)
+
+// onPod reports whether the record id refers to the pod or to one of its containers
+func onPod(id string, pod *v1.Pod) bool {
+	podID := pod.Namespace + "/" + pod.Name
+	return id == podID || strings.HasPrefix(id, podID+"/")
+}
+
+// injectedContainers returns the names of containers in the pod which are still injected by the chaos
+func injectedContainers(status *v1alpha1.ChaosStatus, pod *v1.Pod) ([]string, error) {
+	var containers []string
+	for _, record := range status.Experiment.Records {
+		if record.Phase != v1alpha1.Injected || !onPod(record.Id, pod) {
+			continue
+		}
+		_, container, err := controller.ParseNamespacedNameContainer(record.Id)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse record %s", record.Id)
+		}
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
+
+// containerID returns the id of the container in the pod
+func containerID(pod *v1.Pod, name string) (string, error) {
+	for _, container := range pod.Status.ContainerStatuses {
+		if container.Name == name {
+			return container.ContainerID, nil
+		}
+	}
+	return "", errors.Wrapf(utils.ErrContainerNotFound, "container %s of pod %s/%s", name, pod.Namespace, pod.Name)
+}
+
+// recoverTimeChaos recovers the time offset injected by the chaos, and returns actually recovered containers
+func (r *Resolver) recoverTimeChaos(ctx context.Context, pod *v1.Pod, ns, name string) ([]string, error) {
+	chaos := new(v1alpha1.TimeChaos)
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, chaos); err != nil {
+		return nil, err
+	}
+
+	containers, err := injectedContainers(&chaos.Status.ChaosStatus, pod)
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, nil
+	}
+
+	daemonClient, err := r.Builder.Build(ctx, pod, &types.NamespacedName{Namespace: ns, Name: name})
+	if err != nil {
+		return nil, errors.Wrapf(err, "create chaos daemon client of pod(%s/%s)", pod.Namespace, pod.Name)
+	}
+	defer daemonClient.Close()
+
+	var recovered []string
+	for _, container := range containers {
+		id, err := containerID(pod, container)
+		if err != nil {
+			return recovered, err
+		}
+		_, err = daemonClient.RecoverTimeOffset(ctx, &pb.TimeRequest{
+			ContainerId:      id,
+			Uid:              string(chaos.UID) + string(pod.UID),
+			PodContainerName: fmt.Sprintf("%s:%s", pod.UID, container),
+		})
+		if err != nil {
+			return recovered, errors.Wrapf(err, "recover time offset of container %s", container)
+		}
+		recovered = append(recovered, container)
+	}
+	return recovered, nil
+}
+
+// recoverBlockChaos recovers the block injections of the chaos, and returns actually recovered records
+func (r *Resolver) recoverBlockChaos(ctx context.Context, pod *v1.Pod, ns, name string) ([]string, error) {
+	chaos := new(v1alpha1.BlockChaos)
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, chaos); err != nil {
+		return nil, err
+	}
+
+	var records []string
+	for record := range chaos.Status.InjectionIds {
+		if onPod(record, pod) {
+			records = append(records, record)
+		}
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	daemonClient, err := r.Builder.Build(ctx, pod, &types.NamespacedName{Namespace: ns, Name: name})
+	if err != nil {
+		return nil, errors.Wrapf(err, "create chaos daemon client of pod(%s/%s)", pod.Namespace, pod.Name)
+	}
+	defer daemonClient.Close()
+
+	var recovered []string
+	for _, record := range records {
+		_, err = daemonClient.RecoverBlockChaos(ctx, &pb.RecoverBlockChaosRequest{
+			InjectionId: int32(chaos.Status.InjectionIds[record]),
+		})
+		if err != nil {
+			return recovered, errors.Wrapf(err, "recover block chaos %s", record)
+		}
+		recovered = append(recovered, record)
+	}
+	return recovered, nil
+}
+
+// recoverKernelChaos recovers the kernel fault injected by the chaos, and returns actually recovered containers
+func (r *Resolver) recoverKernelChaos(ctx context.Context, pod *v1.Pod, ns, name string) ([]string, error) {
+	chaos := new(v1alpha1.KernelChaos)
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: ns, Name: name}, chaos); err != nil {
+		return nil, err
+	}
+
+	containers, err := injectedContainers(&chaos.Status.ChaosStatus, pod)
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, nil
+	}
+
+	daemonClient, err := r.Builder.Build(ctx, pod, &types.NamespacedName{Namespace: ns, Name: name})
+	if err != nil {
+		return nil, errors.Wrapf(err, "create chaos daemon client of pod(%s/%s)", pod.Namespace, pod.Name)
+	}
+	defer daemonClient.Close()
+
+	daemonIP, err := r.Builder.FindDaemonIP(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpcUtils.Builder(daemonIP, ctrlconfig.ControllerCfg.BPFKIPort).WithDefaultTimeout().Insecure().Build()
+	if err != nil {
+		return nil, errors.Wrap(err, "create bpfki connection")
+	}
+	defer conn.Close()
+	bpfClient := pb_kernel.NewBPFKIServiceClient(conn)
+
+	var callchain []*pb_kernel.FailKernRequestFrame
+	for _, frame := range chaos.Spec.FailKernRequest.Callchain {
+		callchain = append(callchain, &pb_kernel.FailKernRequestFrame{
+			Funcname:   frame.Funcname,
+			Parameters: frame.Parameters,
+			Predicate:  frame.Predicate,
+		})
+	}
+
+	var recovered []string
+	for _, container := range containers {
+		id, err := containerID(pod, container)
+		if err != nil {
+			return recovered, err
+		}
+		res, err := daemonClient.ContainerGetPid(ctx, &pb.ContainerRequest{
+			Action: &pb.ContainerAction{
+				Action: pb.ContainerAction_GETPID,
+			},
+			ContainerId: id,
+		})
+		if err != nil {
+			return recovered, errors.Wrapf(err, "get pid of container %s", container)
+		}
+		_, err = bpfClient.RecoverMMOrBIO(ctx, &pb_kernel.FailKernRequest{
+			Pid:       res.Pid,
+			Callchain: callchain,
+		})
+		if err != nil {
+			return recovered, errors.Wrapf(err, "recover kernel fault of container %s", container)
+		}
+		recovered = append(recovered, container)
+	}
+	return recovered, nil
+}
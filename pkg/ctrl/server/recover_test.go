@@ -0,0 +1,188 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package server
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/go-logr/logr"
+	"github.com/golang/protobuf/ptypes/empty"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/test"
+	chaosdaemonclient "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/client"
+	pb "github.com/chaos-mesh/chaos-mesh/pkg/chaosdaemon/pb"
+	ctrlclient "github.com/chaos-mesh/chaos-mesh/pkg/ctrl/client"
+	"github.com/chaos-mesh/chaos-mesh/pkg/ctrl/server/generated"
+)
+
+// recoverDaemonClient records the recover requests sent to the chaos daemon
+type recoverDaemonClient struct {
+	test.MockChaosDaemonClient
+	timeRequests  []*pb.TimeRequest
+	blockRequests []*pb.RecoverBlockChaosRequest
+}
+
+func (c *recoverDaemonClient) RecoverTimeOffset(ctx context.Context, in *pb.TimeRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.timeRequests = append(c.timeRequests, in)
+	return &empty.Empty{}, nil
+}
+
+func (c *recoverDaemonClient) RecoverBlockChaos(ctx context.Context, in *pb.RecoverBlockChaosRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	c.blockRequests = append(c.blockRequests, in)
+	return &empty.Empty{}, nil
+}
+
+// recoverDaemonBuilder builds the same client for all pods
+type recoverDaemonBuilder struct {
+	client *recoverDaemonClient
+}
+
+func (b *recoverDaemonBuilder) Build(ctx context.Context, pod *v1.Pod, id *types.NamespacedName) (chaosdaemonclient.ChaosDaemonClientInterface, error) {
+	return b.client, nil
+}
+
+func (b *recoverDaemonBuilder) FindDaemonIP(ctx context.Context, pod *v1.Pod) (string, error) {
+	return "", errors.New("no chaos daemon in test")
+}
+
+func injectedRecords(phases map[string]v1alpha1.Phase) v1alpha1.ChaosStatus {
+	var status v1alpha1.ChaosStatus
+	for id, phase := range phases {
+		status.Experiment.Records = append(status.Experiment.Records, &v1alpha1.Record{Id: id, Phase: phase})
+	}
+	return status
+}
+
+// newRecoverClient serves the resolver with the objects, and returns the client of it
+func newRecoverClient(t *testing.T, daemon *recoverDaemonClient, objs ...client.Object) *ctrlclient.CtrlClient {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	resolver := &Resolver{
+		DaemonHelper: &DaemonHelper{Builder: &recoverDaemonBuilder{client: daemon}},
+		Log:          logr.Discard(),
+		Client:       fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+	}
+	srv := httptest.NewServer(handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: resolver})))
+	t.Cleanup(srv.Close)
+	return ctrlclient.NewCtrlClient(srv.URL)
+}
+
+func TestRecoverChaos(t *testing.T) {
+	RegisterTestingT(t)
+	ctx := context.Background()
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod", UID: "pod-uid"},
+		Status: v1.PodStatus{
+			ContainerStatuses: []v1.ContainerStatus{
+				{Name: "c1", ContainerID: "containerd://c1"},
+				{Name: "c2", ContainerID: "containerd://c2"},
+			},
+		},
+	}
+	timeChaos := &v1alpha1.TimeChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "chaos", Name: "time", UID: "time-uid"},
+		Status: v1alpha1.TimeChaosStatus{ChaosStatus: injectedRecords(map[string]v1alpha1.Phase{
+			"default/pod/c1":   v1alpha1.Injected,
+			"default/pod/c2":   v1alpha1.NotInjected,
+			"default/other/c1": v1alpha1.Injected,
+		})},
+	}
+	blockChaos := &v1alpha1.BlockChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "chaos", Name: "block"},
+		Status: v1alpha1.BlockChaosStatus{
+			InjectionIds: map[string]int{"default/pod/c1": 3, "default/other/c1": 4},
+		},
+	}
+	kernelChaos := &v1alpha1.KernelChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "chaos", Name: "kernel"},
+		Status: v1alpha1.KernelChaosStatus{ChaosStatus: injectedRecords(map[string]v1alpha1.Phase{
+			"default/pod/c1": v1alpha1.NotInjected,
+		})},
+	}
+	daemon := &recoverDaemonClient{}
+	c := newRecoverClient(t, daemon, pod, timeChaos, blockChaos, kernelChaos)
+
+	recovered, err := c.RecoverTimeChaos(ctx, "default", "pod", "chaos", "time")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(recovered).To(Equal([]string{"c1"}))
+	Expect(daemon.timeRequests).To(HaveLen(1))
+	Expect(daemon.timeRequests[0].ContainerId).To(Equal("containerd://c1"))
+	Expect(daemon.timeRequests[0].Uid).To(Equal("time-uidpod-uid"))
+	Expect(daemon.timeRequests[0].PodContainerName).To(Equal("pod-uid:c1"))
+
+	recovered, err = c.RecoverBlockChaos(ctx, "default", "pod", "chaos", "block")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(recovered).To(Equal([]string{"default/pod/c1"}))
+	Expect(daemon.blockRequests).To(HaveLen(1))
+	Expect(daemon.blockRequests[0].InjectionId).To(BeEquivalentTo(3))
+
+	// the kernel fault is not injected, so the bpfki server is not connected
+	recovered, err = c.RecoverKernelChaos(ctx, "default", "pod", "chaos", "kernel")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(recovered).To(BeEmpty())
+
+	_, err = c.RecoverTimeChaos(ctx, "default", "pod", "chaos", "missing")
+	Expect(err).To(HaveOccurred())
+	_, err = c.RecoverBlockChaos(ctx, "default", "missing", "chaos", "block")
+	Expect(err).To(HaveOccurred())
+	Expect(daemon.timeRequests).To(HaveLen(1))
+	Expect(daemon.blockRequests).To(HaveLen(1))
+}
+
+func TestInjectedContainers(t *testing.T) {
+	RegisterTestingT(t)
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod"}}
+
+	status := injectedRecords(map[string]v1alpha1.Phase{
+		"default/pod/c1":  v1alpha1.Injected,
+		"default/pod/c2":  v1alpha1.NotInjected,
+		"default/pod-1/c": v1alpha1.Injected,
+	})
+	containers, err := injectedContainers(&status, pod)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(containers).To(Equal([]string{"c1"}))
+
+	Expect(onPod("default/pod", pod)).To(BeTrue())
+	Expect(onPod("default/pod/c1", pod)).To(BeTrue())
+	Expect(onPod("default/pod-1/c", pod)).To(BeFalse())
+
+	status = injectedRecords(map[string]v1alpha1.Phase{"default/pod": v1alpha1.Injected})
+	_, err = injectedContainers(&status, pod)
+	Expect(err).To(HaveOccurred())
+
+	_, err = containerID(pod, "c1")
+	Expect(err).To(HaveOccurred())
+}
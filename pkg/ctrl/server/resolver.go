@@ -24,6 +24,7 @@ package server
 import (
 	"github.com/go-logr/logr"
 	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -33,4 +34,5 @@ type Resolver struct {
 	Client        client.Client
 	Clientset     *kubernetes.Clientset
 	NoCacheReader client.Reader
+	Cache         cache.Cache
 }
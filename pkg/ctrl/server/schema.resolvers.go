@@ -133,6 +133,72 @@ func (r *bandwidthSpecResolver) Minburst(ctx context.Context, obj *v1alpha1.Band
 	return &value, nil
 }
 
+// UID is the resolver for the uid field.
+func (r *blockChaosResolver) UID(ctx context.Context, obj *v1alpha1.BlockChaos) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *blockChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.BlockChaos) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *blockChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.BlockChaos) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *blockChaosResolver) Labels(ctx context.Context, obj *v1alpha1.BlockChaos) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *blockChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.BlockChaos) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Pods is the resolver for the pods field.
+func (r *blockChaosResolver) Pods(ctx context.Context, obj *v1alpha1.BlockChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
+// Action is the resolver for the action field.
+func (r *blockChaosSpecResolver) Action(ctx context.Context, obj *v1alpha1.BlockChaosSpec) (string, error) {
+	return string(obj.Action), nil
+}
+
+// Mode is the resolver for the mode field.
+func (r *blockChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.BlockChaosSpec) (string, error) {
+	return string(obj.Mode), nil
+}
+
+// Ids is the resolver for the ids field.
+func (r *blockChaosStatusResolver) Ids(ctx context.Context, obj *v1alpha1.BlockChaosStatus) (map[string]any, error) {
+	ids := make(map[string]interface{})
+	for k, v := range obj.InjectionIds {
+		ids[k] = v
+	}
+	return ids, nil
+}
+
 // Type is the resolver for the type field.
 func (r *chaosConditionResolver) Type(ctx context.Context, obj *v1alpha1.ChaosCondition) (string, error) {
 	return string(obj.Type), nil
@@ -163,11 +229,83 @@ func (r *containerStateTerminatedResolver) FinishedAt(ctx context.Context, obj *
 	return &obj.FinishedAt.Time, nil
 }
 
+// UID is the resolver for the uid field.
+func (r *dNSChaosResolver) UID(ctx context.Context, obj *v1alpha1.DNSChaos) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *dNSChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.DNSChaos) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *dNSChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.DNSChaos) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *dNSChaosResolver) Labels(ctx context.Context, obj *v1alpha1.DNSChaos) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *dNSChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.DNSChaos) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Pods is the resolver for the pods field.
+func (r *dNSChaosResolver) Pods(ctx context.Context, obj *v1alpha1.DNSChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
+// Action is the resolver for the action field.
+func (r *dNSChaosSpecResolver) Action(ctx context.Context, obj *v1alpha1.DNSChaosSpec) (string, error) {
+	return string(obj.Action), nil
+}
+
+// Mode is the resolver for the mode field.
+func (r *dNSChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.DNSChaosSpec) (string, error) {
+	return string(obj.Mode), nil
+}
+
+// Patterns is the resolver for the patterns field.
+func (r *dNSChaosSpecResolver) Patterns(ctx context.Context, obj *v1alpha1.DNSChaosSpec) ([]string, error) {
+	return obj.DomainNamePatterns, nil
+}
+
 // DesiredPhase is the resolver for the desiredPhase field.
 func (r *experimentStatusResolver) DesiredPhase(ctx context.Context, obj *v1alpha1.ExperimentStatus) (string, error) {
 	return string(obj.DesiredPhase), nil
 }
 
+// Probability is the resolver for the probability field.
+func (r *failKernRequestResolver) Probability(ctx context.Context, obj *v1alpha1.FailKernRequest) (int, error) {
+	return int(obj.Probability), nil
+}
+
+// Times is the resolver for the times field.
+func (r *failKernRequestResolver) Times(ctx context.Context, obj *v1alpha1.FailKernRequest) (int, error) {
+	return int(obj.Times), nil
+}
+
 // UID is the resolver for the uid field.
 func (r *hTTPChaosResolver) UID(ctx context.Context, obj *v1alpha1.HTTPChaos) (string, error) {
 	return string(obj.UID), nil
@@ -214,6 +352,20 @@ func (r *hTTPChaosResolver) Podhttp(ctx context.Context, obj *v1alpha1.HTTPChaos
 	return podhttps, nil
 }
 
+// Pods is the resolver for the pods field.
+func (r *hTTPChaosResolver) Pods(ctx context.Context, obj *v1alpha1.HTTPChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
 // Mode is the resolver for the mode field.
 func (r *hTTPChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.HTTPChaosSpec) (string, error) {
 	return string(obj.Mode), nil
@@ -251,6 +403,11 @@ func (r *hTTPChaosStatusResolver) Instances(ctx context.Context, obj *v1alpha1.H
 	return instances, nil
 }
 
+// Method is the resolver for the method field.
+func (r *hTTPStatusCheckResolver) Method(ctx context.Context, obj *v1alpha1.HTTPStatusCheck) (string, error) {
+	return string(obj.RequestMethod), nil
+}
+
 // UID is the resolver for the uid field.
 func (r *iOChaosResolver) UID(ctx context.Context, obj *v1alpha1.IOChaos) (string, error) {
 	return string(obj.UID), nil
@@ -297,6 +454,20 @@ func (r *iOChaosResolver) Podios(ctx context.Context, obj *v1alpha1.IOChaos) ([]
 	return podios, nil
 }
 
+// Pods is the resolver for the pods field.
+func (r *iOChaosResolver) Pods(ctx context.Context, obj *v1alpha1.IOChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
 // Type is the resolver for the type field.
 func (r *iOChaosActionResolver) Type(ctx context.Context, obj *v1alpha1.IOChaosAction) (string, error) {
 	return string(obj.Type), nil
@@ -480,6 +651,129 @@ func (r *ioFaultResolver) Errno(ctx context.Context, obj *v1alpha1.IoFault) (int
 	return int(obj.Errno), nil
 }
 
+// UID is the resolver for the uid field.
+func (r *jVMChaosResolver) UID(ctx context.Context, obj *v1alpha1.JVMChaos) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *jVMChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.JVMChaos) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *jVMChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.JVMChaos) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *jVMChaosResolver) Labels(ctx context.Context, obj *v1alpha1.JVMChaos) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *jVMChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.JVMChaos) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Podjvm is the resolver for the podjvm field.
+func (r *jVMChaosResolver) Podjvm(ctx context.Context, obj *v1alpha1.JVMChaos) ([]*model.PodJVMChaos, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var podJVM []*model.PodJVMChaos
+	for _, pod := range pods {
+		p := pod
+		podJVM = append(podJVM, &model.PodJVMChaos{
+			JvmChaos: obj,
+			Pod:      &p,
+		})
+	}
+	return podJVM, nil
+}
+
+// Mode is the resolver for the mode field.
+func (r *jVMChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.JVMChaosSpec) (string, error) {
+	return string(obj.Mode), nil
+}
+
+// Action is the resolver for the action field.
+func (r *jVMChaosSpecResolver) Action(ctx context.Context, obj *v1alpha1.JVMChaosSpec) (string, error) {
+	return string(obj.Action), nil
+}
+
+// Exception is the resolver for the exception field.
+func (r *jVMChaosSpecResolver) Exception(ctx context.Context, obj *v1alpha1.JVMChaosSpec) (string, error) {
+	return obj.ThrowException, nil
+}
+
+// Latency is the resolver for the latency field.
+func (r *jVMChaosSpecResolver) Latency(ctx context.Context, obj *v1alpha1.JVMChaosSpec) (int, error) {
+	return obj.LatencyDuration, nil
+}
+
+// UID is the resolver for the uid field.
+func (r *kernelChaosResolver) UID(ctx context.Context, obj *v1alpha1.KernelChaos) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *kernelChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.KernelChaos) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *kernelChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.KernelChaos) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *kernelChaosResolver) Labels(ctx context.Context, obj *v1alpha1.KernelChaos) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *kernelChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.KernelChaos) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Pods is the resolver for the pods field.
+func (r *kernelChaosResolver) Pods(ctx context.Context, obj *v1alpha1.KernelChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
+// Mode is the resolver for the mode field.
+func (r *kernelChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.KernelChaosSpec) (string, error) {
+	return string(obj.Mode), nil
+}
+
 // Component is the resolver for the component field.
 func (r *loggerResolver) Component(ctx context.Context, ns string, component model.Component) (<-chan string, error) {
 	var list v1.PodList
@@ -522,6 +816,21 @@ func (r *loggerResolver) Pod(ctx context.Context, ns string, name string) (<-cha
 	return logChan, nil
 }
 
+// ChaosStatus is the resolver for the chaosStatus field.
+func (r *loggerResolver) ChaosStatus(ctx context.Context, ns string, kind string, name string) (<-chan *model.ChaosStatusEvent, error) {
+	return r.Resolver.watchChaosStatus(ctx, ns, kind, name)
+}
+
+// WorkflowNode is the resolver for the workflowNode field.
+func (r *loggerResolver) WorkflowNode(ctx context.Context, ns string, workflow string) (<-chan *model.WorkflowNodeEvent, error) {
+	return r.Resolver.watchWorkflowNodes(ctx, ns, workflow)
+}
+
+// PodNetworkState is the resolver for the podNetworkState field.
+func (r *loggerResolver) PodNetworkState(ctx context.Context, ns string, name string, interval int) (<-chan *model.PodNetworkState, error) {
+	return r.Resolver.watchPodNetworkState(ctx, ns, name, time.Duration(interval)*time.Second)
+}
+
 // Filling is the resolver for the filling field.
 func (r *mistakeSpecResolver) Filling(ctx context.Context, obj *v1alpha1.MistakeSpec) (*string, error) {
 	filling := string(obj.Filling)
@@ -543,6 +852,31 @@ func (r *mutablePodResolver) CleanIptables(ctx context.Context, obj *model.Mutab
 	return r.Resolver.cleanIptables(ctx, obj.Pod, chains)
 }
 
+// RestoreResolvConf is the resolver for the restoreResolvConf field.
+func (r *mutablePodResolver) RestoreResolvConf(ctx context.Context, obj *model.MutablePod) (bool, error) {
+	return r.Resolver.restoreResolvConf(ctx, obj.Pod)
+}
+
+// UninstallJVMRules is the resolver for the uninstallJVMRules field.
+func (r *mutablePodResolver) UninstallJVMRules(ctx context.Context, obj *model.MutablePod, port int) (bool, error) {
+	return r.Resolver.uninstallJVMRules(ctx, obj.Pod, int32(port))
+}
+
+// RecoverTimeChaos is the resolver for the recoverTimeChaos field.
+func (r *mutablePodResolver) RecoverTimeChaos(ctx context.Context, obj *model.MutablePod, ns string, name string) ([]string, error) {
+	return r.Resolver.recoverTimeChaos(ctx, obj.Pod, ns, name)
+}
+
+// RecoverBlockChaos is the resolver for the recoverBlockChaos field.
+func (r *mutablePodResolver) RecoverBlockChaos(ctx context.Context, obj *model.MutablePod, ns string, name string) ([]string, error) {
+	return r.Resolver.recoverBlockChaos(ctx, obj.Pod, ns, name)
+}
+
+// RecoverKernelChaos is the resolver for the recoverKernelChaos field.
+func (r *mutablePodResolver) RecoverKernelChaos(ctx context.Context, obj *model.MutablePod, ns string, name string) ([]string, error) {
+	return r.Resolver.recoverKernelChaos(ctx, obj.Pod, ns, name)
+}
+
 // Pod is the resolver for the pod field.
 func (r *mutationResolver) Pod(ctx context.Context, ns string, name string) (*model.MutablePod, error) {
 	key := types.NamespacedName{Namespace: ns, Name: name}
@@ -759,34 +1093,274 @@ func (r *namespaceResolver) Podnetworkchaos(ctx context.Context, obj *model.Name
 	return []*v1alpha1.PodNetworkChaos{network}, nil
 }
 
-// UID is the resolver for the uid field.
-func (r *networkChaosResolver) UID(ctx context.Context, obj *v1alpha1.NetworkChaos) (string, error) {
-	return string(obj.UID), nil
-}
+// Timechaos is the resolver for the timechaos field.
+func (r *namespaceResolver) Timechaos(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.TimeChaos, error) {
+	if name == nil {
+		var timeList v1alpha1.TimeChaosList
+		var times []*v1alpha1.TimeChaos
+		if err := r.Client.List(ctx, &timeList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
 
-// CreationTimestamp is the resolver for the creationTimestamp field.
-func (r *networkChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.NetworkChaos) (*time.Time, error) {
-	return &obj.CreationTimestamp.Time, nil
-}
+		for i := range timeList.Items {
+			times = append(times, &timeList.Items[i])
+		}
 
-// DeletionTimestamp is the resolver for the deletionTimestamp field.
-func (r *networkChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.NetworkChaos) (*time.Time, error) {
-	return &obj.DeletionTimestamp.Time, nil
-}
+		return times, nil
+	}
 
-// Labels is the resolver for the labels field.
-func (r *networkChaosResolver) Labels(ctx context.Context, obj *v1alpha1.NetworkChaos) (map[string]any, error) {
-	labels := make(map[string]interface{})
-	for k, v := range obj.Labels {
-		labels[k] = v
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	timeChaos := new(v1alpha1.TimeChaos)
+	if err := r.Client.Get(ctx, key, timeChaos); err != nil {
+		return nil, err
 	}
-	return labels, nil
+	return []*v1alpha1.TimeChaos{timeChaos}, nil
 }
 
-// Annotations is the resolver for the annotations field.
-func (r *networkChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.NetworkChaos) (map[string]any, error) {
-	annotations := make(map[string]interface{})
-	for k, v := range obj.Annotations {
+// Dnschaos is the resolver for the dnschaos field.
+func (r *namespaceResolver) Dnschaos(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.DNSChaos, error) {
+	if name == nil {
+		var dnsList v1alpha1.DNSChaosList
+		var dnss []*v1alpha1.DNSChaos
+		if err := r.Client.List(ctx, &dnsList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range dnsList.Items {
+			dnss = append(dnss, &dnsList.Items[i])
+		}
+
+		return dnss, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	dns := new(v1alpha1.DNSChaos)
+	if err := r.Client.Get(ctx, key, dns); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.DNSChaos{dns}, nil
+}
+
+// Jvmchaos is the resolver for the jvmchaos field.
+func (r *namespaceResolver) Jvmchaos(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.JVMChaos, error) {
+	if name == nil {
+		var jvmList v1alpha1.JVMChaosList
+		var jvms []*v1alpha1.JVMChaos
+		if err := r.Client.List(ctx, &jvmList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range jvmList.Items {
+			jvms = append(jvms, &jvmList.Items[i])
+		}
+
+		return jvms, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	jvm := new(v1alpha1.JVMChaos)
+	if err := r.Client.Get(ctx, key, jvm); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.JVMChaos{jvm}, nil
+}
+
+// Blockchaos is the resolver for the blockchaos field.
+func (r *namespaceResolver) Blockchaos(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.BlockChaos, error) {
+	if name == nil {
+		var blockList v1alpha1.BlockChaosList
+		var blocks []*v1alpha1.BlockChaos
+		if err := r.Client.List(ctx, &blockList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range blockList.Items {
+			blocks = append(blocks, &blockList.Items[i])
+		}
+
+		return blocks, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	block := new(v1alpha1.BlockChaos)
+	if err := r.Client.Get(ctx, key, block); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.BlockChaos{block}, nil
+}
+
+// Kernelchaos is the resolver for the kernelchaos field.
+func (r *namespaceResolver) Kernelchaos(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.KernelChaos, error) {
+	if name == nil {
+		var kernelList v1alpha1.KernelChaosList
+		var kernels []*v1alpha1.KernelChaos
+		if err := r.Client.List(ctx, &kernelList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range kernelList.Items {
+			kernels = append(kernels, &kernelList.Items[i])
+		}
+
+		return kernels, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	kernel := new(v1alpha1.KernelChaos)
+	if err := r.Client.Get(ctx, key, kernel); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.KernelChaos{kernel}, nil
+}
+
+// Podchaos is the resolver for the podchaos field.
+func (r *namespaceResolver) Podchaos(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.PodChaos, error) {
+	if name == nil {
+		var podList v1alpha1.PodChaosList
+		var pods []*v1alpha1.PodChaos
+		if err := r.Client.List(ctx, &podList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range podList.Items {
+			pods = append(pods, &podList.Items[i])
+		}
+
+		return pods, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	pod := new(v1alpha1.PodChaos)
+	if err := r.Client.Get(ctx, key, pod); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.PodChaos{pod}, nil
+}
+
+// Schedule is the resolver for the schedule field.
+func (r *namespaceResolver) Schedule(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.Schedule, error) {
+	if name == nil {
+		var scheduleList v1alpha1.ScheduleList
+		var schedules []*v1alpha1.Schedule
+		if err := r.Client.List(ctx, &scheduleList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range scheduleList.Items {
+			schedules = append(schedules, &scheduleList.Items[i])
+		}
+
+		return schedules, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	schedule := new(v1alpha1.Schedule)
+	if err := r.Client.Get(ctx, key, schedule); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.Schedule{schedule}, nil
+}
+
+// Workflow is the resolver for the workflow field.
+func (r *namespaceResolver) Workflow(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.Workflow, error) {
+	if name == nil {
+		var workflowList v1alpha1.WorkflowList
+		var workflows []*v1alpha1.Workflow
+		if err := r.Client.List(ctx, &workflowList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range workflowList.Items {
+			workflows = append(workflows, &workflowList.Items[i])
+		}
+
+		return workflows, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	workflow := new(v1alpha1.Workflow)
+	if err := r.Client.Get(ctx, key, workflow); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.Workflow{workflow}, nil
+}
+
+// Workflownode is the resolver for the workflownode field.
+func (r *namespaceResolver) Workflownode(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.WorkflowNode, error) {
+	if name == nil {
+		var nodeList v1alpha1.WorkflowNodeList
+		var nodes []*v1alpha1.WorkflowNode
+		if err := r.Client.List(ctx, &nodeList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range nodeList.Items {
+			nodes = append(nodes, &nodeList.Items[i])
+		}
+
+		return nodes, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	node := new(v1alpha1.WorkflowNode)
+	if err := r.Client.Get(ctx, key, node); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.WorkflowNode{node}, nil
+}
+
+// Statuscheck is the resolver for the statuscheck field.
+func (r *namespaceResolver) Statuscheck(ctx context.Context, obj *model.Namespace, name *string) ([]*v1alpha1.StatusCheck, error) {
+	if name == nil {
+		var statusCheckList v1alpha1.StatusCheckList
+		var statusChecks []*v1alpha1.StatusCheck
+		if err := r.Client.List(ctx, &statusCheckList, &client.ListOptions{Namespace: obj.Ns}); err != nil {
+			return nil, err
+		}
+
+		for i := range statusCheckList.Items {
+			statusChecks = append(statusChecks, &statusCheckList.Items[i])
+		}
+
+		return statusChecks, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Ns, Name: *name}
+	statusCheck := new(v1alpha1.StatusCheck)
+	if err := r.Client.Get(ctx, key, statusCheck); err != nil {
+		return nil, err
+	}
+	return []*v1alpha1.StatusCheck{statusCheck}, nil
+}
+
+// UID is the resolver for the uid field.
+func (r *networkChaosResolver) UID(ctx context.Context, obj *v1alpha1.NetworkChaos) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *networkChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.NetworkChaos) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *networkChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.NetworkChaos) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *networkChaosResolver) Labels(ctx context.Context, obj *v1alpha1.NetworkChaos) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *networkChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.NetworkChaos) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
 		annotations[k] = v
 	}
 	return annotations, nil
@@ -805,6 +1379,25 @@ func (r *networkChaosResolver) Podnetwork(ctx context.Context, obj *v1alpha1.Net
 	return podnetworks, nil
 }
 
+// Pods is the resolver for the pods field.
+func (r *networkChaosResolver) Pods(ctx context.Context, obj *v1alpha1.NetworkChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
+// UID is the resolver for the uid field.
+func (r *objectReferenceResolver) UID(ctx context.Context, obj *v1.ObjectReference) (string, error) {
+	return string(obj.UID), nil
+}
+
 // UID is the resolver for the uid field.
 func (r *ownerReferenceResolver) UID(ctx context.Context, obj *v11.OwnerReference) (string, error) {
 	return string(obj.UID), nil
@@ -896,6 +1489,68 @@ func (r *podResolver) Iptables(ctx context.Context, obj *v1.Pod) ([]string, erro
 	return r.GetIptables(ctx, obj)
 }
 
+// ResolvConf is the resolver for the resolvConf field.
+func (r *podResolver) ResolvConf(ctx context.Context, obj *v1.Pod) (string, error) {
+	return r.GetResolvConf(ctx, obj)
+}
+
+// UID is the resolver for the uid field.
+func (r *podChaosResolver) UID(ctx context.Context, obj *v1alpha1.PodChaos) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *podChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.PodChaos) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *podChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.PodChaos) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *podChaosResolver) Labels(ctx context.Context, obj *v1alpha1.PodChaos) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *podChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.PodChaos) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Pods is the resolver for the pods field.
+func (r *podChaosResolver) Pods(ctx context.Context, obj *v1alpha1.PodChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
+// Mode is the resolver for the mode field.
+func (r *podChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.PodChaosSpec) (string, error) {
+	return string(obj.Mode), nil
+}
+
+// Action is the resolver for the action field.
+func (r *podChaosSpecResolver) Action(ctx context.Context, obj *v1alpha1.PodChaosSpec) (string, error) {
+	return string(obj.Action), nil
+}
+
 // Type is the resolver for the type field.
 func (r *podConditionResolver) Type(ctx context.Context, obj *v1.PodCondition) (string, error) {
 	return string(obj.Type), nil
@@ -1072,6 +1727,11 @@ func (r *podIOChaosResolver) Ios(ctx context.Context, obj *v1alpha1.PodIOChaos)
 	return ios, nil
 }
 
+// Rules is the resolver for the rules field.
+func (r *podJVMChaosResolver) Rules(ctx context.Context, obj *model.PodJVMChaos) (string, error) {
+	return r.GetJVMRules(ctx, obj.Pod, obj.JvmChaos.Spec.Port)
+}
+
 // UID is the resolver for the uid field.
 func (r *podNetworkChaosResolver) UID(ctx context.Context, obj *v1alpha1.PodNetworkChaos) (string, error) {
 	return string(obj.UID), nil
@@ -1315,22 +1975,22 @@ func (r *recordResolver) Phase(ctx context.Context, obj *v1alpha1.Record) (strin
 }
 
 // UID is the resolver for the uid field.
-func (r *stressChaosResolver) UID(ctx context.Context, obj *v1alpha1.StressChaos) (string, error) {
+func (r *scheduleResolver) UID(ctx context.Context, obj *v1alpha1.Schedule) (string, error) {
 	return string(obj.UID), nil
 }
 
 // CreationTimestamp is the resolver for the creationTimestamp field.
-func (r *stressChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.StressChaos) (*time.Time, error) {
+func (r *scheduleResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.Schedule) (*time.Time, error) {
 	return &obj.CreationTimestamp.Time, nil
 }
 
 // DeletionTimestamp is the resolver for the deletionTimestamp field.
-func (r *stressChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.StressChaos) (*time.Time, error) {
+func (r *scheduleResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.Schedule) (*time.Time, error) {
 	return &obj.DeletionTimestamp.Time, nil
 }
 
 // Labels is the resolver for the labels field.
-func (r *stressChaosResolver) Labels(ctx context.Context, obj *v1alpha1.StressChaos) (map[string]any, error) {
+func (r *scheduleResolver) Labels(ctx context.Context, obj *v1alpha1.Schedule) (map[string]any, error) {
 	labels := make(map[string]interface{})
 	for k, v := range obj.Labels {
 		labels[k] = v
@@ -1339,7 +1999,7 @@ func (r *stressChaosResolver) Labels(ctx context.Context, obj *v1alpha1.StressCh
 }
 
 // Annotations is the resolver for the annotations field.
-func (r *stressChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.StressChaos) (map[string]any, error) {
+func (r *scheduleResolver) Annotations(ctx context.Context, obj *v1alpha1.Schedule) (map[string]any, error) {
 	annotations := make(map[string]interface{})
 	for k, v := range obj.Annotations {
 		annotations[k] = v
@@ -1347,47 +2007,482 @@ func (r *stressChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.Str
 	return annotations, nil
 }
 
-// Podstress is the resolver for the podstress field.
-func (r *stressChaosResolver) Podstress(ctx context.Context, obj *v1alpha1.StressChaos) ([]*model.PodStressChaos, error) {
-	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
-	if err != nil {
-		return nil, err
-	}
-
-	var podStress []*model.PodStressChaos
-	for _, pod := range pods {
-		p := pod
-		podStress = append(podStress, &model.PodStressChaos{
-			StressChaos: obj,
-			Pod:         &p,
-		})
-	}
-	return podStress, nil
+// ConcurrencyPolicy is the resolver for the concurrencyPolicy field.
+func (r *scheduleSpecResolver) ConcurrencyPolicy(ctx context.Context, obj *v1alpha1.ScheduleSpec) (string, error) {
+	return string(obj.ConcurrencyPolicy), nil
 }
 
-// Mode is the resolver for the mode field.
-func (r *stressChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.StressChaosSpec) (string, error) {
-	return string(obj.Mode), nil
+// Type is the resolver for the type field.
+func (r *scheduleSpecResolver) Type(ctx context.Context, obj *v1alpha1.ScheduleSpec) (string, error) {
+	return string(obj.Type), nil
 }
 
-// Instances is the resolver for the instances field.
-func (r *stressChaosStatusResolver) Instances(ctx context.Context, obj *v1alpha1.StressChaosStatus) (map[string]any, error) {
-	instances := make(map[string]interface{})
-	for k, v := range obj.Instances {
-		instances[k] = v
-	}
-	return instances, nil
+// LastScheduleTime is the resolver for the lastScheduleTime field.
+func (r *scheduleStatusResolver) LastScheduleTime(ctx context.Context, obj *v1alpha1.ScheduleStatus) (*time.Time, error) {
+	return &obj.LastScheduleTime.Time, nil
 }
 
-// AttrOverrideSpec returns generated.AttrOverrideSpecResolver implementation.
-func (r *Resolver) AttrOverrideSpec() generated.AttrOverrideSpecResolver {
-	return &attrOverrideSpecResolver{r}
+// UID is the resolver for the uid field.
+func (r *statusCheckResolver) UID(ctx context.Context, obj *v1alpha1.StatusCheck) (string, error) {
+	return string(obj.UID), nil
 }
 
-// BandwidthSpec returns generated.BandwidthSpecResolver implementation.
-func (r *Resolver) BandwidthSpec() generated.BandwidthSpecResolver { return &bandwidthSpecResolver{r} }
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *statusCheckResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.StatusCheck) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
 
-// ChaosCondition returns generated.ChaosConditionResolver implementation.
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *statusCheckResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.StatusCheck) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *statusCheckResolver) Labels(ctx context.Context, obj *v1alpha1.StatusCheck) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *statusCheckResolver) Annotations(ctx context.Context, obj *v1alpha1.StatusCheck) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Type is the resolver for the type field.
+func (r *statusCheckConditionResolver) Type(ctx context.Context, obj *v1alpha1.StatusCheckCondition) (string, error) {
+	return string(obj.Type), nil
+}
+
+// Status is the resolver for the status field.
+func (r *statusCheckConditionResolver) Status(ctx context.Context, obj *v1alpha1.StatusCheckCondition) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Reason is the resolver for the reason field.
+func (r *statusCheckConditionResolver) Reason(ctx context.Context, obj *v1alpha1.StatusCheckCondition) (string, error) {
+	return string(obj.Reason), nil
+}
+
+// LastProbeTime is the resolver for the lastProbeTime field.
+func (r *statusCheckConditionResolver) LastProbeTime(ctx context.Context, obj *v1alpha1.StatusCheckCondition) (*time.Time, error) {
+	if obj.LastProbeTime == nil {
+		return nil, nil
+	}
+	return &obj.LastProbeTime.Time, nil
+}
+
+// LastTransitionTime is the resolver for the lastTransitionTime field.
+func (r *statusCheckConditionResolver) LastTransitionTime(ctx context.Context, obj *v1alpha1.StatusCheckCondition) (*time.Time, error) {
+	if obj.LastTransitionTime == nil {
+		return nil, nil
+	}
+	return &obj.LastTransitionTime.Time, nil
+}
+
+// StartTime is the resolver for the startTime field.
+func (r *statusCheckRecordResolver) StartTime(ctx context.Context, obj *v1alpha1.StatusCheckRecord) (*time.Time, error) {
+	if obj.StartTime == nil {
+		return nil, nil
+	}
+	return &obj.StartTime.Time, nil
+}
+
+// Outcome is the resolver for the outcome field.
+func (r *statusCheckRecordResolver) Outcome(ctx context.Context, obj *v1alpha1.StatusCheckRecord) (string, error) {
+	return string(obj.Outcome), nil
+}
+
+// Mode is the resolver for the mode field.
+func (r *statusCheckSpecResolver) Mode(ctx context.Context, obj *v1alpha1.StatusCheckSpec) (string, error) {
+	return string(obj.Mode), nil
+}
+
+// Type is the resolver for the type field.
+func (r *statusCheckSpecResolver) Type(ctx context.Context, obj *v1alpha1.StatusCheckSpec) (string, error) {
+	return string(obj.Type), nil
+}
+
+// StartTime is the resolver for the startTime field.
+func (r *statusCheckStatusResolver) StartTime(ctx context.Context, obj *v1alpha1.StatusCheckStatus) (*time.Time, error) {
+	if obj.StartTime == nil {
+		return nil, nil
+	}
+	return &obj.StartTime.Time, nil
+}
+
+// CompletionTime is the resolver for the completionTime field.
+func (r *statusCheckStatusResolver) CompletionTime(ctx context.Context, obj *v1alpha1.StatusCheckStatus) (*time.Time, error) {
+	if obj.CompletionTime == nil {
+		return nil, nil
+	}
+	return &obj.CompletionTime.Time, nil
+}
+
+// UID is the resolver for the uid field.
+func (r *stressChaosResolver) UID(ctx context.Context, obj *v1alpha1.StressChaos) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *stressChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.StressChaos) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *stressChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.StressChaos) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *stressChaosResolver) Labels(ctx context.Context, obj *v1alpha1.StressChaos) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *stressChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.StressChaos) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Podstress is the resolver for the podstress field.
+func (r *stressChaosResolver) Podstress(ctx context.Context, obj *v1alpha1.StressChaos) ([]*model.PodStressChaos, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var podStress []*model.PodStressChaos
+	for _, pod := range pods {
+		p := pod
+		podStress = append(podStress, &model.PodStressChaos{
+			StressChaos: obj,
+			Pod:         &p,
+		})
+	}
+	return podStress, nil
+}
+
+// Pods is the resolver for the pods field.
+func (r *stressChaosResolver) Pods(ctx context.Context, obj *v1alpha1.StressChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
+// Mode is the resolver for the mode field.
+func (r *stressChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.StressChaosSpec) (string, error) {
+	return string(obj.Mode), nil
+}
+
+// Instances is the resolver for the instances field.
+func (r *stressChaosStatusResolver) Instances(ctx context.Context, obj *v1alpha1.StressChaosStatus) (map[string]any, error) {
+	instances := make(map[string]interface{})
+	for k, v := range obj.Instances {
+		instances[k] = v
+	}
+	return instances, nil
+}
+
+// UID is the resolver for the uid field.
+func (r *timeChaosResolver) UID(ctx context.Context, obj *v1alpha1.TimeChaos) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *timeChaosResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.TimeChaos) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *timeChaosResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.TimeChaos) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *timeChaosResolver) Labels(ctx context.Context, obj *v1alpha1.TimeChaos) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *timeChaosResolver) Annotations(ctx context.Context, obj *v1alpha1.TimeChaos) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Pods is the resolver for the pods field.
+func (r *timeChaosResolver) Pods(ctx context.Context, obj *v1alpha1.TimeChaos) ([]*v1.Pod, error) {
+	pods, _, err := GetPods(ctx, obj.Status.ChaosStatus, obj.Spec.Selector, r.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*v1.Pod
+	for i := range pods {
+		result = append(result, &pods[i])
+	}
+	return result, nil
+}
+
+// Mode is the resolver for the mode field.
+func (r *timeChaosSpecResolver) Mode(ctx context.Context, obj *v1alpha1.TimeChaosSpec) (string, error) {
+	return string(obj.Mode), nil
+}
+
+// UID is the resolver for the uid field.
+func (r *workflowResolver) UID(ctx context.Context, obj *v1alpha1.Workflow) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *workflowResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.Workflow) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *workflowResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.Workflow) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *workflowResolver) Labels(ctx context.Context, obj *v1alpha1.Workflow) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *workflowResolver) Annotations(ctx context.Context, obj *v1alpha1.Workflow) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Nodes is the resolver for the nodes field.
+func (r *workflowResolver) Nodes(ctx context.Context, obj *v1alpha1.Workflow) ([]*v1alpha1.WorkflowNode, error) {
+	var nodeList v1alpha1.WorkflowNodeList
+	if err := r.Client.List(ctx, &nodeList, &client.ListOptions{Namespace: obj.Namespace}, client.MatchingLabels{v1alpha1.LabelWorkflow: obj.Name}); err != nil {
+		return nil, err
+	}
+
+	var nodes []*v1alpha1.WorkflowNode
+	for i := range nodeList.Items {
+		nodes = append(nodes, &nodeList.Items[i])
+	}
+	return nodes, nil
+}
+
+// Type is the resolver for the type field.
+func (r *workflowConditionResolver) Type(ctx context.Context, obj *v1alpha1.WorkflowCondition) (string, error) {
+	return string(obj.Type), nil
+}
+
+// Status is the resolver for the status field.
+func (r *workflowConditionResolver) Status(ctx context.Context, obj *v1alpha1.WorkflowCondition) (string, error) {
+	return string(obj.Status), nil
+}
+
+// StartTime is the resolver for the startTime field.
+func (r *workflowConditionResolver) StartTime(ctx context.Context, obj *v1alpha1.WorkflowCondition) (*time.Time, error) {
+	if obj.StartTime == nil {
+		return nil, nil
+	}
+	return &obj.StartTime.Time, nil
+}
+
+// UID is the resolver for the uid field.
+func (r *workflowNodeResolver) UID(ctx context.Context, obj *v1alpha1.WorkflowNode) (string, error) {
+	return string(obj.UID), nil
+}
+
+// CreationTimestamp is the resolver for the creationTimestamp field.
+func (r *workflowNodeResolver) CreationTimestamp(ctx context.Context, obj *v1alpha1.WorkflowNode) (*time.Time, error) {
+	return &obj.CreationTimestamp.Time, nil
+}
+
+// DeletionTimestamp is the resolver for the deletionTimestamp field.
+func (r *workflowNodeResolver) DeletionTimestamp(ctx context.Context, obj *v1alpha1.WorkflowNode) (*time.Time, error) {
+	return &obj.DeletionTimestamp.Time, nil
+}
+
+// Labels is the resolver for the labels field.
+func (r *workflowNodeResolver) Labels(ctx context.Context, obj *v1alpha1.WorkflowNode) (map[string]any, error) {
+	labels := make(map[string]interface{})
+	for k, v := range obj.Labels {
+		labels[k] = v
+	}
+	return labels, nil
+}
+
+// Annotations is the resolver for the annotations field.
+func (r *workflowNodeResolver) Annotations(ctx context.Context, obj *v1alpha1.WorkflowNode) (map[string]any, error) {
+	annotations := make(map[string]interface{})
+	for k, v := range obj.Annotations {
+		annotations[k] = v
+	}
+	return annotations, nil
+}
+
+// Chaos is the resolver for the chaos field.
+func (r *workflowNodeResolver) Chaos(ctx context.Context, obj *v1alpha1.WorkflowNode) (client.Object, error) {
+	ref := obj.Status.ChaosResource
+	if ref == nil {
+		return nil, nil
+	}
+
+	var chaos client.Object
+	switch ref.Kind {
+	case v1alpha1.KindStressChaos:
+		chaos = new(v1alpha1.StressChaos)
+	case v1alpha1.KindIOChaos:
+		chaos = new(v1alpha1.IOChaos)
+	case v1alpha1.KindHTTPChaos:
+		chaos = new(v1alpha1.HTTPChaos)
+	case v1alpha1.KindNetworkChaos:
+		chaos = new(v1alpha1.NetworkChaos)
+	case v1alpha1.KindTimeChaos:
+		chaos = new(v1alpha1.TimeChaos)
+	case v1alpha1.KindDNSChaos:
+		chaos = new(v1alpha1.DNSChaos)
+	case v1alpha1.KindJVMChaos:
+		chaos = new(v1alpha1.JVMChaos)
+	case v1alpha1.KindBlockChaos:
+		chaos = new(v1alpha1.BlockChaos)
+	case v1alpha1.KindKernelChaos:
+		chaos = new(v1alpha1.KernelChaos)
+	case v1alpha1.KindPodChaos:
+		chaos = new(v1alpha1.PodChaos)
+	case v1alpha1.KindSchedule:
+		chaos = new(v1alpha1.Schedule)
+	default:
+		// the kind is not exposed by this schema
+		return nil, nil
+	}
+
+	key := types.NamespacedName{Namespace: obj.Namespace, Name: ref.Name}
+	if err := r.Client.Get(ctx, key, chaos); err != nil {
+		return nil, err
+	}
+	return chaos, nil
+}
+
+// Type is the resolver for the type field.
+func (r *workflowNodeConditionResolver) Type(ctx context.Context, obj *v1alpha1.WorkflowNodeCondition) (string, error) {
+	return string(obj.Type), nil
+}
+
+// Status is the resolver for the status field.
+func (r *workflowNodeConditionResolver) Status(ctx context.Context, obj *v1alpha1.WorkflowNodeCondition) (string, error) {
+	return string(obj.Status), nil
+}
+
+// Type is the resolver for the type field.
+func (r *workflowNodeSpecResolver) Type(ctx context.Context, obj *v1alpha1.WorkflowNodeSpec) (string, error) {
+	return string(obj.Type), nil
+}
+
+// StartTime is the resolver for the startTime field.
+func (r *workflowNodeSpecResolver) StartTime(ctx context.Context, obj *v1alpha1.WorkflowNodeSpec) (*time.Time, error) {
+	if obj.StartTime == nil {
+		return nil, nil
+	}
+	return &obj.StartTime.Time, nil
+}
+
+// Deadline is the resolver for the deadline field.
+func (r *workflowNodeSpecResolver) Deadline(ctx context.Context, obj *v1alpha1.WorkflowNodeSpec) (*time.Time, error) {
+	if obj.Deadline == nil {
+		return nil, nil
+	}
+	return &obj.Deadline.Time, nil
+}
+
+// Outputs is the resolver for the outputs field.
+func (r *workflowNodeStatusResolver) Outputs(ctx context.Context, obj *v1alpha1.WorkflowNodeStatus) (map[string]any, error) {
+	outputs := make(map[string]interface{})
+	for k, v := range obj.Outputs {
+		outputs[k] = v
+	}
+	return outputs, nil
+}
+
+// NextIterationTime is the resolver for the nextIterationTime field.
+func (r *workflowNodeStatusResolver) NextIterationTime(ctx context.Context, obj *v1alpha1.WorkflowNodeStatus) (*time.Time, error) {
+	if obj.NextIterationTime == nil {
+		return nil, nil
+	}
+	return &obj.NextIterationTime.Time, nil
+}
+
+// StartTime is the resolver for the startTime field.
+func (r *workflowStatusResolver) StartTime(ctx context.Context, obj *v1alpha1.WorkflowStatus) (*time.Time, error) {
+	if obj.StartTime == nil {
+		return nil, nil
+	}
+	return &obj.StartTime.Time, nil
+}
+
+// EndTime is the resolver for the endTime field.
+func (r *workflowStatusResolver) EndTime(ctx context.Context, obj *v1alpha1.WorkflowStatus) (*time.Time, error) {
+	if obj.EndTime == nil {
+		return nil, nil
+	}
+	return &obj.EndTime.Time, nil
+}
+
+// AttrOverrideSpec returns generated.AttrOverrideSpecResolver implementation.
+func (r *Resolver) AttrOverrideSpec() generated.AttrOverrideSpecResolver {
+	return &attrOverrideSpecResolver{r}
+}
+
+// BandwidthSpec returns generated.BandwidthSpecResolver implementation.
+func (r *Resolver) BandwidthSpec() generated.BandwidthSpecResolver { return &bandwidthSpecResolver{r} }
+
+// BlockChaos returns generated.BlockChaosResolver implementation.
+func (r *Resolver) BlockChaos() generated.BlockChaosResolver { return &blockChaosResolver{r} }
+
+// BlockChaosSpec returns generated.BlockChaosSpecResolver implementation.
+func (r *Resolver) BlockChaosSpec() generated.BlockChaosSpecResolver {
+	return &blockChaosSpecResolver{r}
+}
+
+// BlockChaosStatus returns generated.BlockChaosStatusResolver implementation.
+func (r *Resolver) BlockChaosStatus() generated.BlockChaosStatusResolver {
+	return &blockChaosStatusResolver{r}
+}
+
+// ChaosCondition returns generated.ChaosConditionResolver implementation.
 func (r *Resolver) ChaosCondition() generated.ChaosConditionResolver {
 	return &chaosConditionResolver{r}
 }
@@ -1405,11 +2500,22 @@ func (r *Resolver) ContainerStateTerminated() generated.ContainerStateTerminated
 	return &containerStateTerminatedResolver{r}
 }
 
+// DNSChaos returns generated.DNSChaosResolver implementation.
+func (r *Resolver) DNSChaos() generated.DNSChaosResolver { return &dNSChaosResolver{r} }
+
+// DNSChaosSpec returns generated.DNSChaosSpecResolver implementation.
+func (r *Resolver) DNSChaosSpec() generated.DNSChaosSpecResolver { return &dNSChaosSpecResolver{r} }
+
 // ExperimentStatus returns generated.ExperimentStatusResolver implementation.
 func (r *Resolver) ExperimentStatus() generated.ExperimentStatusResolver {
 	return &experimentStatusResolver{r}
 }
 
+// FailKernRequest returns generated.FailKernRequestResolver implementation.
+func (r *Resolver) FailKernRequest() generated.FailKernRequestResolver {
+	return &failKernRequestResolver{r}
+}
+
 // HTTPChaos returns generated.HTTPChaosResolver implementation.
 func (r *Resolver) HTTPChaos() generated.HTTPChaosResolver { return &hTTPChaosResolver{r} }
 
@@ -1421,6 +2527,11 @@ func (r *Resolver) HTTPChaosStatus() generated.HTTPChaosStatusResolver {
 	return &hTTPChaosStatusResolver{r}
 }
 
+// HTTPStatusCheck returns generated.HTTPStatusCheckResolver implementation.
+func (r *Resolver) HTTPStatusCheck() generated.HTTPStatusCheckResolver {
+	return &hTTPStatusCheckResolver{r}
+}
+
 // IOChaos returns generated.IOChaosResolver implementation.
 func (r *Resolver) IOChaos() generated.IOChaosResolver { return &iOChaosResolver{r} }
 
@@ -1436,6 +2547,20 @@ func (r *Resolver) IOChaosStatus() generated.IOChaosStatusResolver { return &iOC
 // IoFault returns generated.IoFaultResolver implementation.
 func (r *Resolver) IoFault() generated.IoFaultResolver { return &ioFaultResolver{r} }
 
+// JVMChaos returns generated.JVMChaosResolver implementation.
+func (r *Resolver) JVMChaos() generated.JVMChaosResolver { return &jVMChaosResolver{r} }
+
+// JVMChaosSpec returns generated.JVMChaosSpecResolver implementation.
+func (r *Resolver) JVMChaosSpec() generated.JVMChaosSpecResolver { return &jVMChaosSpecResolver{r} }
+
+// KernelChaos returns generated.KernelChaosResolver implementation.
+func (r *Resolver) KernelChaos() generated.KernelChaosResolver { return &kernelChaosResolver{r} }
+
+// KernelChaosSpec returns generated.KernelChaosSpecResolver implementation.
+func (r *Resolver) KernelChaosSpec() generated.KernelChaosSpecResolver {
+	return &kernelChaosSpecResolver{r}
+}
+
 // Logger returns generated.LoggerResolver implementation.
 func (r *Resolver) Logger() generated.LoggerResolver { return &loggerResolver{r} }
 
@@ -1454,6 +2579,11 @@ func (r *Resolver) Namespace() generated.NamespaceResolver { return &namespaceRe
 // NetworkChaos returns generated.NetworkChaosResolver implementation.
 func (r *Resolver) NetworkChaos() generated.NetworkChaosResolver { return &networkChaosResolver{r} }
 
+// ObjectReference returns generated.ObjectReferenceResolver implementation.
+func (r *Resolver) ObjectReference() generated.ObjectReferenceResolver {
+	return &objectReferenceResolver{r}
+}
+
 // OwnerReference returns generated.OwnerReferenceResolver implementation.
 func (r *Resolver) OwnerReference() generated.OwnerReferenceResolver {
 	return &ownerReferenceResolver{r}
@@ -1462,6 +2592,12 @@ func (r *Resolver) OwnerReference() generated.OwnerReferenceResolver {
 // Pod returns generated.PodResolver implementation.
 func (r *Resolver) Pod() generated.PodResolver { return &podResolver{r} }
 
+// PodChaos returns generated.PodChaosResolver implementation.
+func (r *Resolver) PodChaos() generated.PodChaosResolver { return &podChaosResolver{r} }
+
+// PodChaosSpec returns generated.PodChaosSpecResolver implementation.
+func (r *Resolver) PodChaosSpec() generated.PodChaosSpecResolver { return &podChaosSpecResolver{r} }
+
 // PodCondition returns generated.PodConditionResolver implementation.
 func (r *Resolver) PodCondition() generated.PodConditionResolver { return &podConditionResolver{r} }
 
@@ -1486,6 +2622,9 @@ func (r *Resolver) PodHttpChaosSelector() generated.PodHttpChaosSelectorResolver
 // PodIOChaos returns generated.PodIOChaosResolver implementation.
 func (r *Resolver) PodIOChaos() generated.PodIOChaosResolver { return &podIOChaosResolver{r} }
 
+// PodJVMChaos returns generated.PodJVMChaosResolver implementation.
+func (r *Resolver) PodJVMChaos() generated.PodJVMChaosResolver { return &podJVMChaosResolver{r} }
+
 // PodNetworkChaos returns generated.PodNetworkChaosResolver implementation.
 func (r *Resolver) PodNetworkChaos() generated.PodNetworkChaosResolver {
 	return &podNetworkChaosResolver{r}
@@ -1524,6 +2663,40 @@ func (r *Resolver) RawTrafficControl() generated.RawTrafficControlResolver {
 // Record returns generated.RecordResolver implementation.
 func (r *Resolver) Record() generated.RecordResolver { return &recordResolver{r} }
 
+// Schedule returns generated.ScheduleResolver implementation.
+func (r *Resolver) Schedule() generated.ScheduleResolver { return &scheduleResolver{r} }
+
+// ScheduleSpec returns generated.ScheduleSpecResolver implementation.
+func (r *Resolver) ScheduleSpec() generated.ScheduleSpecResolver { return &scheduleSpecResolver{r} }
+
+// ScheduleStatus returns generated.ScheduleStatusResolver implementation.
+func (r *Resolver) ScheduleStatus() generated.ScheduleStatusResolver {
+	return &scheduleStatusResolver{r}
+}
+
+// StatusCheck returns generated.StatusCheckResolver implementation.
+func (r *Resolver) StatusCheck() generated.StatusCheckResolver { return &statusCheckResolver{r} }
+
+// StatusCheckCondition returns generated.StatusCheckConditionResolver implementation.
+func (r *Resolver) StatusCheckCondition() generated.StatusCheckConditionResolver {
+	return &statusCheckConditionResolver{r}
+}
+
+// StatusCheckRecord returns generated.StatusCheckRecordResolver implementation.
+func (r *Resolver) StatusCheckRecord() generated.StatusCheckRecordResolver {
+	return &statusCheckRecordResolver{r}
+}
+
+// StatusCheckSpec returns generated.StatusCheckSpecResolver implementation.
+func (r *Resolver) StatusCheckSpec() generated.StatusCheckSpecResolver {
+	return &statusCheckSpecResolver{r}
+}
+
+// StatusCheckStatus returns generated.StatusCheckStatusResolver implementation.
+func (r *Resolver) StatusCheckStatus() generated.StatusCheckStatusResolver {
+	return &statusCheckStatusResolver{r}
+}
+
 // StressChaos returns generated.StressChaosResolver implementation.
 func (r *Resolver) StressChaos() generated.StressChaosResolver { return &stressChaosResolver{r} }
 
@@ -1537,35 +2710,87 @@ func (r *Resolver) StressChaosStatus() generated.StressChaosStatusResolver {
 	return &stressChaosStatusResolver{r}
 }
 
+// TimeChaos returns generated.TimeChaosResolver implementation.
+func (r *Resolver) TimeChaos() generated.TimeChaosResolver { return &timeChaosResolver{r} }
+
+// TimeChaosSpec returns generated.TimeChaosSpecResolver implementation.
+func (r *Resolver) TimeChaosSpec() generated.TimeChaosSpecResolver { return &timeChaosSpecResolver{r} }
+
+// Workflow returns generated.WorkflowResolver implementation.
+func (r *Resolver) Workflow() generated.WorkflowResolver { return &workflowResolver{r} }
+
+// WorkflowCondition returns generated.WorkflowConditionResolver implementation.
+func (r *Resolver) WorkflowCondition() generated.WorkflowConditionResolver {
+	return &workflowConditionResolver{r}
+}
+
+// WorkflowNode returns generated.WorkflowNodeResolver implementation.
+func (r *Resolver) WorkflowNode() generated.WorkflowNodeResolver { return &workflowNodeResolver{r} }
+
+// WorkflowNodeCondition returns generated.WorkflowNodeConditionResolver implementation.
+func (r *Resolver) WorkflowNodeCondition() generated.WorkflowNodeConditionResolver {
+	return &workflowNodeConditionResolver{r}
+}
+
+// WorkflowNodeSpec returns generated.WorkflowNodeSpecResolver implementation.
+func (r *Resolver) WorkflowNodeSpec() generated.WorkflowNodeSpecResolver {
+	return &workflowNodeSpecResolver{r}
+}
+
+// WorkflowNodeStatus returns generated.WorkflowNodeStatusResolver implementation.
+func (r *Resolver) WorkflowNodeStatus() generated.WorkflowNodeStatusResolver {
+	return &workflowNodeStatusResolver{r}
+}
+
+// WorkflowStatus returns generated.WorkflowStatusResolver implementation.
+func (r *Resolver) WorkflowStatus() generated.WorkflowStatusResolver {
+	return &workflowStatusResolver{r}
+}
+
 type attrOverrideSpecResolver struct{ *Resolver }
 type bandwidthSpecResolver struct{ *Resolver }
+type blockChaosResolver struct{ *Resolver }
+type blockChaosSpecResolver struct{ *Resolver }
+type blockChaosStatusResolver struct{ *Resolver }
 type chaosConditionResolver struct{ *Resolver }
 type cidrAndPortResolver struct{ *Resolver }
 type containerStateRunningResolver struct{ *Resolver }
 type containerStateTerminatedResolver struct{ *Resolver }
+type dNSChaosResolver struct{ *Resolver }
+type dNSChaosSpecResolver struct{ *Resolver }
 type experimentStatusResolver struct{ *Resolver }
+type failKernRequestResolver struct{ *Resolver }
 type hTTPChaosResolver struct{ *Resolver }
 type hTTPChaosSpecResolver struct{ *Resolver }
 type hTTPChaosStatusResolver struct{ *Resolver }
+type hTTPStatusCheckResolver struct{ *Resolver }
 type iOChaosResolver struct{ *Resolver }
 type iOChaosActionResolver struct{ *Resolver }
 type iOChaosSpecResolver struct{ *Resolver }
 type iOChaosStatusResolver struct{ *Resolver }
 type ioFaultResolver struct{ *Resolver }
+type jVMChaosResolver struct{ *Resolver }
+type jVMChaosSpecResolver struct{ *Resolver }
+type kernelChaosResolver struct{ *Resolver }
+type kernelChaosSpecResolver struct{ *Resolver }
 type loggerResolver struct{ *Resolver }
 type mistakeSpecResolver struct{ *Resolver }
 type mutablePodResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type namespaceResolver struct{ *Resolver }
 type networkChaosResolver struct{ *Resolver }
+type objectReferenceResolver struct{ *Resolver }
 type ownerReferenceResolver struct{ *Resolver }
 type podResolver struct{ *Resolver }
+type podChaosResolver struct{ *Resolver }
+type podChaosSpecResolver struct{ *Resolver }
 type podConditionResolver struct{ *Resolver }
 type podHTTPChaosResolver struct{ *Resolver }
 type podHttpChaosReplaceActionsResolver struct{ *Resolver }
 type podHttpChaosRuleResolver struct{ *Resolver }
 type podHttpChaosSelectorResolver struct{ *Resolver }
 type podIOChaosResolver struct{ *Resolver }
+type podJVMChaosResolver struct{ *Resolver }
 type podNetworkChaosResolver struct{ *Resolver }
 type podSelectorSpecResolver struct{ *Resolver }
 type podStatusResolver struct{ *Resolver }
@@ -1576,6 +2801,23 @@ type rawIPSetResolver struct{ *Resolver }
 type rawIptablesResolver struct{ *Resolver }
 type rawTrafficControlResolver struct{ *Resolver }
 type recordResolver struct{ *Resolver }
+type scheduleResolver struct{ *Resolver }
+type scheduleSpecResolver struct{ *Resolver }
+type scheduleStatusResolver struct{ *Resolver }
+type statusCheckResolver struct{ *Resolver }
+type statusCheckConditionResolver struct{ *Resolver }
+type statusCheckRecordResolver struct{ *Resolver }
+type statusCheckSpecResolver struct{ *Resolver }
+type statusCheckStatusResolver struct{ *Resolver }
 type stressChaosResolver struct{ *Resolver }
 type stressChaosSpecResolver struct{ *Resolver }
 type stressChaosStatusResolver struct{ *Resolver }
+type timeChaosResolver struct{ *Resolver }
+type timeChaosSpecResolver struct{ *Resolver }
+type workflowResolver struct{ *Resolver }
+type workflowConditionResolver struct{ *Resolver }
+type workflowNodeResolver struct{ *Resolver }
+type workflowNodeConditionResolver struct{ *Resolver }
+type workflowNodeSpecResolver struct{ *Resolver }
+type workflowNodeStatusResolver struct{ *Resolver }
+type workflowStatusResolver struct{ *Resolver }
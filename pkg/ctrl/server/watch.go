@@ -0,0 +1,253 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/ctrl/server/model"
+)
+
+// watchEvent is a change of an object observed by the informer
+type watchEvent struct {
+	object  client.Object
+	deleted bool
+}
+
+// watch streams the changes of objects with the same type as obj which match the filter,
+// the objects existing in the cache are sent as the first events.
+func (r *Resolver) watch(ctx context.Context, obj client.Object, filter func(client.Object) bool) (<-chan watchEvent, error) {
+	informer, err := r.Cache.GetInformer(ctx, obj)
+	if err != nil {
+		return nil, errors.Wrapf(err, "get informer of %T", obj)
+	}
+
+	events := make(chan watchEvent)
+	send := func(o interface{}, deleted bool) {
+		if tombstone, ok := o.(toolscache.DeletedFinalStateUnknown); ok {
+			o = tombstone.Obj
+		}
+		object, ok := o.(client.Object)
+		if !ok || !filter(object) {
+			return
+		}
+		select {
+		case events <- watchEvent{object: object, deleted: deleted}:
+		case <-ctx.Done():
+		}
+	}
+	registration, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(o interface{}) { send(o, false) },
+		UpdateFunc: func(_, o interface{}) { send(o, false) },
+		DeleteFunc: func(o interface{}) { send(o, true) },
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "add event handler of %T", obj)
+	}
+
+	go func() {
+		<-ctx.Done()
+		if err := informer.RemoveEventHandler(registration); err != nil {
+			r.Log.Error(err, "remove event handler", "type", fmt.Sprintf("%T", obj))
+		}
+	}()
+	return events, nil
+}
+
+// watchChaosStatus streams the status of the chaos once it changes
+func (r *Resolver) watchChaosStatus(ctx context.Context, ns, kind, name string) (<-chan *model.ChaosStatusEvent, error) {
+	chaosKind, ok := v1alpha1.AllKinds()[kind]
+	if !ok {
+		return nil, errors.Errorf("unknown chaos kind %s", kind)
+	}
+
+	events, err := r.watch(ctx, chaosKind.SpawnObject(), func(o client.Object) bool {
+		return o.GetNamespace() == ns && o.GetName() == name
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	statusChan := make(chan *model.ChaosStatusEvent)
+	go func() {
+		defer close(statusChan)
+		var last *v1alpha1.ChaosStatus
+		for {
+			select {
+			case event := <-events:
+				chaos, ok := event.object.(v1alpha1.StatefulObject)
+				if !ok {
+					continue
+				}
+				status := chaos.GetStatus()
+				if !event.deleted && last != nil && equality.Semantic.DeepEqual(last, status) {
+					continue
+				}
+				last = status.DeepCopy()
+
+				select {
+				case statusChan <- &model.ChaosStatusEvent{
+					Time:      time.Now(),
+					Kind:      kind,
+					Namespace: ns,
+					Name:      name,
+					Deleted:   event.deleted,
+					Status:    status,
+				}:
+				case <-ctx.Done():
+					return
+				}
+				if event.deleted {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return statusChan, nil
+}
+
+// watchWorkflowNodes streams the nodes of the workflow once they are created, deleted or their status changes
+func (r *Resolver) watchWorkflowNodes(ctx context.Context, ns, workflow string) (<-chan *model.WorkflowNodeEvent, error) {
+	events, err := r.watch(ctx, &v1alpha1.WorkflowNode{}, func(o client.Object) bool {
+		return o.GetNamespace() == ns && o.GetLabels()[v1alpha1.LabelWorkflow] == workflow
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nodeChan := make(chan *model.WorkflowNodeEvent)
+	go func() {
+		defer close(nodeChan)
+		lastStatus := make(map[string]v1alpha1.WorkflowNodeStatus)
+		for {
+			select {
+			case event := <-events:
+				node, ok := event.object.(*v1alpha1.WorkflowNode)
+				if !ok {
+					continue
+				}
+				if last, ok := lastStatus[node.Name]; ok && !event.deleted && equality.Semantic.DeepEqual(last, node.Status) {
+					continue
+				}
+				if event.deleted {
+					delete(lastStatus, node.Name)
+				} else {
+					lastStatus[node.Name] = *node.Status.DeepCopy()
+				}
+
+				select {
+				case nodeChan <- &model.WorkflowNodeEvent{
+					Time:    time.Now(),
+					Deleted: event.deleted,
+					Node:    node,
+				}:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return nodeChan, nil
+}
+
+// watchPodNetworkState inspects the tc qdiscs, iptables chains and ipsets of the pod every interval,
+// and streams them once they change.
+func (r *Resolver) watchPodNetworkState(ctx context.Context, ns, name string, interval time.Duration) (<-chan *model.PodNetworkState, error) {
+	if interval <= 0 {
+		return nil, errors.Errorf("invalid interval %s", interval)
+	}
+
+	key := types.NamespacedName{Namespace: ns, Name: name}
+	stateChan := make(chan *model.PodNetworkState)
+	go func() {
+		defer close(stateChan)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var last *model.PodNetworkState
+		for {
+			pod := new(v1.Pod)
+			if err := r.Client.Get(ctx, key, pod); err != nil {
+				if !apierrors.IsNotFound(err) {
+					r.Log.Error(err, "get pod", "pod", key)
+				}
+				return
+			}
+
+			state, err := r.inspectNetworkState(ctx, pod)
+			if err != nil {
+				r.Log.Error(err, "inspect network state", "pod", key)
+			} else if last == nil || !equalNetworkState(last, state) {
+				last = state
+				select {
+				case stateChan <- state:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return stateChan, nil
+}
+
+func (r *Resolver) inspectNetworkState(ctx context.Context, pod *v1.Pod) (*model.PodNetworkState, error) {
+	tcQdisc, err := r.GetTcQdisc(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+	iptables, err := r.GetIptables(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+	ipset, err := r.GetIpset(ctx, pod)
+	if err != nil {
+		return nil, err
+	}
+	return &model.PodNetworkState{
+		Time:     time.Now(),
+		Pod:      pod,
+		TcQdisc:  tcQdisc,
+		Iptables: iptables,
+		Ipset:    ipset,
+	}, nil
+}
+
+func equalNetworkState(a, b *model.PodNetworkState) bool {
+	return equality.Semantic.DeepEqual(a.TcQdisc, b.TcQdisc) &&
+		equality.Semantic.DeepEqual(a.Iptables, b.Iptables) &&
+		a.Ipset == b.Ipset
+}
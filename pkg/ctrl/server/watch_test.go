@@ -0,0 +1,175 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package server
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/ctrl/server/model"
+)
+
+// recordingInformer counts the event handlers removed by the unsubscribed watches
+type recordingInformer struct {
+	*controllertest.FakeInformer
+	removed int32
+}
+
+func (i *recordingInformer) RemoveEventHandler(handle toolscache.ResourceEventHandlerRegistration) error {
+	atomic.AddInt32(&i.removed, 1)
+	return nil
+}
+
+func newWatchResolver(t *testing.T) (*Resolver, map[string]*recordingInformer) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	informers := map[string]*recordingInformer{}
+	byGVK := map[schema.GroupVersionKind]toolscache.SharedIndexInformer{}
+	for _, kind := range []string{v1alpha1.KindPodChaos, v1alpha1.KindWorkflowNode} {
+		informers[kind] = &recordingInformer{FakeInformer: &controllertest.FakeInformer{}}
+		byGVK[v1alpha1.GroupVersion.WithKind(kind)] = informers[kind]
+	}
+	return &Resolver{
+		Log:   logr.Discard(),
+		Cache: &informertest.FakeInformers{Scheme: scheme, InformersByGVK: byGVK},
+	}, informers
+}
+
+func podChaosWithPhase(name string, phase v1alpha1.DesiredPhase) *v1alpha1.PodChaos {
+	chaos := &v1alpha1.PodChaos{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name}}
+	chaos.Status.Experiment.DesiredPhase = phase
+	return chaos
+}
+
+func TestWatchChaosStatus(t *testing.T) {
+	RegisterTestingT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resolver, informers := newWatchResolver(t)
+	subscription := &loggerResolver{resolver}
+
+	_, err := subscription.ChaosStatus(ctx, "default", "FooChaos", "a")
+	Expect(err).To(HaveOccurred())
+
+	events, err := subscription.ChaosStatus(ctx, "default", v1alpha1.KindPodChaos, "a")
+	Expect(err).NotTo(HaveOccurred())
+	informer := informers[v1alpha1.KindPodChaos]
+
+	informer.Add(podChaosWithPhase("a", v1alpha1.RunningPhase))
+	var event *model.ChaosStatusEvent
+	Eventually(events).Should(Receive(&event))
+	Expect(event.Kind).To(Equal(v1alpha1.KindPodChaos))
+	Expect(event.Namespace).To(Equal("default"))
+	Expect(event.Name).To(Equal("a"))
+	Expect(event.Deleted).To(BeFalse())
+	Expect(event.Status.Experiment.DesiredPhase).To(Equal(v1alpha1.RunningPhase))
+
+	// the other chaos and the update without changes of status are not delivered
+	informer.Add(podChaosWithPhase("b", v1alpha1.StoppedPhase))
+	informer.Update(podChaosWithPhase("a", v1alpha1.RunningPhase), podChaosWithPhase("a", v1alpha1.RunningPhase))
+	Consistently(events, 100*time.Millisecond).ShouldNot(Receive())
+
+	informer.Update(podChaosWithPhase("a", v1alpha1.RunningPhase), podChaosWithPhase("a", v1alpha1.StoppedPhase))
+	Eventually(events).Should(Receive(&event))
+	Expect(event.Status.Experiment.DesiredPhase).To(Equal(v1alpha1.StoppedPhase))
+
+	// the subscription ends once the chaos is deleted
+	informer.Delete(podChaosWithPhase("a", v1alpha1.StoppedPhase))
+	Eventually(events).Should(Receive(&event))
+	Expect(event.Deleted).To(BeTrue())
+	Eventually(events).Should(BeClosed())
+}
+
+func TestWatchUnsubscribe(t *testing.T) {
+	RegisterTestingT(t)
+	resolver, informers := newWatchResolver(t)
+	subscription := &loggerResolver{resolver}
+	informer := informers[v1alpha1.KindPodChaos]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := subscription.ChaosStatus(ctx, "default", v1alpha1.KindPodChaos, "a")
+	Expect(err).NotTo(HaveOccurred())
+	cancel()
+
+	Eventually(events).Should(BeClosed())
+	Eventually(func() int32 { return atomic.LoadInt32(&informer.removed) }).Should(BeEquivalentTo(1))
+
+	// the events after unsubscribing are dropped without blocking the informer
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		informer.Add(podChaosWithPhase("a", v1alpha1.RunningPhase))
+	}()
+	Eventually(done).Should(BeClosed())
+}
+
+func TestWatchWorkflowNodes(t *testing.T) {
+	RegisterTestingT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resolver, informers := newWatchResolver(t)
+	subscription := &loggerResolver{resolver}
+
+	events, err := subscription.WorkflowNode(ctx, "default", "wf")
+	Expect(err).NotTo(HaveOccurred())
+	informer := informers[v1alpha1.KindWorkflowNode]
+
+	newNode := func(name, workflow string, conditions ...v1alpha1.WorkflowNodeConditionType) *v1alpha1.WorkflowNode {
+		node := &v1alpha1.WorkflowNode{ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default", Name: name, Labels: map[string]string{v1alpha1.LabelWorkflow: workflow},
+		}}
+		for _, condition := range conditions {
+			node.Status.Conditions = append(node.Status.Conditions, v1alpha1.WorkflowNodeCondition{Type: condition, Status: "True"})
+		}
+		return node
+	}
+
+	informer.Add(newNode("entry", "wf"))
+	var event *model.WorkflowNodeEvent
+	Eventually(events).Should(Receive(&event))
+	Expect(event.Node.Name).To(Equal("entry"))
+	Expect(event.Deleted).To(BeFalse())
+
+	informer.Add(newNode("other", "wf-1"))
+	informer.Update(newNode("entry", "wf"), newNode("entry", "wf"))
+	Consistently(events, 100*time.Millisecond).ShouldNot(Receive())
+
+	informer.Update(newNode("entry", "wf"), newNode("entry", "wf", v1alpha1.ConditionAccomplished))
+	Eventually(events).Should(Receive(&event))
+	Expect(event.Node.Status.Conditions).To(HaveLen(1))
+
+	informer.Delete(newNode("entry", "wf", v1alpha1.ConditionAccomplished))
+	Eventually(events).Should(Receive(&event))
+	Expect(event.Deleted).To(BeTrue())
+
+	cancel()
+	Eventually(events).Should(BeClosed())
+	Eventually(func() int32 { return atomic.LoadInt32(&informer.removed) }).Should(BeEquivalentTo(1))
+}
@@ -55,6 +55,10 @@ func Register(r *gin.RouterGroup, s *Service) {
 	statusCheckEndpoint.GET("/statuscheck", s.getStatusCheckTemplateDetail)
 	statusCheckEndpoint.PUT("/statuscheck", s.updateStatusCheckTemplate)
 	statusCheckEndpoint.DELETE("/statuscheck", s.deleteStatusCheckTemplate)
+
+	workflowEndpoint := endpoint.Group("/workflows")
+	workflowEndpoint.GET("", s.listWorkflowTemplate)
+	workflowEndpoint.GET("/workflow", s.getWorkflowTemplateDetail)
 }
 
 // @Summary List status check templates.
@@ -342,3 +346,123 @@ func (s *Service) deleteStatusCheckTemplate(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, u.ResponseSuccess)
 }
+
+// @Summary List workflow templates.
+// @Description Get the WorkflowTemplates and ClusterWorkflowTemplates from k8s cluster in real time.
+// @Tags template
+// @Produce json
+// @Param namespace query string false "filter workflow templates by namespace, the cluster workflow templates are always listed"
+// @Param name query string false "filter workflow templates by name"
+// @Success 200 {array} apiservertypes.WorkflowTemplateBase
+// @Failure 400 {object} u.APIError
+// @Failure 500 {object} u.APIError
+// @Router /templates/workflows [get]
+func (s *Service) listWorkflowTemplate(c *gin.Context) {
+	kubeCli, err := clientpool.ExtractTokenAndGetClient(c.Request.Header)
+	if err != nil {
+		u.SetAPIError(c, u.ErrBadRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	ns, name := c.Query("namespace"), c.Query("name")
+	if ns == "" && !s.conf.ClusterScoped && s.conf.TargetNamespace != "" {
+		ns = s.conf.TargetNamespace
+		s.logger.Info("Replace query namespace", "ns", ns)
+	}
+
+	var templateList v1alpha1.WorkflowTemplateList
+	if err = kubeCli.List(context.Background(), &templateList, client.InNamespace(ns)); err != nil {
+		u.SetAPImachineryError(c, err)
+		return
+	}
+	var clusterTemplateList v1alpha1.ClusterWorkflowTemplateList
+	if err = kubeCli.List(context.Background(), &clusterTemplateList); err != nil {
+		u.SetAPImachineryError(c, err)
+		return
+	}
+
+	templates := make([]*apiservertypes.WorkflowTemplateBase, 0)
+	for _, template := range templateList.Items {
+		if name != "" && template.Name != name {
+			continue
+		}
+		templates = append(templates, workflowTemplateBase(template.ObjectMeta, template.Spec, false))
+	}
+	for _, template := range clusterTemplateList.Items {
+		if name != "" && template.Name != name {
+			continue
+		}
+		templates = append(templates, workflowTemplateBase(template.ObjectMeta, template.Spec, true))
+	}
+
+	sort.Slice(templates, func(i, j int) bool {
+		return templates[i].Created > templates[j].Created
+	})
+
+	c.JSON(http.StatusOK, templates)
+}
+
+// @Summary Get a workflow template.
+// @Description Get the workflow template's detail by namespaced name, or by name if it's a cluster workflow template.
+// @Tags template
+// @Produce json
+// @Param namespace query string false "the namespace of workflow template"
+// @Param name query string true "the name of workflow template"
+// @Param cluster_scope query bool false "whether the template is a cluster workflow template"
+// @Success 200 {object} apiservertypes.WorkflowTemplateDetail
+// @Failure 400 {object} u.APIError
+// @Failure 404 {object} u.APIError
+// @Failure 500 {object} u.APIError
+// @Router /templates/workflows/workflow [get]
+func (s *Service) getWorkflowTemplateDetail(c *gin.Context) {
+	kubeCli, err := clientpool.ExtractTokenAndGetClient(c.Request.Header)
+	if err != nil {
+		u.SetAPIError(c, u.ErrBadRequest.WrapWithNoMessage(err))
+		return
+	}
+
+	ns, name := c.Query("namespace"), c.Query("name")
+	if ns == "" && !s.conf.ClusterScoped && s.conf.TargetNamespace != "" {
+		ns = s.conf.TargetNamespace
+		s.logger.Info("Replace query namespace", "ns", ns)
+	}
+	if name == "" {
+		u.SetAPIError(c, u.ErrBadRequest.New("name is required"))
+		return
+	}
+
+	var detail apiservertypes.WorkflowTemplateDetail
+	if c.Query("cluster_scope") == "true" {
+		var template v1alpha1.ClusterWorkflowTemplate
+		if err = kubeCli.Get(context.Background(), types.NamespacedName{Name: name}, &template); err != nil {
+			u.SetAPImachineryError(c, err)
+			return
+		}
+		detail = apiservertypes.WorkflowTemplateDetail{
+			WorkflowTemplateBase: *workflowTemplateBase(template.ObjectMeta, template.Spec, true),
+			Spec:                 template.Spec,
+		}
+	} else {
+		var template v1alpha1.WorkflowTemplate
+		if err = kubeCli.Get(context.Background(), types.NamespacedName{Namespace: ns, Name: name}, &template); err != nil {
+			u.SetAPImachineryError(c, err)
+			return
+		}
+		detail = apiservertypes.WorkflowTemplateDetail{
+			WorkflowTemplateBase: *workflowTemplateBase(template.ObjectMeta, template.Spec, false),
+			Spec:                 template.Spec,
+		}
+	}
+	c.JSON(http.StatusOK, detail)
+}
+
+func workflowTemplateBase(meta metav1.ObjectMeta, spec v1alpha1.WorkflowTemplateSpec, clusterScope bool) *apiservertypes.WorkflowTemplateBase {
+	return &apiservertypes.WorkflowTemplateBase{
+		Namespace:    meta.Namespace,
+		Name:         meta.Name,
+		UID:          string(meta.UID),
+		ClusterScope: clusterScope,
+		Description:  spec.Description,
+		Created:      meta.CreationTimestamp.Format(time.RFC3339),
+	}
+}
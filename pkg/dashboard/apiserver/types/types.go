@@ -107,3 +107,23 @@ type StatusCheckTemplate struct {
 	Description string                       `json:"description,omitempty"`
 	Spec        v1alpha1.StatusCheckTemplate `json:"spec"`
 }
+
+type WorkflowTemplateBase struct {
+	// Namespace is empty if the template is a ClusterWorkflowTemplate.
+	Namespace    string `json:"namespace,omitempty"`
+	Name         string `json:"name"`
+	UID          string `json:"uid"`
+	ClusterScope bool   `json:"cluster_scope"`
+	Description  string `json:"description,omitempty"`
+	Created      string `json:"created_at"`
+}
+
+/*
+WorkflowTemplateDetail represents a WorkflowTemplate or ClusterWorkflowTemplate.
+
+It inherits `WorkflowTemplateBase` and adds complete definition of a workflow template.
+*/
+type WorkflowTemplateDetail struct {
+	WorkflowTemplateBase `json:",inline,omitempty"`
+	Spec                 v1alpha1.WorkflowTemplateSpec `json:"spec"`
+}
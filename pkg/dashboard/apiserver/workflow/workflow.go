@@ -20,9 +20,11 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
@@ -245,6 +247,7 @@ func (it *Service) getWorkflowDetailByUID(c *gin.Context) {
 // @Tags workflows
 // @Produce json
 // @Param request body v1alpha1.Workflow true "Request body"
+// @Param parameter query []string false "the value of workflow parameter, in the form of name=value" collectionFormat(multi)
 // @Success 200 {object} core.WorkflowDetail
 // @Failure 400 {object} utils.APIError
 // @Failure 500 {object} utils.APIError
@@ -258,6 +261,16 @@ func (it *Service) createWorkflow(c *gin.Context) {
 		return
 	}
 
+	values, err := parseParameterValues(c.QueryArray("parameter"))
+	if err != nil {
+		_ = c.Error(utils.ErrBadRequest.WrapWithNoMessage(err))
+		return
+	}
+	if err := payload.Spec.SetParameterValues(values); err != nil {
+		_ = c.Error(utils.ErrBadRequest.WrapWithNoMessage(err))
+		return
+	}
+
 	kubeClient, err := clientpool.ExtractTokenAndGetClient(c.Request.Header)
 	if err != nil {
 		utils.SetAPImachineryError(c, err)
@@ -371,3 +384,16 @@ func (it *Service) updateWorkflow(c *gin.Context) {
 
 	c.JSON(http.StatusOK, result)
 }
+
+// parseParameterValues parses the values of workflow parameters in the form of name=value
+func parseParameterValues(parameters []string) (map[string]string, error) {
+	values := make(map[string]string, len(parameters))
+	for _, parameter := range parameters {
+		name, value, ok := strings.Cut(parameter, "=")
+		if !ok || len(name) == 0 {
+			return nil, errors.Errorf("invalid parameter %s, it should be in the form of name=value", parameter)
+		}
+		values[name] = value
+	}
+	return values, nil
+}
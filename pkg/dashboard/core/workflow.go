@@ -136,6 +136,11 @@ var nodeTypeTemplateTypeMapping = map[v1alpha1.TemplateType]NodeType{
 	v1alpha1.TypeParallel: ParallelNode,
 	v1alpha1.TypeSuspend:  SuspendNode,
 	v1alpha1.TypeTask:     TaskNode,
+	// the iterations of retry and loop are instantiated one by one, and the ones of for-each are instantiated
+	// at the same time, so they are displayed like serial and parallel nodes.
+	v1alpha1.TypeRetry:   SerialNode,
+	v1alpha1.TypeLoop:    SerialNode,
+	v1alpha1.TypeForEach: ParallelNode,
 }
 
 type KubeWorkflowRepository struct {
@@ -344,6 +349,21 @@ func convertWorkflowNode(kubeWorkflowNode v1alpha1.WorkflowNode) (Node, error) {
 		}
 		result.Parallel = composeParallelTaskAndNodes(kubeWorkflowNode.Spec.Children, nodes)
 
+	} else if v1alpha1.IsIterationTemplateType(kubeWorkflowNode.Spec.Type) {
+		var nodes []string
+		for _, child := range kubeWorkflowNode.Status.FinishedChildren {
+			nodes = append(nodes, child.Name)
+		}
+		for _, child := range kubeWorkflowNode.Status.ActiveChildren {
+			nodes = append(nodes, child.Name)
+		}
+		iterations := composeIterationTaskAndNodes(kubeWorkflowNode.Spec.Children, nodes)
+		if kubeWorkflowNode.Spec.Type == v1alpha1.TypeForEach {
+			result.Parallel = iterations
+		} else {
+			result.Serial = iterations
+		}
+
 	} else if kubeWorkflowNode.Spec.Type == v1alpha1.TypeTask {
 		var nodes []string
 		for _, child := range kubeWorkflowNode.Status.FinishedChildren {
@@ -397,6 +417,22 @@ func composeParallelTaskAndNodes(children []string, nodes []string) []NodeNameWi
 	return result
 }
 
+// composeIterationTaskAndNodes lists each iteration of the only child, or the child itself if no iteration
+// is instantiated yet.
+func composeIterationTaskAndNodes(children []string, nodes []string) []NodeNameWithTemplate {
+	if len(children) == 0 {
+		return nil
+	}
+	if len(nodes) == 0 {
+		return []NodeNameWithTemplate{{Template: children[0]}}
+	}
+	var result []NodeNameWithTemplate
+	for _, node := range nodes {
+		result = append(result, NodeNameWithTemplate{Name: node, Template: children[0]})
+	}
+	return result
+}
+
 func composeTaskConditionalBranches(conditionalBranches []v1alpha1.ConditionalBranch, nodes []string) []ConditionalBranch {
 	var result []ConditionalBranch
 	for _, item := range conditionalBranches {
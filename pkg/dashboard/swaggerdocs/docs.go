@@ -2009,6 +2009,114 @@ const docTemplate = `{
                 }
             }
         },
+        "/templates/workflows": {
+            "get": {
+                "description": "Get the WorkflowTemplates and ClusterWorkflowTemplates from k8s cluster in real time.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "template"
+                ],
+                "summary": "List workflow templates.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "filter workflow templates by namespace, the cluster workflow templates are always listed",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "filter workflow templates by name",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/types.WorkflowTemplateBase"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.APIError"
+                        }
+                    }
+                }
+            }
+        },
+        "/templates/workflows/workflow": {
+            "get": {
+                "description": "Get the workflow template's detail by namespaced name, or by name if it's a cluster workflow template.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "template"
+                ],
+                "summary": "Get a workflow template.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "the namespace of workflow template",
+                        "name": "namespace",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "the name of workflow template",
+                        "name": "name",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "whether the template is a cluster workflow template",
+                        "name": "cluster_scope",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.WorkflowTemplateDetail"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/utils.APIError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/utils.APIError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/utils.APIError"
+                        }
+                    }
+                }
+            }
+        },
         "/workflows": {
             "get": {
                 "description": "List workflows from Kubernetes cluster.",
@@ -2075,6 +2183,16 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/v1alpha1.Workflow"
                         }
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "the value of workflow parameter, in the form of name=value",
+                        "name": "parameter",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2965,6 +3083,57 @@ const docTemplate = `{
                 }
             }
         },
+        "types.WorkflowTemplateBase": {
+            "type": "object",
+            "properties": {
+                "cluster_scope": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace is empty if the template is a ClusterWorkflowTemplate.",
+                    "type": "string"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
+        "types.WorkflowTemplateDetail": {
+            "type": "object",
+            "properties": {
+                "cluster_scope": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace is empty if the template is a ClusterWorkflowTemplate.",
+                    "type": "string"
+                },
+                "spec": {
+                    "$ref": "#/definitions/v1alpha1.WorkflowTemplateSpec"
+                },
+                "uid": {
+                    "type": "string"
+                }
+            }
+        },
         "utils.APIError": {
             "type": "object",
             "properties": {
@@ -4911,10 +5080,25 @@ const docTemplate = `{
                     "description": "Endpoint indicates the endpoint of the aws server. Just used it in test now.\n+ui:form:ignore\n+optional",
                     "type": "string"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "remoteCluster": {
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "secretName": {
                     "description": "SecretName defines the name of kubernetes secret.\n+optional",
                     "type": "string"
@@ -4993,6 +5177,10 @@ const docTemplate = `{
                     "description": "Duration represents the duration of the chaos action.\n+optional",
                     "type": "string"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "lun": {
                     "description": "LUN indicates the Logical Unit Number of the data disk.\nNeeded in disk-detach.\n+optional",
                     "type": "integer"
@@ -5001,6 +5189,17 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "resourceGroupName": {
                     "description": "ResourceGroupName defines the name of ResourceGroup",
                     "type": "string"
@@ -5066,6 +5265,10 @@ const docTemplate = `{
                     "description": "Duration represents the duration of the chaos action.\n+optional",
                     "type": "string"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "mode": {
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
@@ -5074,6 +5277,25 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -5126,6 +5348,26 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.CgroupThrottle": {
+            "type": "object",
+            "properties": {
+                "cpu": {
+                    "description": "CPU limits the CPU time of the container through cpu.max, e.g. \"500m\" limits the container to\nhalf of a CPU.\n+optional",
+                    "type": "string"
+                },
+                "io": {
+                    "description": "IO limits the IO of the container on the block devices through io.max\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.IOThrottle"
+                    }
+                },
+                "memoryHigh": {
+                    "description": "MemoryHigh sets memory.high of the container, over which the container is throttled and its\nmemory is reclaimed heavily. It's in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. \"256MiB\".\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.ChaosOnlyScheduleSpec": {
             "type": "object",
             "properties": {
@@ -5141,6 +5383,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.BlockChaosSpec"
                 },
+                "compositeChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.CompositeChaosSpec"
+                },
                 "concurrencyPolicy": {
                     "description": "+optional\n+kubebuilder:validation:Enum=Forbid;Allow",
                     "type": "string"
@@ -5185,6 +5431,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.PodChaosSpec"
                 },
+                "podfaultChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.PodFaultChaosSpec"
+                },
                 "schedule": {
                     "type": "string"
                 },
@@ -5222,39 +5472,9 @@ const docTemplate = `{
                 }
             }
         },
-        "v1alpha1.ConditionalBranch": {
-            "type": "object",
-            "properties": {
-                "expression": {
-                    "description": "Expression is the expression for this conditional branch, expected type of result is boolean. If expression is empty, this branch will always be selected/the template will be spawned.\n+optional",
-                    "type": "string"
-                },
-                "target": {
-                    "description": "Target is the name of other template, if expression is evaluated as true, this template will be spawned.",
-                    "type": "string"
-                }
-            }
-        },
-        "v1alpha1.CorruptSpec": {
-            "type": "object",
-            "properties": {
-                "correlation": {
-                    "description": "+optional",
-                    "type": "string",
-                    "default": "0"
-                },
-                "corrupt": {
-                    "type": "string"
-                }
-            }
-        },
-        "v1alpha1.DNSChaosSpec": {
+        "v1alpha1.CompositeChaosSpec": {
             "type": "object",
             "properties": {
-                "action": {
-                    "description": "Action defines the specific DNS chaos action.\nSupported action: error, random\nDefault action: error\n+kubebuilder:validation:Enum=error;random",
-                    "type": "string"
-                },
                 "containerNames": {
                     "description": "ContainerNames indicates list of the name of affected container.\nIf not set, the first container will be injected\n+optional",
                     "type": "array",
@@ -5263,23 +5483,46 @@ const docTemplate = `{
                     }
                 },
                 "duration": {
-                    "description": "Duration represents the duration of the chaos action",
+                    "description": "Duration represents the duration of the chaos action.\n+optional",
                     "type": "string"
                 },
+                "faults": {
+                    "description": "Faults is the list of faults to be injected into every selected container. The faults are injected\nin order and recovered in the reverse order. If a fault fails to be injected, the faults injected\nbefore it are recovered, so that a container is either injected with all the faults or none of them.\n+kubebuilder:validation:MinItems=1",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.CompositeFault"
+                    }
+                },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "mode": {
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
                 },
-                "patterns": {
-                    "description": "Choose which domain names to take effect, support the placeholder ? and wildcard *, or the Specified domain name.\nNote:\n     1. The wildcard * must be at the end of the string. For example, chaos-*.org is invalid.\n     2. if the patterns is empty, will take effect on all the domain names.\nFor example:\n\t\tThe value is [\"google.com\", \"github.*\", \"chaos-mes?.org\"],\n\t\twill take effect on \"google.com\", \"github.com\" and \"chaos-mesh.org\"\n+optional",
+                "remoteCluster": {
+                    "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
+                    "type": "string"
+                },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "remoteCluster": {
-                    "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
-                    "type": "string"
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
                 },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
@@ -5291,30 +5534,264 @@ const docTemplate = `{
                 }
             }
         },
-        "v1alpha1.DelaySpec": {
+        "v1alpha1.CompositeFault": {
             "type": "object",
             "properties": {
-                "correlation": {
-                    "description": "+optional",
-                    "type": "string",
-                    "default": "0"
-                },
-                "jitter": {
-                    "description": "+kubebuilder:validation:Pattern=\"^[0-9]+(\\\\.[0-9]+)?(ns|us|ms|s|m|h)$\"\n+optional",
-                    "type": "string",
-                    "default": "0ms"
+                "io": {
+                    "description": "IO injects an IO fault into a volume of the selected containers.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.CompositeIOFault"
                 },
-                "latency": {
-                    "description": "+kubebuilder:validation:Pattern=\"^[0-9]+(\\\\.[0-9]+)?(ns|us|ms|s|m|h)$\"",
+                "name": {
+                    "description": "Name is the name of the fault, which is unique in the CompositeChaos.",
                     "type": "string"
                 },
-                "reorder": {
-                    "description": "+optional",
-                    "$ref": "#/definitions/v1alpha1.ReorderSpec"
+                "network": {
+                    "description": "Network injects a traffic control fault into the pods of the selected containers.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.CompositeNetworkFault"
+                },
+                "stress": {
+                    "description": "Stress runs stressors in the selected containers.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.CompositeStressFault"
+                },
+                "time": {
+                    "description": "Time shifts the clock of the selected containers.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.CompositeTimeFault"
                 }
             }
         },
-        "v1alpha1.DiskFillSpec": {
+        "v1alpha1.CompositeIOFault": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action defines the specific pod chaos action.\nSupported action: latency / fault / attrOverride / mistake\n+kubebuilder:validation:Enum=latency;fault;attrOverride;mistake",
+                    "type": "string"
+                },
+                "attr": {
+                    "description": "Attr defines the overrided attribution\n+ui:form:when=action=='attrOverride'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.AttrOverrideSpec"
+                },
+                "delay": {
+                    "description": "Delay defines the value of I/O chaos action delay.\nA delay string is a possibly signed sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+ui:form:when=action=='latency'\n+optional",
+                    "type": "string"
+                },
+                "errno": {
+                    "description": "Errno defines the error code that returned by I/O action.\nrefer to: https://www-numi.fnal.gov/offline_software/srt_public_context/WebDocs/Errors/unix_system_errors.html\n+ui:form:when=action=='fault'\n+optional",
+                    "type": "integer"
+                },
+                "methods": {
+                    "description": "Methods defines the I/O methods for injecting I/O chaos action.\ndefault: all I/O methods.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mistake": {
+                    "description": "Mistake defines what types of incorrectness are injected to IO operations\n+ui:form:when=action=='mistake'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.MistakeSpec"
+                },
+                "path": {
+                    "description": "Path defines the path of files for injecting I/O chaos action.\n+optional",
+                    "type": "string"
+                },
+                "percent": {
+                    "description": "Percent defines the percentage of injection errors and provides a number from 0-100.\ndefault: 100.\n+optional\n+kubebuilder:default=100",
+                    "type": "integer"
+                },
+                "volumePath": {
+                    "description": "VolumePath represents the mount path of injected volume",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.CompositeNetworkFault": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action defines the specific network chaos action.\nSupported action: netem, delay, loss, duplicate, corrupt, bandwidth\n+kubebuilder:validation:Enum=netem;delay;loss;duplicate;corrupt;bandwidth",
+                    "type": "string"
+                },
+                "bandwidth": {
+                    "description": "Bandwidth represents the detail about bandwidth control action\n+ui:form:when=action=='bandwidth'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.BandwidthSpec"
+                },
+                "corrupt": {
+                    "description": "Corrupt represents the detail about corrupt action\n+ui:form:when=action=='corrupt'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.CorruptSpec"
+                },
+                "delay": {
+                    "description": "Delay represents the detail about delay action\n+ui:form:when=action=='delay'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.DelaySpec"
+                },
+                "device": {
+                    "description": "Device represents the network device to be affected.\n+optional",
+                    "type": "string"
+                },
+                "duplicate": {
+                    "description": "DuplicateSpec represents the detail about loss action\n+ui:form:when=action=='duplicate'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.DuplicateSpec"
+                },
+                "externalTargets": {
+                    "description": "ExternalTargets represents network targets outside k8s\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "loss": {
+                    "description": "Loss represents the detail about loss action\n+ui:form:when=action=='loss'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.LossSpec"
+                },
+                "rate": {
+                    "description": "Rate represents the detail about rate control action\n+ui:form:when=action=='rate'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RateSpec"
+                }
+            }
+        },
+        "v1alpha1.CompositeStressFault": {
+            "type": "object",
+            "properties": {
+                "stressngStressors": {
+                    "description": "StressngStressors defines the stressors in ` + "`" + `stress-ng` + "`" + ` dialect, see also the same field of StressChaos.\n+optional",
+                    "type": "string"
+                },
+                "stressors": {
+                    "description": "Stressors defines plenty of stressors supported to stress system components out.\nYou can use one or more of them to make up various kinds of stresses. At least\none of the stressors should be specified.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.Stressors"
+                }
+            }
+        },
+        "v1alpha1.CompositeTimeFault": {
+            "type": "object",
+            "properties": {
+                "clockIds": {
+                    "description": "ClockIds defines all affected clock id, see also the same field of TimeChaos.\nDefault value is [\"CLOCK_REALTIME\"]",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timeOffset": {
+                    "description": "TimeOffset defines the delta time of injected program. It's a possibly signed sequence of decimal numbers, such as\n\"300ms\", \"-1.5h\" or \"2h45m\". Valid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.ConditionalBranch": {
+            "type": "object",
+            "properties": {
+                "expression": {
+                    "description": "Expression is the expression for this conditional branch, expected type of result is boolean. If expression is empty, this branch will always be selected/the template will be spawned.\n+optional",
+                    "type": "string"
+                },
+                "target": {
+                    "description": "Target is the name of other template, if expression is evaluated as true, this template will be spawned.",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.CorruptSpec": {
+            "type": "object",
+            "properties": {
+                "correlation": {
+                    "description": "+optional",
+                    "type": "string",
+                    "default": "0"
+                },
+                "corrupt": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.DNSChaosSpec": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action defines the specific DNS chaos action.\nSupported action: error, random\nDefault action: error\n+kubebuilder:validation:Enum=error;random",
+                    "type": "string"
+                },
+                "containerNames": {
+                    "description": "ContainerNames indicates list of the name of affected container.\nIf not set, the first container will be injected\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "duration": {
+                    "description": "Duration represents the duration of the chaos action",
+                    "type": "string"
+                },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
+                "mode": {
+                    "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
+                    "type": "string"
+                },
+                "patterns": {
+                    "description": "Choose which domain names to take effect, support the placeholder ? and wildcard *, or the Specified domain name.\nNote:\n     1. The wildcard * must be at the end of the string. For example, chaos-*.org is invalid.\n     2. if the patterns is empty, will take effect on all the domain names.\nFor example:\n\t\tThe value is [\"google.com\", \"github.*\", \"chaos-mes?.org\"],\n\t\twill take effect on \"google.com\", \"github.com\" and \"chaos-mesh.org\"\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "remoteCluster": {
+                    "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
+                    "type": "string"
+                },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
+                "selector": {
+                    "description": "Selector is used to select pods that are used to inject chaos action.",
+                    "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
+                },
+                "value": {
+                    "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.DelaySpec": {
+            "type": "object",
+            "properties": {
+                "correlation": {
+                    "description": "+optional",
+                    "type": "string",
+                    "default": "0"
+                },
+                "jitter": {
+                    "description": "+kubebuilder:validation:Pattern=\"^[0-9]+(\\\\.[0-9]+)?(ns|us|ms|s|m|h)$\"\n+optional",
+                    "type": "string",
+                    "default": "0ms"
+                },
+                "latency": {
+                    "description": "+kubebuilder:validation:Pattern=\"^[0-9]+(\\\\.[0-9]+)?(ns|us|ms|s|m|h)$\"",
+                    "type": "string"
+                },
+                "reorder": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.ReorderSpec"
+                }
+            }
+        },
+        "v1alpha1.DiskFillSpec": {
             "type": "object",
             "properties": {
                 "fill-by-fallocate": {
@@ -5482,6 +5959,19 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.ForEachSpec": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "description": "Items is the expression evaluated as the list of items, like ` + "`" + `parameters.namespaces` + "`" + ` or ` + "`" + `outputs.pick.pods` + "`" + `.\nA string result is parsed as a JSON list, or split by comma. The item and its index are available as ` + "`" + `item` + "`" + `\nand ` + "`" + `index` + "`" + ` in the templated fields of the child and its descendants.",
+                    "type": "string"
+                },
+                "parallelism": {
+                    "description": "Parallelism is the maximum number of children running at the same time. All the children run\nat the same time if it's not specified.\n+optional\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                }
+            }
+        },
         "v1alpha1.Frame": {
             "type": "object",
             "properties": {
@@ -5521,6 +6011,10 @@ const docTemplate = `{
                     "description": "Instance defines the name of the instance",
                     "type": "string"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "project": {
                     "description": "Project defines the ID of gcp project.",
                     "type": "string"
@@ -5529,6 +6023,17 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "secretName": {
                     "description": "SecretName defines the name of kubernetes secret. It is used for GCP credentials.\n+optional",
                     "type": "string"
@@ -5586,10 +6091,22 @@ const docTemplate = `{
                     "description": "Delay represents the delay of the target request/response.\nA duration string is a possibly unsigned sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\", \"2h45m\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+optional",
                     "type": "string"
                 },
+                "direction": {
+                    "description": "Direction is the direction of the traffic to be injected, \u003cInbound|Outbound\u003e.\nThe inbound traffic is received by the selected pods, and the outbound traffic is sent\nby the selected pods to their dependencies.\n+optional\n+kubebuilder:validation:Enum=Inbound;Outbound\n+kubebuilder:default=Inbound",
+                    "type": "string"
+                },
                 "duration": {
                     "description": "Duration represents the duration of the chaos action.\n+optional",
                     "type": "string"
                 },
+                "host": {
+                    "description": "Host is a rule to select target by the host in http request, which is the destination of\nthe outbound traffic.\n+optional",
+                    "type": "string"
+                },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "method": {
                     "description": "Method is a rule to select target by http method in request.\n+optional",
                     "type": "string"
@@ -5606,14 +6123,33 @@ const docTemplate = `{
                     "description": "Path is a rule to select target by uri path in http request.\n+optional",
                     "type": "string"
                 },
+                "percent": {
+                    "description": "Percent is the percentage of the selected requests or responses to be injected, in [1, 100].\nAll of them are injected if it's not set.\n+optional\n+kubebuilder:validation:Minimum=1\n+kubebuilder:validation:Maximum=100",
+                    "type": "integer"
+                },
                 "port": {
-                    "description": "Port represents the target port to be proxy of.",
+                    "description": "Port represents the target port to be proxy of.\nFor the outbound traffic, it's the port of the destination.",
                     "type": "integer"
                 },
+                "rate": {
+                    "description": "Rate limits the injections with a token bucket.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.PodHttpChaosRate"
+                },
                 "remoteCluster": {
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "replace": {
                     "description": "Replace is a rule to replace some contents in target.\n+optional",
                     "$ref": "#/definitions/v1alpha1.PodHttpChaosReplaceActions"
@@ -5625,6 +6161,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
                 "response_headers": {
                     "description": "ResponseHeaders is a rule to select target by http headers in response.\nThe key-value pairs represent header name and header value pairs.\n+optional",
                     "type": "object",
@@ -5632,6 +6172,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -5640,6 +6184,10 @@ const docTemplate = `{
                     "description": "Target is the object to be selected and injected.\n+kubebuilder:validation:Enum=Request;Response",
                     "type": "string"
                 },
+                "times": {
+                    "description": "Times is the maximum times to inject, the rule stops injecting once it's reached.\n+optional\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                },
                 "tls": {
                     "description": "TLS is the tls config,\nwill override PodHttpChaos if there are multiple HTTPChaos experiments are applied\n+optional",
                     "$ref": "#/definitions/v1alpha1.PodHttpChaosTLS"
@@ -5775,6 +6323,10 @@ const docTemplate = `{
                     "description": "Errno defines the error code that returned by I/O action.\nrefer to: https://www-numi.fnal.gov/offline_software/srt_public_context/WebDocs/Errors/unix_system_errors.html\n+ui:form:when=action=='fault'\n+optional",
                     "type": "integer"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "methods": {
                     "description": "Methods defines the I/O methods for injecting I/O chaos action.\ndefault: all I/O methods.\n+optional",
                     "type": "array",
@@ -5802,6 +6354,25 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -5816,6 +6387,48 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.IOThrottle": {
+            "type": "object",
+            "properties": {
+                "device": {
+                    "description": "Device is the block device, either the path in the container like \"/dev/sda\", or \"\u003cmajor\u003e:\u003cminor\u003e\"",
+                    "type": "string"
+                },
+                "readBytesPerSecond": {
+                    "description": "ReadBytesPerSecond limits the read bandwidth, in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. \"10MiB\"\n+optional",
+                    "type": "string"
+                },
+                "readIOPS": {
+                    "description": "ReadIOPS limits the read operations per second\n+kubebuilder:validation:Minimum=1\n+optional",
+                    "type": "integer"
+                },
+                "writeBytesPerSecond": {
+                    "description": "WriteBytesPerSecond limits the write bandwidth, in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. \"10MiB\"\n+optional",
+                    "type": "string"
+                },
+                "writeIOPS": {
+                    "description": "WriteIOPS limits the write operations per second\n+kubebuilder:validation:Minimum=1\n+optional",
+                    "type": "integer"
+                }
+            }
+        },
+        "v1alpha1.IntermittentSpec": {
+            "type": "object",
+            "properties": {
+                "jitter": {
+                    "description": "Jitter is the maximum random duration added to every on and off period.\n+optional",
+                    "type": "string"
+                },
+                "offPeriod": {
+                    "description": "OffPeriod is the duration to keep the chaos recovered in every cycle.",
+                    "type": "string"
+                },
+                "onPeriod": {
+                    "description": "OnPeriod is the duration to keep the chaos injected in every cycle.",
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.JVMChaosSpec": {
             "type": "object",
             "properties": {
@@ -5850,6 +6463,10 @@ const docTemplate = `{
                     "description": "+optional\nthe exception which needs to throw for action ` + "`" + `exception` + "`" + `\nor the exception message needs to throw in action ` + "`" + `mysql` + "`" + `",
                     "type": "string"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "latency": {
                     "description": "+optional\nthe latency duration for action 'latency', unit ms\nor the latency duration in action ` + "`" + `mysql` + "`" + `",
                     "type": "integer"
@@ -5886,10 +6503,29 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
                 "returnValue": {
                     "description": "+optional\nthe return value for action 'return'",
                     "type": "string"
                 },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "ruleData": {
                     "description": "+optional\nthe byteman rule's data for action 'ruleData'",
                     "type": "string"
@@ -6147,6 +6783,10 @@ const docTemplate = `{
                     "description": "FailKernRequest defines the request of kernel injection",
                     "$ref": "#/definitions/v1alpha1.FailKernRequest"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "mode": {
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
@@ -6155,6 +6795,25 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -6165,6 +6824,23 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.LoopSpec": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "Count is the maximum number of iterations.\n+optional\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                },
+                "interval": {
+                    "description": "Interval is the duration to wait between iterations.\n+optional",
+                    "type": "string"
+                },
+                "until": {
+                    "description": "Until is the expression evaluated after each iteration, the loop stops when it's evaluated as true.\nThe outputs of nodes, the parameters and the index of iteration are available in it.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.LossSpec": {
             "type": "object",
             "properties": {
@@ -6292,6 +6968,10 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "loss": {
                     "description": "Loss represents the detail about loss action\n+ui:form:when=action=='loss'\n+optional",
                     "$ref": "#/definitions/v1alpha1.LossSpec"
@@ -6308,6 +6988,25 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -6677,6 +7376,10 @@ const docTemplate = `{
                     "description": "+ui:form:when=action=='http-request'\n+optional",
                     "$ref": "#/definitions/v1alpha1.HTTPRequestSpec"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "jvm-exception": {
                     "description": "+ui:form:when=action=='jvm-exception'\n+optional",
                     "$ref": "#/definitions/v1alpha1.JVMExceptionSpec"
@@ -6785,6 +7488,21 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected physical machines batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select physical machines that are used to inject chaos action.\n+optional",
                     "$ref": "#/definitions/v1alpha1.PhysicalMachineSelectorSpec"
@@ -6821,44 +7539,111 @@ const docTemplate = `{
                         "type": "string"
                     }
                 },
-                "fieldSelectors": {
-                    "description": "Map of string keys and values that can be used to select objects.\nA selector based on fields.\n+optional",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "fieldSelectors": {
+                    "description": "Map of string keys and values that can be used to select objects.\nA selector based on fields.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "labelSelectors": {
+                    "description": "Map of string keys and values that can be used to select objects.\nA selector based on labels.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "namespaces": {
+                    "description": "Namespaces is a set of namespace to which objects belong.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "physicalMachines": {
+                    "description": "PhysicalMachines is a map of string keys and a set values that used to select physical machines.\nThe key defines the namespace which physical machine belong,\nand each value is a set of physical machine names.\n+optional",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "skipUnhealthy": {
+                    "description": "SkipUnhealthy skips the physical machines whose chaosd is probed to be unreachable.\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
+        "v1alpha1.PodChaosSpec": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "Action defines the specific pod chaos action.\nSupported action: pod-kill / pod-failure / container-kill\nDefault action: pod-kill\n+kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill",
+                    "type": "string"
+                },
+                "containerNames": {
+                    "description": "ContainerNames indicates list of the name of affected container.\nIf not set, the first container will be injected\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "duration": {
+                    "description": "Duration represents the duration of the chaos action.\nIt is required when the action is ` + "`" + `PodFailureAction` + "`" + `.\nA duration string is a possibly signed sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\", \"-1.5h\" or \"2h45m\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+optional",
+                    "type": "string"
+                },
+                "gracePeriod": {
+                    "description": "GracePeriod is used in pod-kill action. It represents the duration in seconds before the pod should be deleted.\nValue must be non-negative integer. The default value is zero that indicates delete immediately.\n+optional\n+kubebuilder:validation:Minimum=0",
+                    "type": "integer"
+                },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
+                "mode": {
+                    "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
+                    "type": "string"
+                },
+                "remoteCluster": {
+                    "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
+                    "type": "string"
                 },
-                "labelSelectors": {
-                    "description": "Map of string keys and values that can be used to select objects.\nA selector based on labels.\n+optional",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "string"
-                    }
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
                 },
-                "namespaces": {
-                    "description": "Namespaces is a set of namespace to which objects belong.\n+optional",
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "physicalMachines": {
-                    "description": "PhysicalMachines is a map of string keys and a set values that used to select physical machines.\nThe key defines the namespace which physical machine belong,\nand each value is a set of physical machine names.\n+optional",
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        }
-                    }
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
+                "selector": {
+                    "description": "Selector is used to select pods that are used to inject chaos action.",
+                    "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
+                },
+                "value": {
+                    "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
+                    "type": "string"
                 }
             }
         },
-        "v1alpha1.PodChaosSpec": {
+        "v1alpha1.PodFaultChaosSpec": {
             "type": "object",
             "properties": {
                 "action": {
-                    "description": "Action defines the specific pod chaos action.\nSupported action: pod-kill / pod-failure / container-kill\nDefault action: pod-kill\n+kubebuilder:validation:Enum=pod-kill;pod-failure;container-kill",
+                    "description": "Action defines the specific fault to be injected into the selected containers.\n+kubebuilder:validation:Enum=file-create;file-modify;file-delete;file-rename;file-append;file-replace;process;redis-expiration;redis-penetration;redis-cacheLimit;kafka-fill;kafka-flood;kafka-io",
                     "type": "string"
                 },
                 "containerNames": {
@@ -6869,21 +7654,92 @@ const docTemplate = `{
                     }
                 },
                 "duration": {
-                    "description": "Duration represents the duration of the chaos action.\nIt is required when the action is ` + "`" + `PodFailureAction` + "`" + `.\nA duration string is a possibly signed sequence of\ndecimal numbers, each with optional fraction and a unit suffix,\nsuch as \"300ms\", \"-1.5h\" or \"2h45m\".\nValid time units are \"ns\", \"us\" (or \"µs\"), \"ms\", \"s\", \"m\", \"h\".\n+optional",
+                    "description": "Duration represents the duration of the chaos action.\n+optional",
                     "type": "string"
                 },
-                "gracePeriod": {
-                    "description": "GracePeriod is used in pod-kill action. It represents the duration in seconds before the pod should be deleted.\nValue must be non-negative integer. The default value is zero that indicates delete immediately.\n+optional\n+kubebuilder:validation:Minimum=0",
-                    "type": "integer"
+                "file-append": {
+                    "description": "+ui:form:when=action=='file-append'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.FileAppendSpec"
+                },
+                "file-create": {
+                    "description": "+ui:form:when=action=='file-create'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.FileCreateSpec"
+                },
+                "file-delete": {
+                    "description": "+ui:form:when=action=='file-delete'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.FileDeleteSpec"
+                },
+                "file-modify": {
+                    "description": "+ui:form:when=action=='file-modify'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.FileModifyPrivilegeSpec"
+                },
+                "file-rename": {
+                    "description": "+ui:form:when=action=='file-rename'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.FileRenameSpec"
+                },
+                "file-replace": {
+                    "description": "+ui:form:when=action=='file-replace'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.FileReplaceSpec"
+                },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
+                "kafka-fill": {
+                    "description": "+ui:form:when=action=='kafka-fill'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.KafkaFillSpec"
+                },
+                "kafka-flood": {
+                    "description": "+ui:form:when=action=='kafka-flood'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.KafkaFloodSpec"
+                },
+                "kafka-io": {
+                    "description": "+ui:form:when=action=='kafka-io'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.KafkaIOSpec"
                 },
                 "mode": {
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
                 },
+                "process": {
+                    "description": "+ui:form:when=action=='process'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ProcessSpec"
+                },
+                "redis-cacheLimit": {
+                    "description": "+ui:form:when=action=='redis-cacheLimit'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RedisCacheLimitSpec"
+                },
+                "redis-expiration": {
+                    "description": "+ui:form:when=action=='redis-expiration'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RedisExpirationSpec"
+                },
+                "redis-penetration": {
+                    "description": "+ui:form:when=action=='redis-penetration'\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RedisPenetrationSpec"
+                },
                 "remoteCluster": {
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -6936,6 +7792,19 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.PodHttpChaosRate": {
+            "type": "object",
+            "properties": {
+                "burst": {
+                    "description": "Burst is the maximum number of injections allowed at once, it's the same as Limit if not set.\n+optional",
+                    "type": "integer"
+                },
+                "limit": {
+                    "description": "Limit is the number of injections allowed per second.",
+                    "type": "integer"
+                }
+            }
+        },
         "v1alpha1.PodHttpChaosReplaceActions": {
             "type": "object",
             "properties": {
@@ -7006,6 +7875,14 @@ const docTemplate = `{
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
                 },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -7235,6 +8112,61 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.ReselectSpec": {
+            "type": "object",
+            "properties": {
+                "interval": {
+                    "description": "Interval is the period to select the targets again.\n+optional\n+kubebuilder:default=\"1m\"",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.RetrySpec": {
+            "type": "object",
+            "properties": {
+                "backoff": {
+                    "description": "Backoff is the duration to wait before the first retry.\n+optional",
+                    "type": "string"
+                },
+                "expression": {
+                    "description": "Expression decides whether an attempt succeeds, the outputs of nodes and the parameters are available in it.\nIf it's empty, an attempt succeeds unless it's aborted, it's a task exiting with non-zero code, or it's\na status check exceeding the failure threshold.\n+optional",
+                    "type": "string"
+                },
+                "factor": {
+                    "description": "Factor is the multiplier applied to the backoff after each retry.\n+optional\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                },
+                "maxAttempts": {
+                    "description": "MaxAttempts is the maximum number of attempts, including the first one.\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                },
+                "maxBackoff": {
+                    "description": "MaxBackoff is the upper limit of the backoff.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.RolloutSpec": {
+            "type": "object",
+            "properties": {
+                "batchSize": {
+                    "description": "BatchSize is the number of targets to be injected in every batch.\n+kubebuilder:validation:Minimum=1",
+                    "type": "integer"
+                },
+                "interval": {
+                    "description": "Interval is the duration to wait after a batch has been injected, before injecting the next batch.\n+optional",
+                    "type": "string"
+                },
+                "statusCheck": {
+                    "description": "StatusCheck is the name of a StatusCheck in the namespace of the chaos. If it's set, the next batch is\ninjected only if the status check hasn't failed, and the rollout is halted once the status check fails.\nA ` + "`" + `Synchronous` + "`" + ` status check has to be completed before injecting the next batch.\n+optional",
+                    "type": "string"
+                },
+                "stopOnFailure": {
+                    "description": "StopOnFailure stops injecting the following batches once a target of the current batch fails to be\ninjected.\n+optional",
+                    "type": "boolean"
+                }
+            }
+        },
         "v1alpha1.Schedule": {
             "type": "object",
             "properties": {
@@ -7345,6 +8277,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.BlockChaosSpec"
                 },
+                "compositeChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.CompositeChaosSpec"
+                },
                 "concurrencyPolicy": {
                     "description": "+optional\n+kubebuilder:default=Forbid\n+kubebuilder:validation:Enum=Forbid;Allow",
                     "type": "string"
@@ -7389,6 +8325,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.PodChaosSpec"
                 },
+                "podfaultChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.PodFaultChaosSpec"
+                },
                 "schedule": {
                     "type": "string"
                 },
@@ -7545,6 +8485,10 @@ const docTemplate = `{
                     "description": "Duration represents the duration of the chaos action\n+optional",
                     "type": "string"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "mode": {
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
@@ -7553,6 +8497,25 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -7565,6 +8528,10 @@ const docTemplate = `{
                     "description": "Stressors defines plenty of stressors supported to stress system components out.\nYou can use one or more of them to make up various kinds of stresses. At least\none of the stressors should be specified.\n+optional",
                     "$ref": "#/definitions/v1alpha1.Stressors"
                 },
+                "throttle": {
+                    "description": "Throttle limits the resources of the target containers through the cgroup v2 interfaces, rather than\nstressing them out. It could be used together with the stressors, and requires the nodes to use cgroup v2.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.CgroupThrottle"
+                },
                 "value": {
                     "description": "Value is required when the mode is set to ` + "`" + `FixedMode` + "`" + ` / ` + "`" + `FixedPercentMode` + "`" + ` / ` + "`" + `RandomMaxPercentMode` + "`" + `.\nIf ` + "`" + `FixedMode` + "`" + `, provide an integer of pods to do chaos action.\nIf ` + "`" + `FixedPercentMode` + "`" + `, provide a number from 0-100 to specify the percent of pods the server can do chaos action.\nIF ` + "`" + `RandomMaxPercentMode` + "`" + `,  provide a number from 0-100 to specify the max percent of pods to do chaos action\n+optional",
                     "type": "string"
@@ -7607,6 +8574,13 @@ const docTemplate = `{
                     "description": "Container is the main container image to run in the pod",
                     "$ref": "#/definitions/v1.Container"
                 },
+                "outputs": {
+                    "description": "Outputs describes the named outputs published by the task, they could be referenced as\n` + "`" + `outputs.\u003ctemplate name\u003e.\u003coutput name\u003e` + "`" + ` in the expressions of conditional branches and\nthe templated fields of the following nodes.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.TaskOutput"
+                    }
+                },
                 "volumes": {
                     "description": "Volumes is a list of volumes that can be mounted by containers in a template.\n+patchStrategy=merge\n+patchMergeKey=name",
                     "type": "array",
@@ -7616,6 +8590,27 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.TaskOutput": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "From is the source of the output, the content of the source is parsed as JSON,\nor used as a plain string if it's not a valid JSON.\n+optional\n+kubebuilder:validation:Enum=Stdout;File\n+kubebuilder:default=Stdout",
+                    "type": "string"
+                },
+                "key": {
+                    "description": "Key selects the value with the key from the parsed JSON object.\nThe whole parsed content is used if it's empty.\n+optional",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of the output.",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path is the path of the file which the output is read from. Only used when From is File.\nThe file is used as the termination message of the container, so all the outputs\nof a task share the same file, and its size should not exceed 4096 bytes.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.Template": {
             "type": "object",
             "properties": {
@@ -7636,12 +8631,16 @@ const docTemplate = `{
                     "$ref": "#/definitions/v1alpha1.BlockChaosSpec"
                 },
                 "children": {
-                    "description": "Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel.\n+optional",
+                    "description": "Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial, TypeParallel,\nTypeRetry, TypeLoop or TypeForEach. The last three types accept exactly one child.\n+optional",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "compositeChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.CompositeChaosSpec"
+                },
                 "conditionalBranches": {
                     "description": "ConditionalBranches describes the conditional branches of custom tasks. Only used when Type is TypeTask.\n+optional",
                     "type": "array",
@@ -7657,6 +8656,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.DNSChaosSpec"
                 },
+                "forEach": {
+                    "description": "ForEach describes the behavior of ForEach. Only used when Type is TypeForEach.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ForEachSpec"
+                },
                 "gcpChaos": {
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.GCPChaosSpec"
@@ -7677,6 +8680,10 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.KernelChaosSpec"
                 },
+                "loop": {
+                    "description": "Loop describes the behavior of Loop. Only used when Type is TypeLoop.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.LoopSpec"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -7692,6 +8699,14 @@ const docTemplate = `{
                     "description": "+optional",
                     "$ref": "#/definitions/v1alpha1.PodChaosSpec"
                 },
+                "podfaultChaos": {
+                    "description": "+optional",
+                    "$ref": "#/definitions/v1alpha1.PodFaultChaosSpec"
+                },
+                "retry": {
+                    "description": "Retry describes the behavior of Retry. Only used when Type is TypeRetry.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RetrySpec"
+                },
                 "schedule": {
                     "description": "Schedule describe the Schedule(describing scheduled chaos) to be injected with chaos nodes. Only used when Type is TypeSchedule.\n+optional",
                     "$ref": "#/definitions/v1alpha1.ChaosOnlyScheduleSpec"
@@ -7738,6 +8753,10 @@ const docTemplate = `{
                     "description": "Duration represents the duration of the chaos action",
                     "type": "string"
                 },
+                "intermittent": {
+                    "description": "Intermittent injects and recovers the chaos repeatedly during the experiment, rather than keeping it\ninjected all the time.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.IntermittentSpec"
+                },
                 "mode": {
                     "description": "Mode defines the mode to run chaos action.\nSupported mode: one / all / fixed / fixed-percent / random-max-percent\n+kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent",
                     "type": "string"
@@ -7746,6 +8765,25 @@ const docTemplate = `{
                     "description": "RemoteCluster represents the remote cluster where the chaos will be deployed\n+optional",
                     "type": "string"
                 },
+                "remoteClusterSelector": {
+                    "description": "RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,\nit could be used together with RemoteClusters\n+optional",
+                    "$ref": "#/definitions/v1.LabelSelector"
+                },
+                "remoteClusters": {
+                    "description": "RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be\nused together with RemoteCluster\n+optional",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reselect": {
+                    "description": "Reselect selects the pods again periodically while the chaos is running, injects the chaos into the new\nmatched pods and drops the pods which are gone, keeping the number of pods required by the mode.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.ReselectSpec"
+                },
+                "rollout": {
+                    "description": "Rollout injects the chaos into the selected pods batch by batch, rather than all at once.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.RolloutSpec"
+                },
                 "selector": {
                     "description": "Selector is used to select pods that are used to inject chaos action.",
                     "$ref": "#/definitions/v1alpha1.PodSelectorSpec"
@@ -7906,17 +8944,51 @@ const docTemplate = `{
                 }
             }
         },
+        "v1alpha1.WorkflowParameter": {
+            "type": "object",
+            "properties": {
+                "default": {
+                    "description": "Default is the default value of parameter, it's used when Value is not specified.\n+optional",
+                    "type": "string"
+                },
+                "description": {
+                    "description": "Description describes the usage of parameter.\n+optional",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name is the name of parameter, it should be a valid identifier.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value is the value of parameter.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
         "v1alpha1.WorkflowSpec": {
             "type": "object",
             "properties": {
                 "entry": {
-                    "type": "string"
+                    "type": "string",
+                    "description": "Entry is the name of the template to start with, it's required unless WorkflowTemplateRef is specified.\n+optional"
+                },
+                "parameters": {
+                    "description": "Parameters describes the parameters of workflow, they could be referenced as ` + "`" + `parameters.\u003cname\u003e` + "`" + `\nin the placeholders of the string fields of the chaos, schedule and status check in templates,\nand the deadline of templates.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.WorkflowParameter"
+                    }
                 },
                 "templates": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/v1alpha1.Template"
-                    }
+                    },
+                    "description": "Templates describes the templates of workflow, they are required unless WorkflowTemplateRef is specified.\n+optional"
+                },
+                "workflowTemplateRef": {
+                    "description": "WorkflowTemplateRef refers to a reusable template. If Templates is empty, the entry, templates and\nparameters of the referenced template are resolved into the workflow before it's scheduled, and the\nparameters specified in the workflow override the ones of the template.\n+optional",
+                    "$ref": "#/definitions/v1alpha1.WorkflowTemplateRef"
                 }
             }
         },
@@ -7943,6 +9015,44 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "v1alpha1.WorkflowTemplateRef": {
+            "type": "object",
+            "properties": {
+                "clusterScope": {
+                    "description": "ClusterScope means the referenced template is a ClusterWorkflowTemplate.\n+optional",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name is the name of the referenced template.",
+                    "type": "string"
+                }
+            }
+        },
+        "v1alpha1.WorkflowTemplateSpec": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "description": "Description describes the scenario of the template.\n+optional",
+                    "type": "string"
+                },
+                "entry": {
+                    "type": "string"
+                },
+                "parameters": {
+                    "description": "Parameters describes the parameters of the template, they could be overridden by the\nparameters of the workflows referencing the template.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.WorkflowParameter"
+                    }
+                },
+                "templates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1alpha1.Template"
+                    }
+                }
+            }
         }
     }
 }`
@@ -16,6 +16,10 @@
 package expr
 
 import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
 	"github.com/antonmedv/expr"
 	"github.com/pkg/errors"
 )
@@ -31,3 +35,36 @@ func EvalBool(expression string, env map[string]interface{}) (bool, error) {
 	}
 	return result, nil
 }
+
+// EvalList evaluates the expression as a list. A string result is parsed as a JSON list, or split by
+// comma if it's not a valid JSON list.
+func EvalList(expression string, env map[string]interface{}) ([]interface{}, error) {
+	eval, err := expr.Eval(expression, env)
+	if err != nil {
+		return nil, err
+	}
+	if text, ok := eval.(string); ok {
+		text = strings.TrimSpace(text)
+		if len(text) == 0 {
+			return nil, nil
+		}
+		var list []interface{}
+		if err := json.Unmarshal([]byte(text), &list); err == nil {
+			return list, nil
+		}
+		for _, item := range strings.Split(text, ",") {
+			list = append(list, strings.TrimSpace(item))
+		}
+		return list, nil
+	}
+
+	value := reflect.ValueOf(eval)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, errors.New("expression result is not a list")
+	}
+	result := make([]interface{}, 0, value.Len())
+	for i := 0; i < value.Len(); i++ {
+		result = append(result, value.Index(i).Interface())
+	}
+	return result, nil
+}
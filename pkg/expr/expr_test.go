@@ -15,7 +15,10 @@
 
 package expr
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestEvalBool(t *testing.T) {
 	type args struct {
@@ -99,3 +102,64 @@ func TestEvalBool(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalList(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		env        map[string]interface{}
+		want       []interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "list literal",
+			expression: `["a", "b"]`,
+			want:       []interface{}{"a", "b"},
+		}, {
+			name:       "list from outputs",
+			expression: "outputs.pick.pods",
+			env: map[string]interface{}{
+				"outputs": map[string]interface{}{
+					"pick": map[string]interface{}{
+						"pods": []interface{}{"pod-a", "pod-b"},
+					},
+				},
+			},
+			want: []interface{}{"pod-a", "pod-b"},
+		}, {
+			name:       "JSON list in string",
+			expression: "parameters.namespaces",
+			env: map[string]interface{}{
+				"parameters": map[string]interface{}{"namespaces": `["ns-a", "ns-b"]`},
+			},
+			want: []interface{}{"ns-a", "ns-b"},
+		}, {
+			name:       "comma separated string",
+			expression: "parameters.namespaces",
+			env: map[string]interface{}{
+				"parameters": map[string]interface{}{"namespaces": "ns-a, ns-b"},
+			},
+			want: []interface{}{"ns-a", "ns-b"},
+		}, {
+			name:       "empty string",
+			expression: `""`,
+			want:       nil,
+		}, {
+			name:       "not a list",
+			expression: "1 + 1",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EvalList(tt.expression, tt.env)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EvalList() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EvalList() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package expr
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/antonmedv/expr"
+	"github.com/pkg/errors"
+)
+
+var placeholder = regexp.MustCompile(`\{\{\s*(.+?)\s*\}\}`)
+
+// IsTemplated returns whether the text contains any placeholder like `{{ expression }}`
+func IsTemplated(text string) bool {
+	return placeholder.MatchString(text)
+}
+
+// RenderString replaces each placeholder like `{{ expression }}` in the text with
+// the evaluation result of the expression.
+func RenderString(text string, env map[string]interface{}) (string, error) {
+	var renderErr error
+	result := placeholder.ReplaceAllStringFunc(text, func(matched string) string {
+		if renderErr != nil {
+			return matched
+		}
+		expression := placeholder.FindStringSubmatch(matched)[1]
+		value, err := expr.Eval(expression, env)
+		if err != nil {
+			renderErr = errors.Wrapf(err, "evaluate expression %s", expression)
+			return matched
+		}
+		if value == nil {
+			renderErr = errors.Errorf("expression %s is evaluated as nil", expression)
+			return matched
+		}
+		return stringify(value)
+	})
+	if renderErr != nil {
+		return "", renderErr
+	}
+	return result, nil
+}
+
+// RenderObject renders all the templated strings in the object, the object should
+// be a pointer which could be marshaled to and unmarshaled from JSON.
+func RenderObject(obj interface{}, env map[string]interface{}) error {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return errors.Wrap(err, "marshal object")
+	}
+	if !IsTemplated(string(data)) {
+		return nil
+	}
+
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return errors.Wrap(err, "unmarshal object")
+	}
+	rendered, err := renderValue(raw, env)
+	if err != nil {
+		return err
+	}
+	if data, err = json.Marshal(rendered); err != nil {
+		return errors.Wrap(err, "marshal rendered object")
+	}
+
+	// reset the object, or the original keys of maps would be kept after unmarshaling
+	target := reflect.ValueOf(obj)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return errors.Errorf("object should be a non-nil pointer, but got %T", obj)
+	}
+	target.Elem().Set(reflect.Zero(target.Elem().Type()))
+	return errors.Wrap(json.Unmarshal(data, obj), "unmarshal rendered object")
+}
+
+func renderValue(value interface{}, env map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return RenderString(v, env)
+	case []interface{}:
+		for i, item := range v {
+			rendered, err := renderValue(item, env)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = rendered
+		}
+		return v, nil
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			renderedKey, err := RenderString(key, env)
+			if err != nil {
+				return nil, err
+			}
+			rendered, err := renderValue(item, env)
+			if err != nil {
+				return nil, err
+			}
+			result[renderedKey] = rendered
+		}
+		return result, nil
+	default:
+		return v, nil
+	}
+}
+
+func stringify(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []interface{}, map[string]interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(data)
+	default:
+		return strings.TrimSpace(fmt.Sprint(v))
+	}
+}
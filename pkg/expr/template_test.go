@@ -0,0 +1,110 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package expr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestRenderString(t *testing.T) {
+	env := map[string]interface{}{
+		"outputs": map[string]interface{}{
+			"pick": map[string]interface{}{
+				"victim": "web-0",
+				"count":  float64(2),
+				"pods":   []interface{}{"web-0", "web-1"},
+			},
+		},
+	}
+	tests := []struct {
+		name    string
+		text    string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "plain text",
+			text: "default",
+			want: "default",
+		}, {
+			name: "string value",
+			text: "{{ outputs.pick.victim }}",
+			want: "web-0",
+		}, {
+			name: "multiple placeholders",
+			text: "{{outputs.pick.victim}}-{{ outputs.pick.count }}",
+			want: "web-0-2",
+		}, {
+			name: "list value",
+			text: "{{ outputs.pick.pods }}",
+			want: `["web-0","web-1"]`,
+		}, {
+			name:    "missing value",
+			text:    "{{ outputs.pick.missing }}",
+			wantErr: true,
+		}, {
+			name:    "invalid expression",
+			text:    "{{ outputs.pick. }}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RenderString(tt.text, env)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RenderString() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("RenderString() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderObject(t *testing.T) {
+	type selector struct {
+		Namespaces []string            `json:"namespaces"`
+		Pods       map[string][]string `json:"pods"`
+		Duration   string              `json:"duration"`
+	}
+	env := map[string]interface{}{
+		"outputs": map[string]interface{}{
+			"pick": map[string]interface{}{
+				"namespace": "app",
+				"victim":    "web-0",
+			},
+		},
+	}
+	obj := &selector{
+		Namespaces: []string{"{{ outputs.pick.namespace }}"},
+		Pods:       map[string][]string{"{{ outputs.pick.namespace }}": {"{{ outputs.pick.victim }}"}},
+		Duration:   "30s",
+	}
+	want := &selector{
+		Namespaces: []string{"app"},
+		Pods:       map[string][]string{"app": {"web-0"}},
+		Duration:   "30s",
+	}
+
+	if err := RenderObject(obj, env); err != nil {
+		t.Fatalf("RenderObject() error = %v", err)
+	}
+	if !reflect.DeepEqual(obj, want) {
+		t.Errorf("RenderObject() got = %v, want %v", obj, want)
+	}
+}
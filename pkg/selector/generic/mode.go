@@ -94,6 +94,37 @@ func FilterObjectsByMode(mode v1alpha1.SelectorMode, value string, count int) ([
 	}
 }
 
+// MaxObjectsByMode returns the maximum number of objects which could be selected
+// by the mode from `count` candidates.
+func MaxObjectsByMode(mode v1alpha1.SelectorMode, value string, count int) (int, error) {
+	switch mode {
+	case v1alpha1.OneMode:
+		if count == 0 {
+			return 0, nil
+		}
+		return 1, nil
+	case v1alpha1.AllMode:
+		return count, nil
+	case v1alpha1.FixedMode:
+		num, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, err
+		}
+		if count < num {
+			num = count
+		}
+		return num, nil
+	case v1alpha1.FixedPercentMode, v1alpha1.RandomMaxPercentMode:
+		percentage, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, err
+		}
+		return int(math.Ceil(float64(count) * float64(percentage) / 100)), nil
+	default:
+		return 0, errors.Errorf("mode %s not supported", mode)
+	}
+}
+
 // RandomFixedIndexes returns the `count` random indexes between `start` and `end`.
 // [start, end)
 func RandomFixedIndexes(start, end, count uint) []uint {
@@ -19,6 +19,8 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 )
 
 func TestRandomFixedIndexes(t *testing.T) {
@@ -66,3 +68,33 @@ func TestRandomFixedIndexes(t *testing.T) {
 		}
 	}
 }
+
+func TestMaxObjectsByMode(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	type TestCase struct {
+		mode     v1alpha1.SelectorMode
+		value    string
+		count    int
+		expected int
+	}
+
+	tcs := []TestCase{
+		{mode: v1alpha1.OneMode, count: 10, expected: 1},
+		{mode: v1alpha1.OneMode, count: 0, expected: 0},
+		{mode: v1alpha1.AllMode, count: 10, expected: 10},
+		{mode: v1alpha1.FixedMode, value: "3", count: 10, expected: 3},
+		{mode: v1alpha1.FixedMode, value: "12", count: 10, expected: 10},
+		{mode: v1alpha1.FixedPercentMode, value: "25", count: 10, expected: 3},
+		{mode: v1alpha1.RandomMaxPercentMode, value: "50", count: 10, expected: 5},
+	}
+
+	for _, tc := range tcs {
+		num, err := MaxObjectsByMode(tc.mode, tc.value, tc.count)
+		g.Expect(err).ToNot(HaveOccurred())
+		g.Expect(num).To(Equal(tc.expected), string(tc.mode))
+	}
+
+	_, err := MaxObjectsByMode(v1alpha1.FixedMode, "abc", 10)
+	g.Expect(err).To(HaveOccurred())
+}
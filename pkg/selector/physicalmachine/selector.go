@@ -129,21 +129,47 @@ func SelectAndFilterPhysicalMachines(ctx context.Context, c client.Client, r cli
 func SelectPhysicalMachines(ctx context.Context, c client.Client, r client.Reader,
 	selector v1alpha1.PhysicalMachineSelectorSpec,
 	clusterScoped bool, targetNamespace string, enableFilterNamespace bool, logger logr.Logger) ([]v1alpha1.PhysicalMachine, error) {
+	var physicalMachines []v1alpha1.PhysicalMachine
 	if len(selector.PhysicalMachines) > 0 {
-		return selectSpecifiedPhysicalMachines(ctx, c, selector, clusterScoped, targetNamespace, enableFilterNamespace, logger)
+		var err error
+		physicalMachines, err = selectSpecifiedPhysicalMachines(ctx, c, selector, clusterScoped, targetNamespace, enableFilterNamespace, logger)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		selectorRegistry := newSelectorRegistry()
+		selectorChain, err := registry.Parse(selectorRegistry, selector.GenericSelectorSpec, generic.Option{
+			ClusterScoped:         clusterScoped,
+			TargetNamespace:       targetNamespace,
+			EnableFilterNamespace: enableFilterNamespace,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		physicalMachines, err = listPhysicalMachines(ctx, c, r, selector, selectorChain, enableFilterNamespace, logger)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	selectorRegistry := newSelectorRegistry()
-	selectorChain, err := registry.Parse(selectorRegistry, selector.GenericSelectorSpec, generic.Option{
-		ClusterScoped:         clusterScoped,
-		TargetNamespace:       targetNamespace,
-		EnableFilterNamespace: enableFilterNamespace,
-	})
-	if err != nil {
-		return nil, err
+	if selector.SkipUnhealthy {
+		physicalMachines = skipUnhealthyPhysicalMachines(physicalMachines, logger)
 	}
+	return physicalMachines, nil
+}
 
-	return listPhysicalMachines(ctx, c, r, selector, selectorChain, enableFilterNamespace, logger)
+// skipUnhealthyPhysicalMachines removes the physical machines whose chaosd is probed to be unreachable
+func skipUnhealthyPhysicalMachines(physicalMachines []v1alpha1.PhysicalMachine, logger logr.Logger) []v1alpha1.PhysicalMachine {
+	var healthy []v1alpha1.PhysicalMachine
+	for _, physicalMachine := range physicalMachines {
+		if physicalMachine.IsUnhealthy() {
+			logger.Info("skip unhealthy physical machine", "namespace", physicalMachine.Namespace, "name", physicalMachine.Name)
+			continue
+		}
+		healthy = append(healthy, physicalMachine)
+	}
+	return healthy
 }
 
 func listPhysicalMachines(ctx context.Context, c client.Client, r client.Reader, spec v1alpha1.PhysicalMachineSelectorSpec,
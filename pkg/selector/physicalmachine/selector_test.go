@@ -20,6 +20,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/kubectl/pkg/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,6 +40,14 @@ func TestSelectPhysicalMachines(t *testing.T) {
 	objects = append(objects, objects2...)
 	physicalMachines = append(physicalMachines, physicalMachines2...)
 
+	// p1 is probed to be unreachable, and s0 has never been probed
+	objects[1].(*v1alpha1.PhysicalMachine).Status.Conditions = []v1alpha1.PhysicalMachineCondition{
+		{
+			Type:   v1alpha1.PhysicalMachineConditionReachable,
+			Status: corev1.ConditionFalse,
+		},
+	}
+
 	err := v1alpha1.SchemeBuilder.AddToScheme(scheme.Scheme)
 	g.Expect(err).NotTo(HaveOccurred())
 
@@ -126,6 +135,36 @@ func TestSelectPhysicalMachines(t *testing.T) {
 			},
 			expected: nil,
 		},
+		{
+			name: "skip unhealthy physical machines",
+			selector: v1alpha1.PhysicalMachineSelectorSpec{
+				GenericSelectorSpec: v1alpha1.GenericSelectorSpec{
+					LabelSelectors: map[string]string{"l1": "l1"},
+				},
+				SkipUnhealthy: true,
+			},
+			expected: []v1alpha1.PhysicalMachine{physicalMachines[0], physicalMachines[2], physicalMachines[3], physicalMachines[4]},
+		},
+		{
+			name: "skip unhealthy specified physical machines",
+			selector: v1alpha1.PhysicalMachineSelectorSpec{
+				PhysicalMachines: map[string][]string{
+					metav1.NamespaceDefault: {"p0", "p1"},
+					"test-s":                {"s0"},
+				},
+				SkipUnhealthy: true,
+			},
+			expected: []v1alpha1.PhysicalMachine{physicalMachines[0], physicalMachines[5]},
+		},
+		{
+			name: "select unhealthy physical machines without skipping",
+			selector: v1alpha1.PhysicalMachineSelectorSpec{
+				PhysicalMachines: map[string][]string{
+					metav1.NamespaceDefault: {"p0", "p1"},
+				},
+			},
+			expected: []v1alpha1.PhysicalMachine{physicalMachines[0], physicalMachines[1]},
+		},
 	}
 
 	var (
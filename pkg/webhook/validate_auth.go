@@ -28,9 +28,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	authorizationv1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	workflowtemplate "github.com/chaos-mesh/chaos-mesh/pkg/workflow/template"
 )
 
 var alwaysAllowedKind = []string{
@@ -42,6 +44,7 @@ var alwaysAllowedKind = []string{
 	v1alpha1.KindPhysicalMachine,
 	v1alpha1.KindStatusCheck,
 	v1alpha1.KindRemoteCluster,
+	v1alpha1.KindChaosPolicy,
 
 	"WorkflowNode",
 }
@@ -52,6 +55,7 @@ var alwaysAllowedKind = []string{
 type AuthValidator struct {
 	enabled bool
 	authCli *authorizationv1.AuthorizationV1Client
+	reader  client.Reader
 
 	decoder *admission.Decoder
 
@@ -62,11 +66,12 @@ type AuthValidator struct {
 }
 
 // NewAuthValidator returns a new AuthValidator
-func NewAuthValidator(enabled bool, authCli *authorizationv1.AuthorizationV1Client, decoderScheme *runtime.Scheme,
+func NewAuthValidator(enabled bool, authCli *authorizationv1.AuthorizationV1Client, reader client.Reader, decoderScheme *runtime.Scheme,
 	clusterScoped bool, targetNamespace string, enableFilterNamespace bool, logger logr.Logger) *AuthValidator {
 	return &AuthValidator{
 		enabled:               enabled,
 		authCli:               authCli,
+		reader:                reader,
 		decoder:               admission.NewDecoder(decoderScheme),
 		clusterScoped:         clusterScoped,
 		targetNamespace:       targetNamespace,
@@ -77,7 +82,9 @@ func NewAuthValidator(enabled bool, authCli *authorizationv1.AuthorizationV1Clie
 
 // AuthValidator admits a pod iff a specific annotation exists.
 func (v *AuthValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
-	if !v.enabled {
+	// the workflows referring a template are always resolved, because the templates are only validated
+	// against the parameters of the workflow here
+	if !v.enabled && req.Kind.Kind != v1alpha1.KindWorkflow {
 		return admission.Allowed("")
 	}
 
@@ -89,22 +96,46 @@ func (v *AuthValidator) Handle(ctx context.Context, req admission.Request) admis
 		return admission.Allowed(fmt.Sprintf("skip the RBAC check for type %s", requestKind))
 	}
 
-	kind, ok := v1alpha1.AllKindsIncludeScheduleAndWorkflow()[requestKind]
-	if !ok {
-		err := errors.Wrapf(errInvalidValue, "kind %s is not support", requestKind)
-		return admission.Errored(http.StatusBadRequest, err)
+	// the workflow templates are checked like the workflows, since the workflows referencing them
+	// are not checked against the namespaces affected by the templates
+	authKind := requestKind
+	var chaos runtime.Object
+	switch requestKind {
+	case v1alpha1.KindWorkflowTemplate:
+		authKind = v1alpha1.KindWorkflow
+		chaos = &v1alpha1.WorkflowTemplate{}
+	case v1alpha1.KindClusterWorkflowTemplate:
+		authKind = v1alpha1.KindWorkflow
+		chaos = &v1alpha1.ClusterWorkflowTemplate{}
+	default:
+		kind, ok := v1alpha1.AllKindsIncludeScheduleAndWorkflow()[requestKind]
+		if !ok {
+			err := errors.Wrapf(errInvalidValue, "kind %s is not support", requestKind)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		chaos = kind.SpawnObject()
 	}
-	chaos := kind.SpawnObject()
 
 	err := v.decoder.Decode(req, chaos)
 	if err != nil {
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
+	if workflow, ok := chaos.(*v1alpha1.Workflow); ok && workflowtemplate.NeedResolve(*workflow) {
+		resolved, err := v.resolveWorkflow(ctx, workflow)
+		if err != nil {
+			return admission.Denied(err.Error())
+		}
+		chaos = resolved
+	}
+	if !v.enabled {
+		return admission.Allowed("")
+	}
+
 	requireClusterPrivileges, affectedNamespaces := affectedNamespaces(chaos)
 
 	if requireClusterPrivileges {
-		allow, err := v.auth(req.UserInfo, "", requestKind)
+		allow, err := v.auth(req.UserInfo, "", authKind)
 		if err != nil {
 			return admission.Errored(http.StatusBadRequest, err)
 		}
@@ -117,7 +148,7 @@ func (v *AuthValidator) Handle(ctx context.Context, req admission.Request) admis
 		v.logger.Info("start validating user", "user", username, "groups", groups, "namespace", affectedNamespaces)
 
 		for namespace := range affectedNamespaces {
-			allow, err := v.auth(req.UserInfo, namespace, requestKind)
+			allow, err := v.auth(req.UserInfo, namespace, authKind)
 			if err != nil {
 				return admission.Errored(http.StatusBadRequest, err)
 			}
@@ -133,6 +164,28 @@ func (v *AuthValidator) Handle(ctx context.Context, req admission.Request) admis
 	return admission.Allowed("")
 }
 
+// resolveWorkflow validates the template referenced by the workflow and renders it with the parameters of the
+// workflow, so the namespaces affected by the template are checked like the ones of an inline workflow.
+func (v *AuthValidator) resolveWorkflow(ctx context.Context, workflow *v1alpha1.Workflow) (*v1alpha1.Workflow, error) {
+	if v.reader == nil {
+		return nil, errors.Errorf("cannot resolve the template %s of workflow", workflow.Spec.WorkflowTemplateRef.Name)
+	}
+
+	resolved, err := workflowtemplate.Resolve(ctx, v.reader, *workflow)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolve workflow template")
+	}
+	if _, err := resolved.ValidateCreate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid workflow template %s", workflow.Spec.WorkflowTemplateRef.Name)
+	}
+	templates, err := resolved.Spec.RenderTemplates()
+	if err != nil {
+		return nil, errors.Wrapf(err, "render workflow template %s", workflow.Spec.WorkflowTemplateRef.Name)
+	}
+	resolved.Spec.Templates = templates
+	return resolved, nil
+}
+
 func (v *AuthValidator) auth(userInfo authnv1.UserInfo, namespace string, chaosKind string) (bool, error) {
 	resourceName, err := v.resourceFor(chaosKind)
 	if err != nil {
@@ -0,0 +1,138 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func newWorkflowTemplate(entry string) *v1alpha1.WorkflowTemplate {
+	namespace := "default-ns"
+	return &v1alpha1.WorkflowTemplate{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "pod-kill"},
+		Spec: v1alpha1.WorkflowTemplateSpec{
+			Entry: entry,
+			Templates: []v1alpha1.Template{{
+				Name: "kill",
+				Type: v1alpha1.TypePodChaos,
+				EmbedChaos: &v1alpha1.EmbedChaos{
+					PodChaos: &v1alpha1.PodChaosSpec{
+						ContainerSelector: v1alpha1.ContainerSelector{
+							PodSelector: v1alpha1.PodSelector{
+								Selector: v1alpha1.PodSelectorSpec{
+									GenericSelectorSpec: v1alpha1.GenericSelectorSpec{
+										Namespaces: []string{"{{ parameters.namespace }}"},
+									},
+								},
+								Mode: v1alpha1.OneMode,
+							},
+						},
+						Action: v1alpha1.PodKillAction,
+					},
+				},
+			}},
+			Parameters: []v1alpha1.WorkflowParameter{{Name: "namespace", Default: &namespace}},
+		},
+	}
+}
+
+func newWorkflowRequest(t *testing.T, workflow *v1alpha1.Workflow) admission.Request {
+	raw, err := json.Marshal(workflow)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Kind:   metav1.GroupVersionKind{Group: "chaos-mesh.org", Version: "v1alpha1", Kind: v1alpha1.KindWorkflow},
+			Object: runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func TestAuthValidatorResolveWorkflow(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	target := "target-ns"
+	workflow := &v1alpha1.Workflow{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "workflow"},
+		Spec: v1alpha1.WorkflowSpec{
+			WorkflowTemplateRef: &v1alpha1.WorkflowTemplateRef{Name: "pod-kill"},
+			Parameters:          []v1alpha1.WorkflowParameter{{Name: "namespace", Value: &target}},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		template   *v1alpha1.WorkflowTemplate
+		wantErr    bool
+		namespaces map[string]struct{}
+	}{
+		{
+			name:       "rendered with the parameters of workflow",
+			template:   newWorkflowTemplate("kill"),
+			namespaces: map[string]struct{}{target: {}},
+		}, {
+			name:    "template not found",
+			wantErr: true,
+		}, {
+			name:     "invalid template",
+			template: newWorkflowTemplate("missing"),
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewWithT(t)
+
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tt.template != nil {
+				builder = builder.WithObjects(tt.template)
+			}
+			validator := NewAuthValidator(false, nil, builder.Build(), scheme, true, "", false, logr.Discard())
+
+			resolved, err := validator.resolveWorkflow(context.Background(), workflow)
+			if tt.wantErr {
+				g.Expect(err).To(gomega.HaveOccurred())
+				// the workflows referring an unresolvable template are rejected even if the auth is disabled
+				response := validator.Handle(context.Background(), newWorkflowRequest(t, workflow))
+				g.Expect(response.Allowed).To(gomega.BeFalse())
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(resolved.Spec.Entry).To(gomega.Equal("kill"))
+			_, namespaces := affectedNamespaces(resolved)
+			g.Expect(namespaces).To(gomega.Equal(tt.namespaces))
+
+			response := validator.Handle(context.Background(), newWorkflowRequest(t, workflow))
+			g.Expect(response.Allowed).To(gomega.BeTrue())
+		})
+	}
+}
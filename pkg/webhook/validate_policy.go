@@ -0,0 +1,96 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaospolicy"
+)
+
+// +kubebuilder:webhook:path=/validate-policy,mutating=false,failurePolicy=fail,groups=chaos-mesh.org,resources=*,verbs=create;update,versions=v1alpha1,name=vpolicy.kb.io
+
+// PolicyValidator rejects the chaos which violates the ChaosPolicies
+type PolicyValidator struct {
+	checker *chaospolicy.Checker
+	decoder *admission.Decoder
+	logger  logr.Logger
+}
+
+// NewPolicyValidator returns a new PolicyValidator
+func NewPolicyValidator(checker *chaospolicy.Checker, decoderScheme *runtime.Scheme, logger logr.Logger) *PolicyValidator {
+	return &PolicyValidator{
+		checker: checker,
+		decoder: admission.NewDecoder(decoderScheme),
+		logger:  logger,
+	}
+}
+
+// Handle admits a chaos iff it doesn't violate any ChaosPolicy governing it. An update is checked only if it
+// would start the chaos again, e.g. removing the pause annotation.
+func (v *PolicyValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+
+	requestKind := req.Kind.Kind
+	kind, ok := v1alpha1.AllKinds()[requestKind]
+	if !ok {
+		return admission.Allowed(fmt.Sprintf("skip the policy check for type %s", requestKind))
+	}
+
+	chaos, ok := kind.SpawnObject().(v1alpha1.InnerObject)
+	if !ok {
+		return admission.Allowed(fmt.Sprintf("skip the policy check for type %s", requestKind))
+	}
+	if err := v.decoder.Decode(req, chaos); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if chaos.GetNamespace() == "" {
+		chaos.SetNamespace(req.Namespace)
+	}
+
+	if req.Operation == admissionv1.Update {
+		old := kind.SpawnObject().(v1alpha1.InnerObject)
+		if err := v.decoder.DecodeRaw(req.OldObject, old); err != nil {
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		// the paused chaos is not counted as running, so resuming it may exceed the MaxConcurrent
+		if !old.IsPaused() || chaos.IsPaused() || chaos.IsDeleted() {
+			return admission.Allowed("")
+		}
+	}
+
+	errs, err := v.checker.Check(ctx, requestKind, chaos, time.Now())
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(errs) > 0 {
+		v.logger.Info("chaos violates the policies", "namespace", chaos.GetNamespace(), "name", chaos.GetName(), "errors", errs)
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return admission.Allowed("")
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/chaospolicy"
+	"github.com/chaos-mesh/chaos-mesh/pkg/selector/generic"
+)
+
+func newNetworkDelay(name string, paused bool) *v1alpha1.NetworkChaos {
+	duration := "30m"
+	chaos := &v1alpha1.NetworkChaos{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "prod", Name: name, UID: types.UID(name)},
+		Spec: v1alpha1.NetworkChaosSpec{
+			Action: v1alpha1.DelayAction,
+			PodSelector: v1alpha1.PodSelector{
+				Selector: v1alpha1.PodSelectorSpec{
+					GenericSelectorSpec: v1alpha1.GenericSelectorSpec{Namespaces: []string{"prod"}},
+				},
+				Mode: v1alpha1.OneMode,
+			},
+			Duration: &duration,
+		},
+	}
+	if paused {
+		chaos.Annotations = map[string]string{v1alpha1.PauseAnnotationKey: "true"}
+	}
+	return chaos
+}
+
+func newUpdateRequest(t *testing.T, old, chaos *v1alpha1.NetworkChaos) admission.Request {
+	oldRaw, err := json.Marshal(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := json.Marshal(chaos)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: admissionv1.Update,
+			Kind:      metav1.GroupVersionKind{Group: "chaos-mesh.org", Version: "v1alpha1", Kind: v1alpha1.KindNetworkChaos},
+			Namespace: "prod",
+			Object:    runtime.RawExtension{Raw: raw},
+			OldObject: runtime.RawExtension{Raw: oldRaw},
+		},
+	}
+}
+
+func TestPolicyValidatorUpdate(t *testing.T) {
+	g := gomega.NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	maxConcurrent := 1
+	running := newNetworkDelay("running", false)
+	running.Status.Experiment.DesiredPhase = v1alpha1.RunningPhase
+	paused := newNetworkDelay("paused", true)
+	paused.Status.Experiment.DesiredPhase = v1alpha1.StoppedPhase
+	c := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		&v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod"}},
+		&v1alpha1.ChaosPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-policy"},
+			Spec:       v1alpha1.ChaosPolicySpec{MaxConcurrent: &maxConcurrent},
+		},
+		running, paused,
+	).Build()
+	validator := NewPolicyValidator(chaospolicy.NewChecker(c, c, generic.Option{ClusterScoped: true}), scheme, logr.Discard())
+
+	resumed := paused.DeepCopy()
+	resumed.Annotations = nil
+	resp := validator.Handle(context.Background(), newUpdateRequest(t, paused, resumed))
+	g.Expect(resp.Allowed).To(gomega.BeFalse())
+	g.Expect(resp.Result.Message).To(gomega.ContainSubstring("prod-policy"))
+
+	labeled := paused.DeepCopy()
+	labeled.Labels = map[string]string{"team": "sre"}
+	resp = validator.Handle(context.Background(), newUpdateRequest(t, paused, labeled))
+	g.Expect(resp.Allowed).To(gomega.BeTrue())
+}
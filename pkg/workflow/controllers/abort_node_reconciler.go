@@ -50,7 +50,8 @@ func NewAbortNodeReconciler(kubeClient client.Client, eventRecorder recorder.Cha
 // Reconcile watches `WorkflowNodes`, if:
 // 1. the abort condition is `False`, just return.
 // 2. the abort condition is `True`, the node is not `TypeStatusCheck`, it will propagate abort condition to children nodes.
-// 3. the abort condition is `True`, the node is `TypeStatusCheck`, it will add abort annotation to the parent workflow.
+// 3. the abort condition is `True`, the node is `TypeStatusCheck`, or it's a `TypeTask` whose outputs are invalid, it
+// will add abort annotation to the parent workflow.
 func (it *AbortNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	node := v1alpha1.WorkflowNode{}
 	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
@@ -62,7 +63,7 @@ func (it *AbortNodeReconciler) Reconcile(ctx context.Context, request reconcile.
 		return reconcile.Result{}, nil
 	}
 
-	if node.Spec.Type != v1alpha1.TypeStatusCheck {
+	if node.Spec.Type != v1alpha1.TypeStatusCheck && !taskOutputsInvalid(node) {
 		// if this node is aborted, try propagating to children node
 		return reconcile.Result{}, it.propagateAbortToChildren(ctx, &node)
 	}
@@ -79,7 +80,7 @@ func (it *AbortNodeReconciler) Reconcile(ctx context.Context, request reconcile.
 
 func (it *AbortNodeReconciler) propagateAbortToChildren(ctx context.Context, parent *v1alpha1.WorkflowNode) error {
 	switch parent.Spec.Type {
-	case v1alpha1.TypeSerial, v1alpha1.TypeParallel, v1alpha1.TypeTask:
+	case v1alpha1.TypeSerial, v1alpha1.TypeParallel, v1alpha1.TypeTask, v1alpha1.TypeRetry, v1alpha1.TypeLoop, v1alpha1.TypeForEach:
 		activeChildNodes, _, err := it.ChildNodesFetcher.fetchChildNodes(ctx, *parent)
 		if err != nil {
 			return errors.Wrap(err, "fetch children nodes")
@@ -131,6 +132,15 @@ func (it *AbortNodeReconciler) propagateAbortToChildren(ctx context.Context, par
 	}
 }
 
+// taskOutputsInvalid returns whether the node is a task aborted because of its invalid outputs
+func taskOutputsInvalid(node v1alpha1.WorkflowNode) bool {
+	if node.Spec.Type != v1alpha1.TypeTask {
+		return false
+	}
+	condition := GetCondition(node.Status, v1alpha1.ConditionAborted)
+	return condition != nil && condition.Reason == v1alpha1.TaskOutputsInvalid
+}
+
 func (it *AbortNodeReconciler) abortWorkflow(ctx context.Context, node v1alpha1.WorkflowNode) error {
 	parentWorkflow, err := getParentWorkflow(ctx, it.kubeClient, node)
 	if err != nil {
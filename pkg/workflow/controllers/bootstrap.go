@@ -86,6 +86,21 @@ func BootstrapWorkflowControllers(mgr manager.Manager, logger logr.Logger, recor
 		return err
 	}
 
+	err = ctrl.NewControllerManagedBy(mgr).
+		For(&v1alpha1.WorkflowNode{}).
+		Owns(&v1alpha1.WorkflowNode{}).
+		Named("workflow-iteration-node-reconciler").
+		Complete(
+			NewIterationNodeReconciler(
+				noCacheClient,
+				recorderBuilder.Build("workflow-iteration-node-reconciler"),
+				logger.WithName("workflow-iteration-node-reconciler"),
+			),
+		)
+	if err != nil {
+		return err
+	}
+
 	err = ctrl.NewControllerManagedBy(mgr).
 		For(&v1alpha1.WorkflowNode{}).
 		Named("workflow-deadline-reconciler").
@@ -0,0 +1,522 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	"github.com/chaos-mesh/chaos-mesh/pkg/expr"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/task/collector"
+)
+
+// IterationNodeReconciler watches on nodes which type is Retry, Loop or ForEach
+type IterationNodeReconciler struct {
+	*ChildNodesFetcher
+	kubeClient    client.Client
+	eventRecorder recorder.ChaosRecorder
+	logger        logr.Logger
+}
+
+func NewIterationNodeReconciler(kubeClient client.Client, eventRecorder recorder.ChaosRecorder, logger logr.Logger) *IterationNodeReconciler {
+	return &IterationNodeReconciler{
+		ChildNodesFetcher: NewChildNodesFetcher(kubeClient, logger),
+		kubeClient:        kubeClient,
+		eventRecorder:     eventRecorder,
+		logger:            logger,
+	}
+}
+
+// iterationDecision describes what an iteration node should do, after observing its children nodes.
+type iterationDecision struct {
+	// iterations are the iterations to instantiate
+	iterations []v1alpha1.Iteration
+	// delay is the duration to wait before instantiating the iterations
+	delay time.Duration
+	// accomplished means no more iteration would be instantiated
+	accomplished bool
+	reason       string
+	outputs      map[string]interface{}
+}
+
+// Reconcile should be invoked by: changes on a Retry/Loop/ForEach node, or changes on a node which controlled by it.
+//
+// Like SerialNodeReconciler, the decision is made by observing the children nodes at each time. The only state
+// kept in v1alpha1.WorkflowNodeStatus is NextIterationTime, which is used to wait for the backoff of Retry or the
+// interval of Loop.
+func (it *IterationNodeReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	startTime := time.Now()
+	defer func() {
+		it.logger.V(4).Info("Finished syncing for iteration node",
+			"node", request.NamespacedName,
+			"duration", time.Since(startTime),
+		)
+	}()
+
+	node := v1alpha1.WorkflowNode{}
+	err := it.kubeClient.Get(ctx, request.NamespacedName, &node)
+	if err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	// only resolve retry, loop and for-each nodes
+	if !v1alpha1.IsIterationTemplateType(node.Spec.Type) {
+		return reconcile.Result{}, nil
+	}
+
+	it.logger.V(4).Info("resolve iteration node", "node", request)
+
+	requeueAfter, err := it.syncChildNodes(ctx, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// update status
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		nodeNeedUpdate := v1alpha1.WorkflowNode{}
+		err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		activeChildren, finishedChildren, err := it.fetchChildNodes(ctx, nodeNeedUpdate)
+		if err != nil {
+			return err
+		}
+
+		nodeNeedUpdate.Status.FinishedChildren = nil
+		for _, finishedChild := range finishedChildren {
+			nodeNeedUpdate.Status.FinishedChildren = append(nodeNeedUpdate.Status.FinishedChildren,
+				corev1.LocalObjectReference{
+					Name: finishedChild.Name,
+				})
+		}
+
+		nodeNeedUpdate.Status.ActiveChildren = nil
+		for _, activeChild := range activeChildren {
+			nodeNeedUpdate.Status.ActiveChildren = append(nodeNeedUpdate.Status.ActiveChildren,
+				corev1.LocalObjectReference{
+					Name: activeChild.Name,
+				})
+		}
+
+		if WorkflowNodeFinished(nodeNeedUpdate.Status) {
+			nodeNeedUpdate.Status.NextIterationTime = nil
+			return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+		}
+
+		decision, err := it.decide(ctx, nodeNeedUpdate, activeChildren, finishedChildren)
+		if err != nil {
+			return err
+		}
+
+		if len(activeChildren) > 0 || decision.accomplished || len(decision.iterations) == 0 {
+			nodeNeedUpdate.Status.NextIterationTime = nil
+		} else if decision.delay > 0 && nodeNeedUpdate.Status.NextIterationTime == nil {
+			nextIterationTime := metav1.NewTime(time.Now().Add(decision.delay))
+			nodeNeedUpdate.Status.NextIterationTime = &nextIterationTime
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.IterationScheduled{
+				Iteration: decision.iterations[0].Index,
+				Delay:     decision.delay.String(),
+			})
+		}
+
+		if decision.outputs != nil {
+			outputs, err := encodeOutputs(decision.outputs)
+			if err != nil {
+				return err
+			}
+			nodeNeedUpdate.Status.Outputs = outputs
+		}
+
+		if decision.accomplished {
+			if decision.reason == v1alpha1.RetryExhausted {
+				it.eventRecorder.Event(&nodeNeedUpdate, recorder.RetryExhausted{Attempts: len(finishedChildren)})
+			}
+			it.eventRecorder.Event(&nodeNeedUpdate, recorder.NodeAccomplished{})
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionTrue,
+				Reason: decision.reason,
+			})
+		} else {
+			SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+				Type:   v1alpha1.ConditionAccomplished,
+				Status: corev1.ConditionFalse,
+				Reason: "",
+			})
+		}
+
+		if nodeNeedUpdate.Status.NextIterationTime != nil {
+			requeueAfter = time.Until(nodeNeedUpdate.Status.NextIterationTime.Time)
+		}
+
+		return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+	})
+
+	if updateError != nil {
+		it.logger.Error(updateError, "failed to update the status of node", "node", request)
+		return reconcile.Result{}, updateError
+	}
+
+	if requeueAfter > 0 {
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// syncChildNodes instantiates the next iterations if it's time to do that. It returns the duration to wait if the
+// next iteration is still waiting for the backoff or interval.
+func (it *IterationNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha1.WorkflowNode) (time.Duration, error) {
+	if WorkflowNodeFinished(node.Status) {
+		return 0, nil
+	}
+
+	activeChildNodes, finishedChildNodes, err := it.fetchChildNodes(ctx, node)
+	if err != nil {
+		return 0, err
+	}
+
+	decision, err := it.decide(ctx, node, activeChildNodes, finishedChildNodes)
+	if err != nil {
+		return 0, err
+	}
+	if decision.accomplished || len(decision.iterations) == 0 {
+		return 0, nil
+	}
+
+	if decision.delay > 0 {
+		// the next iteration time is recorded in status after the first observation of the finished iteration
+		if node.Status.NextIterationTime == nil {
+			return 0, nil
+		}
+		if waiting := time.Until(node.Status.NextIterationTime.Time); waiting > 0 {
+			return waiting, nil
+		}
+	}
+
+	parentWorkflow := v1alpha1.Workflow{}
+	err = it.kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: node.Namespace,
+		Name:      node.Spec.WorkflowName,
+	}, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to fetch parent workflow",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+			"workflow name", node.Spec.WorkflowName)
+		return 0, err
+	}
+	env, err := renderContext(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to collect the context for rendering children childNodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return 0, err
+	}
+
+	var childrenNames []string
+	for _, iteration := range decision.iterations {
+		iteration := iteration
+		childNodes, err := renderNodesByTemplatesWithIteration(&parentWorkflow, &node, &iteration, env, node.Spec.Children...)
+		if err != nil {
+			it.logger.Error(err, "failed to render children childNodes",
+				"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+			return 0, err
+		}
+		for _, childNode := range childNodes {
+			err := it.kubeClient.Create(ctx, childNode)
+			if err != nil {
+				it.logger.Error(err, "failed to create child node",
+					"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+					"child node", childNode)
+				return 0, err
+			}
+			childrenNames = append(childrenNames, childNode.Name)
+		}
+	}
+	it.eventRecorder.Event(&node, recorder.NodesCreated{ChildNodes: childrenNames})
+	it.logger.Info("iteration node spawn new child node",
+		"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name),
+		"child node", childrenNames)
+
+	return 0, nil
+}
+
+// decide observes the children nodes, then decides what the iteration node should do
+func (it *IterationNodeReconciler) decide(ctx context.Context, node v1alpha1.WorkflowNode, activeChildren []v1alpha1.WorkflowNode, finishedChildren []v1alpha1.WorkflowNode) (iterationDecision, error) {
+	// the expressions could only refer the outputs and parameters, so the context is only collected when it's needed
+	var env map[string]interface{}
+	collectEnv := func() (map[string]interface{}, error) {
+		if env != nil {
+			return env, nil
+		}
+		parentWorkflow := v1alpha1.Workflow{}
+		err := it.kubeClient.Get(ctx, types.NamespacedName{
+			Namespace: node.Namespace,
+			Name:      node.Spec.WorkflowName,
+		}, &parentWorkflow)
+		if err != nil {
+			return nil, err
+		}
+		result, err := renderContext(ctx, it.kubeClient, &parentWorkflow)
+		if err != nil {
+			return nil, err
+		}
+		env, err = iterationContext(result, node.Spec.Iteration)
+		return env, err
+	}
+
+	switch node.Spec.Type {
+	case v1alpha1.TypeRetry:
+		return decideRetry(node, activeChildren, finishedChildren, collectEnv)
+	case v1alpha1.TypeLoop:
+		return decideLoop(node, activeChildren, finishedChildren, collectEnv)
+	case v1alpha1.TypeForEach:
+		env, err := collectEnv()
+		if err != nil {
+			return iterationDecision{}, err
+		}
+		items, err := expr.EvalList(node.Spec.ForEach.Items, env)
+		if err != nil {
+			return iterationDecision{}, errors.Wrapf(err, "evaluate items %s", node.Spec.ForEach.Items)
+		}
+		return decideForEach(node, items, len(activeChildren), len(finishedChildren))
+	}
+	return iterationDecision{}, errors.Errorf("unsupported type of iteration node: %s", node.Spec.Type)
+}
+
+func decideRetry(node v1alpha1.WorkflowNode, activeChildren []v1alpha1.WorkflowNode, finishedChildren []v1alpha1.WorkflowNode, collectEnv func() (map[string]interface{}, error)) (iterationDecision, error) {
+	spec := node.Spec.Retry
+	if spec == nil {
+		return iterationDecision{}, errors.New("retry is not specified")
+	}
+	attempts := len(activeChildren) + len(finishedChildren)
+	if attempts == 0 {
+		return iterationDecision{iterations: []v1alpha1.Iteration{inheritedIteration(node, 0)}}, nil
+	}
+	if len(activeChildren) > 0 {
+		return iterationDecision{}, nil
+	}
+
+	succeeded := false
+	lastAttempt := finishedChildren[len(finishedChildren)-1]
+	if len(spec.Expression) > 0 {
+		env, err := collectEnv()
+		if err != nil {
+			return iterationDecision{}, err
+		}
+		if succeeded, err = expr.EvalBool(spec.Expression, env); err != nil {
+			return iterationDecision{}, errors.Wrapf(err, "evaluate expression %s", spec.Expression)
+		}
+	} else {
+		succeeded = !attemptFailed(lastAttempt)
+	}
+
+	outputs := map[string]interface{}{
+		"attempts":  attempts,
+		"succeeded": succeeded,
+	}
+	if succeeded {
+		return iterationDecision{accomplished: true, outputs: outputs}, nil
+	}
+	if attempts >= spec.MaxAttempts {
+		return iterationDecision{accomplished: true, reason: v1alpha1.RetryExhausted, outputs: outputs}, nil
+	}
+
+	delay, err := retryBackoff(spec, attempts)
+	if err != nil {
+		return iterationDecision{}, err
+	}
+	return iterationDecision{
+		iterations: []v1alpha1.Iteration{inheritedIteration(node, attempts)},
+		delay:      delay,
+		outputs:    outputs,
+	}, nil
+}
+
+func decideLoop(node v1alpha1.WorkflowNode, activeChildren []v1alpha1.WorkflowNode, finishedChildren []v1alpha1.WorkflowNode, collectEnv func() (map[string]interface{}, error)) (iterationDecision, error) {
+	spec := node.Spec.Loop
+	if spec == nil {
+		return iterationDecision{}, errors.New("loop is not specified")
+	}
+	iterations := len(activeChildren) + len(finishedChildren)
+	outputs := map[string]interface{}{
+		"iterations": iterations,
+	}
+	if len(activeChildren) > 0 {
+		return iterationDecision{outputs: outputs}, nil
+	}
+	if iterations == 0 {
+		return iterationDecision{iterations: []v1alpha1.Iteration{inheritedIteration(node, 0)}, outputs: outputs}, nil
+	}
+
+	if len(spec.Until) > 0 {
+		env, err := collectEnv()
+		if err != nil {
+			return iterationDecision{}, err
+		}
+		untilEnv := make(map[string]interface{}, len(env)+1)
+		for key, value := range env {
+			untilEnv[key] = value
+		}
+		untilEnv[Index] = iterations - 1
+		until, err := expr.EvalBool(spec.Until, untilEnv)
+		if err != nil {
+			return iterationDecision{}, errors.Wrapf(err, "evaluate expression %s", spec.Until)
+		}
+		if until {
+			return iterationDecision{accomplished: true, outputs: outputs}, nil
+		}
+	}
+	if spec.Count != nil && iterations >= *spec.Count {
+		return iterationDecision{accomplished: true, outputs: outputs}, nil
+	}
+
+	var delay time.Duration
+	if spec.Interval != nil {
+		var err error
+		if delay, err = time.ParseDuration(*spec.Interval); err != nil {
+			return iterationDecision{}, err
+		}
+	}
+	return iterationDecision{
+		iterations: []v1alpha1.Iteration{inheritedIteration(node, iterations)},
+		delay:      delay,
+		outputs:    outputs,
+	}, nil
+}
+
+func decideForEach(node v1alpha1.WorkflowNode, items []interface{}, active int, finished int) (iterationDecision, error) {
+	spec := node.Spec.ForEach
+	if spec == nil {
+		return iterationDecision{}, errors.New("forEach is not specified")
+	}
+	outputs := map[string]interface{}{
+		"count": len(items),
+	}
+	created := active + finished
+	if created >= len(items) {
+		return iterationDecision{accomplished: active == 0, outputs: outputs}, nil
+	}
+
+	parallelism := len(items)
+	if spec.Parallelism != nil {
+		parallelism = *spec.Parallelism
+	}
+	var iterations []v1alpha1.Iteration
+	for index := created; index < len(items) && active+len(iterations) < parallelism; index++ {
+		item, err := json.Marshal(items[index])
+		if err != nil {
+			return iterationDecision{}, errors.Wrapf(err, "encode item %d", index)
+		}
+		iterations = append(iterations, v1alpha1.Iteration{Index: index, Item: string(item)})
+	}
+	return iterationDecision{iterations: iterations, outputs: outputs}, nil
+}
+
+// inheritedIteration returns the iteration with given index, the item is inherited from the iteration
+// of node, so the item of an outer ForEach is still available in the inner Retry or Loop.
+func inheritedIteration(node v1alpha1.WorkflowNode, index int) v1alpha1.Iteration {
+	iteration := v1alpha1.Iteration{Index: index}
+	if node.Spec.Iteration != nil {
+		iteration.Item = node.Spec.Iteration.Item
+	}
+	return iteration
+}
+
+// retryBackoff returns the backoff before the next attempt, after the given attempts failed.
+func retryBackoff(spec *v1alpha1.RetrySpec, attempts int) (time.Duration, error) {
+	if spec.Backoff == nil {
+		return 0, nil
+	}
+	backoff, err := time.ParseDuration(*spec.Backoff)
+	if err != nil {
+		return 0, err
+	}
+	var maxBackoff time.Duration
+	if spec.MaxBackoff != nil {
+		if maxBackoff, err = time.ParseDuration(*spec.MaxBackoff); err != nil {
+			return 0, err
+		}
+	}
+	factor := 1
+	if spec.Factor != nil {
+		factor = *spec.Factor
+	}
+	for i := 1; i < attempts; i++ {
+		// saturate rather than overflow without the max backoff
+		if factor > 1 && backoff > time.Duration(math.MaxInt64)/time.Duration(factor) {
+			backoff = time.Duration(math.MaxInt64)
+			break
+		}
+		backoff *= time.Duration(factor)
+		if maxBackoff > 0 && backoff >= maxBackoff {
+			break
+		}
+	}
+	if maxBackoff > 0 && backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+	return backoff, nil
+}
+
+// attemptFailed returns whether the finished attempt failed: it's aborted, it's a task exiting with
+// non-zero code, or it's a status check exceeding the failure threshold.
+func attemptFailed(attempt v1alpha1.WorkflowNode) bool {
+	if ConditionEqualsTo(attempt.Status, v1alpha1.ConditionAborted, corev1.ConditionTrue) {
+		return true
+	}
+	switch attempt.Spec.Type {
+	case v1alpha1.TypeTask:
+		if attempt.Status.ConditionalBranchesStatus == nil || len(attempt.Status.ConditionalBranchesStatus.Context) == 0 {
+			return true
+		}
+		var env map[string]interface{}
+		if err := json.Unmarshal([]byte(attempt.Status.ConditionalBranchesStatus.Context[0]), &env); err != nil {
+			return true
+		}
+		exitCode, ok := env[collector.ExitCode].(float64)
+		return !ok || exitCode != 0
+	case v1alpha1.TypeStatusCheck:
+		return attempt.Status.Outputs["failureThresholdExceed"] == "true"
+	}
+	return false
+}
+
+func encodeOutputs(values map[string]interface{}) (map[string]string, error) {
+	outputs := make(map[string]string, len(values))
+	for name, value := range values {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "encode output %s", name)
+		}
+		outputs[name] = string(encoded)
+	}
+	return outputs, nil
+}
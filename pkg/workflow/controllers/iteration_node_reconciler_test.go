@@ -0,0 +1,226 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+func Test_retryBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     v1alpha1.RetrySpec
+		attempts int
+		want     time.Duration
+	}{
+		{
+			name:     "no backoff",
+			spec:     v1alpha1.RetrySpec{MaxAttempts: 3},
+			attempts: 1,
+			want:     0,
+		}, {
+			name:     "constant backoff",
+			spec:     v1alpha1.RetrySpec{MaxAttempts: 3, Backoff: pointer.String("10s")},
+			attempts: 2,
+			want:     10 * time.Second,
+		}, {
+			name:     "exponential backoff",
+			spec:     v1alpha1.RetrySpec{MaxAttempts: 5, Backoff: pointer.String("10s"), Factor: pointer.Int(2)},
+			attempts: 3,
+			want:     40 * time.Second,
+		}, {
+			name:     "limited by max backoff",
+			spec:     v1alpha1.RetrySpec{MaxAttempts: 10, Backoff: pointer.String("10s"), Factor: pointer.Int(2), MaxBackoff: pointer.String("1m")},
+			attempts: 8,
+			want:     time.Minute,
+		}, {
+			name:     "saturated without max backoff",
+			spec:     v1alpha1.RetrySpec{MaxAttempts: 100, Backoff: pointer.String("10s"), Factor: pointer.Int(2)},
+			attempts: 100,
+			want:     time.Duration(math.MaxInt64),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := retryBackoff(&tt.spec, tt.attempts)
+			if err != nil {
+				t.Errorf("retryBackoff() error = %v", err)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("retryBackoff() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_attemptFailed(t *testing.T) {
+	tests := []struct {
+		name    string
+		attempt v1alpha1.WorkflowNode
+		want    bool
+	}{
+		{
+			name: "task exits with zero",
+			attempt: v1alpha1.WorkflowNode{
+				Spec: v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeTask},
+				Status: v1alpha1.WorkflowNodeStatus{
+					ConditionalBranchesStatus: &v1alpha1.ConditionalBranchesStatus{Context: []string{`{"exitCode":0,"stdout":""}`}},
+				},
+			},
+			want: false,
+		}, {
+			name: "task exits with non-zero",
+			attempt: v1alpha1.WorkflowNode{
+				Spec: v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeTask},
+				Status: v1alpha1.WorkflowNodeStatus{
+					ConditionalBranchesStatus: &v1alpha1.ConditionalBranchesStatus{Context: []string{`{"exitCode":1}`}},
+				},
+			},
+			want: true,
+		}, {
+			name: "status check exceeds the failure threshold",
+			attempt: v1alpha1.WorkflowNode{
+				Spec:   v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeStatusCheck},
+				Status: v1alpha1.WorkflowNodeStatus{Outputs: map[string]string{"failureThresholdExceed": "true"}},
+			},
+			want: true,
+		}, {
+			name: "aborted serial node",
+			attempt: v1alpha1.WorkflowNode{
+				Spec: v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeSerial},
+				Status: v1alpha1.WorkflowNodeStatus{
+					Conditions: []v1alpha1.WorkflowNodeCondition{{Type: v1alpha1.ConditionAborted, Status: corev1.ConditionTrue}},
+				},
+			},
+			want: true,
+		}, {
+			name:    "accomplished chaos node",
+			attempt: v1alpha1.WorkflowNode{Spec: v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypePodChaos}},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := attemptFailed(tt.attempt); got != tt.want {
+				t.Errorf("attemptFailed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_decideRetry(t *testing.T) {
+	node := v1alpha1.WorkflowNode{
+		Spec: v1alpha1.WorkflowNodeSpec{
+			Type:  v1alpha1.TypeRetry,
+			Retry: &v1alpha1.RetrySpec{MaxAttempts: 2, Backoff: pointer.String("5s")},
+		},
+	}
+	failed := v1alpha1.WorkflowNode{
+		Spec: v1alpha1.WorkflowNodeSpec{Type: v1alpha1.TypeTask},
+		Status: v1alpha1.WorkflowNodeStatus{
+			ConditionalBranchesStatus: &v1alpha1.ConditionalBranchesStatus{Context: []string{`{"exitCode":1}`}},
+		},
+	}
+	noEnv := func() (map[string]interface{}, error) { return nil, nil }
+
+	decision, err := decideRetry(node, nil, nil, noEnv)
+	if err != nil || !reflect.DeepEqual(decision.iterations, []v1alpha1.Iteration{{Index: 0}}) {
+		t.Errorf("decideRetry() should instantiate the first attempt, got %+v, %v", decision, err)
+	}
+
+	decision, err = decideRetry(node, nil, []v1alpha1.WorkflowNode{failed}, noEnv)
+	if err != nil || decision.accomplished || decision.delay != 5*time.Second || !reflect.DeepEqual(decision.iterations, []v1alpha1.Iteration{{Index: 1}}) {
+		t.Errorf("decideRetry() should retry after backoff, got %+v, %v", decision, err)
+	}
+
+	decision, err = decideRetry(node, nil, []v1alpha1.WorkflowNode{failed, failed}, noEnv)
+	if err != nil || !decision.accomplished || decision.reason != v1alpha1.RetryExhausted || decision.outputs["succeeded"] != false {
+		t.Errorf("decideRetry() should be exhausted, got %+v, %v", decision, err)
+	}
+
+	node.Spec.Retry.Expression = "outputs.check.healthy"
+	withEnv := func() (map[string]interface{}, error) {
+		return map[string]interface{}{
+			"outputs": map[string]interface{}{"check": map[string]interface{}{"healthy": true}},
+		}, nil
+	}
+	decision, err = decideRetry(node, nil, []v1alpha1.WorkflowNode{failed}, withEnv)
+	if err != nil || !decision.accomplished || decision.outputs["succeeded"] != true {
+		t.Errorf("decideRetry() should succeed by expression, got %+v, %v", decision, err)
+	}
+}
+
+func Test_decideLoop(t *testing.T) {
+	node := v1alpha1.WorkflowNode{
+		Spec: v1alpha1.WorkflowNodeSpec{
+			Type:      v1alpha1.TypeLoop,
+			Loop:      &v1alpha1.LoopSpec{Count: pointer.Int(3), Until: "index >= 1", Interval: pointer.String("1m")},
+			Iteration: &v1alpha1.Iteration{Index: 4, Item: `"ns-a"`},
+		},
+	}
+	finished := v1alpha1.WorkflowNode{}
+	noEnv := func() (map[string]interface{}, error) { return map[string]interface{}{}, nil }
+
+	decision, err := decideLoop(node, nil, []v1alpha1.WorkflowNode{finished}, noEnv)
+	if err != nil || decision.accomplished || decision.delay != time.Minute ||
+		!reflect.DeepEqual(decision.iterations, []v1alpha1.Iteration{{Index: 1, Item: `"ns-a"`}}) {
+		t.Errorf("decideLoop() should instantiate the next iteration, got %+v, %v", decision, err)
+	}
+
+	decision, err = decideLoop(node, nil, []v1alpha1.WorkflowNode{finished, finished}, noEnv)
+	if err != nil || !decision.accomplished || decision.outputs["iterations"] != 2 {
+		t.Errorf("decideLoop() should stop by until, got %+v, %v", decision, err)
+	}
+}
+
+func Test_decideForEach(t *testing.T) {
+	node := v1alpha1.WorkflowNode{
+		Spec: v1alpha1.WorkflowNodeSpec{
+			Type:    v1alpha1.TypeForEach,
+			ForEach: &v1alpha1.ForEachSpec{Items: "parameters.namespaces", Parallelism: pointer.Int(2)},
+		},
+	}
+	items := []interface{}{"ns-a", "ns-b", "ns-c"}
+
+	decision, err := decideForEach(node, items, 0, 0)
+	if err != nil || !reflect.DeepEqual(decision.iterations, []v1alpha1.Iteration{{Index: 0, Item: `"ns-a"`}, {Index: 1, Item: `"ns-b"`}}) {
+		t.Errorf("decideForEach() should instantiate the iterations with parallelism, got %+v, %v", decision, err)
+	}
+
+	decision, err = decideForEach(node, items, 1, 1)
+	if err != nil || !reflect.DeepEqual(decision.iterations, []v1alpha1.Iteration{{Index: 2, Item: `"ns-c"`}}) {
+		t.Errorf("decideForEach() should instantiate the last iteration, got %+v, %v", decision, err)
+	}
+
+	decision, err = decideForEach(node, items, 1, 2)
+	if err != nil || decision.accomplished || len(decision.iterations) != 0 {
+		t.Errorf("decideForEach() should wait for the active iteration, got %+v, %v", decision, err)
+	}
+
+	decision, err = decideForEach(node, items, 0, 3)
+	if err != nil || !decision.accomplished || decision.outputs["count"] != 3 {
+		t.Errorf("decideForEach() should be accomplished, got %+v, %v", decision, err)
+	}
+}
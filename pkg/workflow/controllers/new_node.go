@@ -16,13 +16,18 @@
 package controllers
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/expr"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/task/collector"
 )
 
 var (
@@ -33,8 +38,52 @@ var (
 	KindWorkflowNode   = "WorkflowNode"
 )
 
+const (
+	Parameters = "parameters"
+	Index      = "index"
+	Item       = "item"
+)
+
+// renderContext returns the env used to render the templated fields of new nodes, it contains the parameters
+// of the workflow and the outputs published by the existing nodes of the workflow.
+func renderContext(ctx context.Context, kubeClient client.Reader, workflow *v1alpha1.Workflow) (map[string]interface{}, error) {
+	env, err := collector.NewOutputsCollector(kubeClient, workflow.Namespace, workflow.Name).CollectContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := workflow.Spec.ResolveParameters()
+	if err != nil {
+		return nil, err
+	}
+	parameters := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		parameters[name] = value
+	}
+	env[Parameters] = parameters
+
+	return env, nil
+}
+
 // renderNodesByTemplates will render the nodes one by one, will setup owner by given parent. If parent is nil, it will use workflow as its owner.
-func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.WorkflowNode, templates ...string) ([]*v1alpha1.WorkflowNode, error) {
+// The templated fields like `{{ parameters.<name> }}` or `{{ outputs.<template>.<output> }}` in the deadline, chaos, schedule
+// and status check of nodes are rendered with env.
+func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.WorkflowNode, env map[string]interface{}, templates ...string) ([]*v1alpha1.WorkflowNode, error) {
+	var iteration *v1alpha1.Iteration
+	if parent != nil {
+		iteration = parent.Spec.Iteration
+	}
+	return renderNodesByTemplatesWithIteration(workflow, parent, iteration, env, templates...)
+}
+
+// renderNodesByTemplatesWithIteration is like renderNodesByTemplates, but the nodes are marked with the given
+// iteration, and the index and the item of iteration are available as `index` and `item` in env.
+func renderNodesByTemplatesWithIteration(workflow *v1alpha1.Workflow, parent *v1alpha1.WorkflowNode, iteration *v1alpha1.Iteration, env map[string]interface{}, templates ...string) ([]*v1alpha1.WorkflowNode, error) {
+	env, err := iterationContext(env, iteration)
+	if err != nil {
+		return nil, err
+	}
+
 	templateNameSet := make(map[string]v1alpha1.Template)
 	for _, template := range workflow.Spec.Templates {
 		templateNameSet[template.Name] = template
@@ -47,7 +96,11 @@ func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.Workfl
 			var deadline *metav1.Time = nil
 
 			if template.Deadline != nil {
-				duration, err := time.ParseDuration(*template.Deadline)
+				renderedDeadline, err := expr.RenderString(*template.Deadline, env)
+				if err != nil {
+					return nil, errors.Wrapf(err, "render deadline of template %s", template.Name)
+				}
+				duration, err := time.ParseDuration(renderedDeadline)
 				if err != nil {
 					// TODO: logger
 					return nil, err
@@ -56,6 +109,25 @@ func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.Workfl
 				deadline = &copiedDuration
 			}
 
+			embedChaos := template.EmbedChaos.DeepCopy()
+			if embedChaos != nil {
+				if err := expr.RenderObject(embedChaos, env); err != nil {
+					return nil, errors.Wrapf(err, "render template %s", template.Name)
+				}
+			}
+			schedule := conversionSchedule(template.Schedule)
+			if schedule != nil {
+				if err := expr.RenderObject(schedule, env); err != nil {
+					return nil, errors.Wrapf(err, "render template %s", template.Name)
+				}
+			}
+			statusCheck := template.StatusCheck.DeepCopy()
+			if statusCheck != nil {
+				if err := expr.RenderObject(statusCheck, env); err != nil {
+					return nil, errors.Wrapf(err, "render template %s", template.Name)
+				}
+			}
+
 			renderedNode := v1alpha1.WorkflowNode{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace:    workflow.Namespace,
@@ -70,10 +142,14 @@ func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.Workfl
 					Children:             template.Children,
 					Task:                 template.Task,
 					ConditionalBranches:  template.ConditionalBranches,
-					EmbedChaos:           template.EmbedChaos,
-					Schedule:             conversionSchedule(template.Schedule),
-					StatusCheck:          template.StatusCheck,
+					EmbedChaos:           embedChaos,
+					Schedule:             schedule,
+					StatusCheck:          statusCheck,
 					AbortWithStatusCheck: template.AbortWithStatusCheck,
+					Retry:                template.Retry,
+					Loop:                 template.Loop,
+					ForEach:              template.ForEach,
+					Iteration:            iteration.DeepCopy(),
 				},
 			}
 
@@ -121,6 +197,26 @@ func renderNodesByTemplates(workflow *v1alpha1.Workflow, parent *v1alpha1.Workfl
 	return result, nil
 }
 
+// iterationContext returns a copy of env extended with the index and the item of iteration
+func iterationContext(env map[string]interface{}, iteration *v1alpha1.Iteration) (map[string]interface{}, error) {
+	if iteration == nil {
+		return env, nil
+	}
+	result := make(map[string]interface{}, len(env)+2)
+	for key, value := range env {
+		result[key] = value
+	}
+	result[Index] = iteration.Index
+	if len(iteration.Item) > 0 {
+		var item interface{}
+		if err := json.Unmarshal([]byte(iteration.Item), &item); err != nil {
+			return nil, errors.Wrap(err, "decode item of iteration")
+		}
+		result[Item] = item
+	}
+	return result, nil
+}
+
 func conversionSchedule(origin *v1alpha1.ChaosOnlyScheduleSpec) *v1alpha1.ScheduleSpec {
 	if origin == nil {
 		return nil
@@ -206,7 +206,13 @@ func (it *ParallelNodeReconciler) syncChildNodes(ctx context.Context, node v1alp
 		return err
 	}
 
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, tasksToStartup...)
+	env, err := renderContext(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to collect the context for rendering children childNodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return err
+	}
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, env, tasksToStartup...)
 	if err != nil {
 		it.logger.Error(err, "failed to render children childNodes",
 			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
@@ -262,7 +262,13 @@ func (it *SerialNodeReconciler) syncChildNodes(ctx context.Context, node v1alpha
 		return err
 	}
 	// TODO: using ordered id instead of random suffix is better, like StatefulSet, also related to the sorting
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, taskToStartup)
+	env, err := renderContext(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to collect the context for rendering children childNodes",
+			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
+		return err
+	}
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &node, env, taskToStartup)
 	if err != nil {
 		it.logger.Error(err, "failed to render children childNodes",
 			"node", fmt.Sprintf("%s/%s", node.Namespace, node.Name))
@@ -17,6 +17,7 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -160,6 +161,11 @@ func (it *StatusCheckReconciler) updateNodeStatus(ctx context.Context, request r
 
 		statusCheck := statusChecks[0]
 		if statusCheck.IsCompleted() {
+			outputs, err := statusCheckOutputs(statusCheck)
+			if err != nil {
+				return err
+			}
+			node.Status.Outputs = outputs
 			SetCondition(&node.Status, v1alpha1.WorkflowNodeCondition{
 				Type:   v1alpha1.ConditionAccomplished,
 				Status: corev1.ConditionTrue,
@@ -256,6 +262,44 @@ func getParentWorkflow(ctx context.Context, kubeClient client.Client, node v1alp
 	return &parentWorkflow, nil
 }
 
+// statusCheckOutputs returns the outputs published by the status check node, they are:
+// - count: the total number of the status check executed
+// - successes: the number of the successful executions in records
+// - failures: the number of the failed executions in records
+// - failureThresholdExceed: whether the failure threshold is exceeded
+func statusCheckOutputs(statusCheck v1alpha1.StatusCheck) (map[string]string, error) {
+	successes, failures := 0, 0
+	for _, record := range statusCheck.Status.Records {
+		switch record.Outcome {
+		case v1alpha1.StatusCheckOutcomeSuccess:
+			successes++
+		case v1alpha1.StatusCheckOutcomeFailure:
+			failures++
+		}
+	}
+	failureThresholdExceed := false
+	for _, condition := range statusCheck.Status.Conditions {
+		if condition.Type == v1alpha1.StatusCheckConditionFailureThresholdExceed {
+			failureThresholdExceed = condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	outputs := make(map[string]string)
+	for name, value := range map[string]interface{}{
+		"count":                  statusCheck.Status.Count,
+		"successes":              successes,
+		"failures":               failures,
+		"failureThresholdExceed": failureThresholdExceed,
+	} {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "encode output %s", name)
+		}
+		outputs[name] = string(encoded)
+	}
+	return outputs, nil
+}
+
 func needToAbort(statusCheck v1alpha1.StatusCheck) bool {
 	if !statusCheck.IsCompleted() {
 		return false
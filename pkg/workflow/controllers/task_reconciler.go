@@ -126,8 +126,11 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 		}
 		if !evaluated {
 			it.eventRecorder.Event(&node, recorder.TaskPodPodCompleted{PodName: pods[0].Name})
+			// the event is recorded once the update succeeds, rather than on every conflict
+			var invalidOutputs error
 			// task pod is terminated
 			updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				invalidOutputs = nil
 				nodeNeedUpdate := v1alpha1.WorkflowNode{}
 				err := it.kubeClient.Get(ctx, request.NamespacedName, &nodeNeedUpdate)
 				if err != nil {
@@ -140,6 +143,10 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 
 				// TODO: update related condition
 				defaultCollector := collector.DefaultCollector(it.kubeClient, it.restConfig, pods[0].Namespace, pods[0].Name, nodeNeedUpdate.Spec.Task.Container.Name)
+				if len(nodeNeedUpdate.Spec.Task.Outputs) > 0 {
+					// the termination message is collected only for the outputs, to keep the context of other tasks
+					defaultCollector = collector.TaskOutputsCollector(it.kubeClient, it.restConfig, pods[0].Namespace, pods[0].Name, nodeNeedUpdate.Spec.Task.Container.Name)
+				}
 				env, err := defaultCollector.CollectContext(ctx)
 				if err != nil {
 					it.logger.Error(err, "failed to fetch env from task",
@@ -158,6 +165,34 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 					}
 				}
 
+				env, err = it.collectOutputs(ctx, &nodeNeedUpdate, env)
+				if err != nil {
+					it.logger.Error(err, "failed to collect outputs of task",
+						"task", fmt.Sprintf("%s/%s", nodeNeedUpdate.Namespace, nodeNeedUpdate.Name),
+					)
+					if !errors.As(err, &invalidOutputsError{}) {
+						return err
+					}
+					// the outputs will never be valid, because the task pod has completed, so the task is
+					// aborted rather than waiting for the outputs forever
+					invalidOutputs = err
+					nodeNeedUpdate.Status.Outputs = nil
+					nodeNeedUpdate.Status.ConditionalBranchesStatus.Branches = nil
+					for _, conditionalTask := range nodeNeedUpdate.Spec.ConditionalBranches {
+						nodeNeedUpdate.Status.ConditionalBranchesStatus.Branches = append(nodeNeedUpdate.Status.ConditionalBranchesStatus.Branches,
+							v1alpha1.ConditionalBranchStatus{
+								Target:           conditionalTask.Target,
+								EvaluationResult: corev1.ConditionFalse,
+							})
+					}
+					SetCondition(&nodeNeedUpdate.Status, v1alpha1.WorkflowNodeCondition{
+						Type:   v1alpha1.ConditionAborted,
+						Status: corev1.ConditionTrue,
+						Reason: v1alpha1.TaskOutputsInvalid,
+					})
+					return it.kubeClient.Status().Update(ctx, &nodeNeedUpdate)
+				}
+
 				evaluator := task.NewEvaluator(it.logger, it.kubeClient)
 				evaluateConditionBranches, err := evaluator.EvaluateConditionBranches(nodeNeedUpdate.Spec.ConditionalBranches, env)
 				if err != nil {
@@ -183,6 +218,10 @@ func (it *TaskReconciler) Reconcile(ctx context.Context, request reconcile.Reque
 			if client.IgnoreNotFound(updateError) != nil {
 				it.logger.Error(updateError, "failed to update the condition status of task",
 					"task", request)
+				return reconcile.Result{}, updateError
+			}
+			if updateError == nil && invalidOutputs != nil {
+				it.eventRecorder.Event(&node, recorder.TaskOutputsInvalid{Err: invalidOutputs.Error()})
 			}
 		}
 	} else {
@@ -371,7 +410,13 @@ func (it *TaskReconciler) syncChildNodes(ctx context.Context, evaluatedNode v1al
 		return err
 	}
 
-	childNodes, err := renderNodesByTemplates(&parentWorkflow, &evaluatedNode, tasks...)
+	env, err := renderContext(ctx, it.kubeClient, &parentWorkflow)
+	if err != nil {
+		it.logger.Error(err, "failed to collect the context for rendering children childNodes",
+			"node", fmt.Sprintf("%s/%s", evaluatedNode.Namespace, evaluatedNode.Name))
+		return err
+	}
+	childNodes, err := renderNodesByTemplates(&parentWorkflow, &evaluatedNode, env, tasks...)
 	if err != nil {
 		it.logger.Error(err, "failed to render children childNodes",
 			"node", fmt.Sprintf("%s/%s", evaluatedNode.Namespace, evaluatedNode.Name))
@@ -398,6 +443,45 @@ func (it *TaskReconciler) syncChildNodes(ctx context.Context, evaluatedNode v1al
 	return nil
 }
 
+// invalidOutputsError means the outputs of the completed task could never be valid, unlike the
+// failures of listing the other nodes, which should be retried.
+type invalidOutputsError struct {
+	error
+}
+
+func (e invalidOutputsError) Unwrap() error {
+	return e.error
+}
+
+// collectOutputs parses the outputs of the task into the status of node, then extends the env
+// with the outputs published by the nodes of the workflow, including the task itself.
+func (it *TaskReconciler) collectOutputs(ctx context.Context, node *v1alpha1.WorkflowNode, env map[string]interface{}) (map[string]interface{}, error) {
+	stdout, _ := env[collector.Stdout].(string)
+	terminationMessage, _ := env[collector.TerminationMessage].(string)
+	outputs, err := task.ParseOutputs(node.Spec.Task.Outputs, stdout, terminationMessage)
+	if err != nil {
+		return nil, invalidOutputsError{err}
+	}
+	node.Status.Outputs = outputs
+
+	workflowOutputs, err := collector.NewOutputsCollector(it.kubeClient, node.Namespace, node.Spec.WorkflowName).CollectContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	values, err := collector.DecodeOutputs(outputs)
+	if err != nil {
+		return nil, invalidOutputsError{err}
+	}
+	workflowOutputs[collector.Outputs].(map[string]interface{})[node.Spec.TemplateName] = values
+
+	result := make(map[string]interface{}, len(env)+1)
+	for key, value := range env {
+		result[key] = value
+	}
+	result[collector.Outputs] = workflowOutputs[collector.Outputs]
+	return result, nil
+}
+
 func (it *TaskReconciler) FetchPodControlledByThisWorkflowNode(ctx context.Context, node v1alpha1.WorkflowNode) ([]corev1.Pod, error) {
 	controlledByThisNode, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{
 		MatchLabels: map[string]string{
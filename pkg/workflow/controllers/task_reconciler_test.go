@@ -0,0 +1,103 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+	"github.com/chaos-mesh/chaos-mesh/pkg/workflow/task/collector"
+)
+
+func Test_collectOutputs(t *testing.T) {
+	listError := errors.New("the server is currently unable to handle the request")
+
+	tests := []struct {
+		name    string
+		outputs []v1alpha1.TaskOutput
+		stdout  string
+		listErr error
+		invalid bool
+		wantErr bool
+	}{
+		{
+			name:    "outputs are collected",
+			outputs: []v1alpha1.TaskOutput{{Name: "ready", Key: "ready"}},
+			stdout:  `{"ready": true}`,
+		}, {
+			name:    "the key is missing",
+			outputs: []v1alpha1.TaskOutput{{Name: "ready", Key: "ready"}},
+			stdout:  `{"count": 1}`,
+			invalid: true,
+			wantErr: true,
+		}, {
+			name:    "the other nodes fail to be listed",
+			outputs: []v1alpha1.TaskOutput{{Name: "ready", Key: "ready"}},
+			stdout:  `{"ready": true}`,
+			listErr: listError,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := v1alpha1.AddToScheme(scheme); err != nil {
+				t.Fatal(err)
+			}
+			kubeClient := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, client client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if tt.listErr != nil {
+						return tt.listErr
+					}
+					return client.List(ctx, list, opts...)
+				},
+			}).Build()
+			it := NewTaskReconciler(kubeClient, nil, nil, logr.Discard())
+
+			node := &v1alpha1.WorkflowNode{
+				Spec: v1alpha1.WorkflowNodeSpec{
+					TemplateName: "check",
+					WorkflowName: "workflow",
+					Task:         &v1alpha1.Task{Outputs: tt.outputs},
+				},
+			}
+			env, err := it.collectOutputs(context.Background(), node, map[string]interface{}{collector.Stdout: tt.stdout})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("collectOutputs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if invalid := errors.As(err, &invalidOutputsError{}); invalid != tt.invalid {
+				t.Errorf("collectOutputs() invalid = %v, want %v", invalid, tt.invalid)
+			}
+			if tt.listErr != nil && errors.Cause(err) != tt.listErr {
+				t.Errorf("collectOutputs() error = %v, want %v", err, tt.listErr)
+			}
+			if !tt.wantErr {
+				outputs := env[collector.Outputs].(map[string]interface{})
+				if got := outputs["check"].(map[string]interface{})["ready"]; got != true {
+					t.Errorf("collectOutputs() outputs.check.ready = %v, want true", got)
+				}
+			}
+		})
+	}
+}
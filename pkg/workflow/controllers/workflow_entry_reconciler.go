@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +33,7 @@ import (
 
 	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
 	"github.com/chaos-mesh/chaos-mesh/controllers/utils/recorder"
+	workflowtemplate "github.com/chaos-mesh/chaos-mesh/pkg/workflow/template"
 )
 
 // WorkflowEntryReconciler watches on Workflow, creates new Entry Node for created Workflow.
@@ -60,6 +62,11 @@ func (it *WorkflowEntryReconciler) Reconcile(ctx context.Context, request reconc
 		return reconcile.Result{}, client.IgnoreNotFound(err)
 	}
 
+	if workflowtemplate.NeedResolve(workflow) {
+		// the entry node will be spawned after the updated workflow is observed
+		return reconcile.Result{}, it.resolveWorkflowTemplate(ctx, request.NamespacedName)
+	}
+
 	entryNodes, err := fetchEntryNode(ctx, it.kubeClient, workflow)
 	if err != nil {
 		it.logger.Error(err, "failed to list entry nodes of workflow",
@@ -231,10 +238,51 @@ func fetchEntryNode(ctx context.Context, kubeClient client.Client, workflow v1al
 	return sortedEntryNodes, nil
 }
 
+// resolveWorkflowTemplate resolves the entry, templates and parameters of the referenced template into the workflow,
+// so the following reconciliations are not affected by the changes of the template.
+func (it *WorkflowEntryReconciler) resolveWorkflowTemplate(ctx context.Context, name types.NamespacedName) error {
+	updateError := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		workflowNeedUpdate := v1alpha1.Workflow{}
+		err := it.kubeClient.Get(ctx, name, &workflowNeedUpdate)
+		if err != nil {
+			return err
+		}
+		if !workflowtemplate.NeedResolve(workflowNeedUpdate) {
+			return nil
+		}
+		templateName := workflowNeedUpdate.Spec.WorkflowTemplateRef.Name
+
+		resolved, err := workflowtemplate.Resolve(ctx, it.kubeClient, workflowNeedUpdate)
+		if err != nil {
+			it.eventRecorder.Event(&workflowNeedUpdate, recorder.WorkflowTemplateResolveFailed{
+				Template: templateName,
+				Error:    err.Error(),
+			})
+			return err
+		}
+
+		workflowNeedUpdate.Spec = resolved.Spec
+		if err := it.kubeClient.Update(ctx, &workflowNeedUpdate); err != nil {
+			return err
+		}
+		it.eventRecorder.Event(&workflowNeedUpdate, recorder.WorkflowTemplateResolved{Template: templateName})
+		return nil
+	})
+	if updateError != nil {
+		it.logger.Error(updateError, "failed to resolve the template of workflow", "workflow", name)
+	}
+	return updateError
+}
+
 // spawnEntryNode will create **one** entry workflow node for current workflow
 func (it *WorkflowEntryReconciler) spawnEntryNode(ctx context.Context, workflow v1alpha1.Workflow) (*v1alpha1.WorkflowNode, error) {
 	// This workflow is just created, create entry node
-	nodes, err := renderNodesByTemplates(&workflow, nil, workflow.Spec.Entry)
+	env, err := renderContext(ctx, it.kubeClient, &workflow)
+	if err != nil {
+		it.logger.Error(err, "failed to collect the context for rendering entry node", "workflow", workflow.Name)
+		return nil, err
+	}
+	nodes, err := renderNodesByTemplates(&workflow, nil, env, workflow.Spec.Entry)
 	if err != nil {
 		it.logger.Error(err, "failed create entry node", "workflow", workflow.Name, "entry", workflow.Spec.Entry)
 		return nil, err
@@ -67,3 +67,11 @@ func DefaultCollector(kubeClient client.Client, restConfig *rest.Config, namespa
 		NewStdoutCollector(restConfig, namespace, podName, containerName),
 	}}
 }
+
+// TaskOutputsCollector returns the DefaultCollector with the termination message, which the outputs of task are parsed from
+func TaskOutputsCollector(kubeClient client.Client, restConfig *rest.Config, namespace, podName, containerName string) Collector {
+	return &ComposeCollector{collectors: []Collector{
+		DefaultCollector(kubeClient, restConfig, namespace, podName, containerName),
+		NewTerminationMessageCollector(kubeClient, namespace, podName, containerName),
+	}}
+}
@@ -39,10 +39,22 @@ func NewExitCodeCollector(kubeClient client.Client, namespace string, podName st
 }
 
 func (it *ExitCodeCollector) CollectContext(ctx context.Context) (env map[string]interface{}, err error) {
+	terminated, err := terminatedState(ctx, it.kubeClient, it.namespace, it.podName, it.containerName)
+	if err != nil || terminated == nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		ExitCode: terminated.ExitCode,
+	}, nil
+}
+
+// terminatedState returns the terminated state of the container, or nil if the pod does not exist.
+func terminatedState(ctx context.Context, kubeClient client.Client, namespace string, podName string, containerName string) (*corev1.ContainerStateTerminated, error) {
 	var pod corev1.Pod
-	err = it.kubeClient.Get(ctx, types.NamespacedName{
-		Namespace: it.namespace,
-		Name:      it.podName,
+	err := kubeClient.Get(ctx, types.NamespacedName{
+		Namespace: namespace,
+		Name:      podName,
 	}, &pod)
 
 	if apierrors.IsNotFound(err) {
@@ -56,7 +68,7 @@ func (it *ExitCodeCollector) CollectContext(ctx context.Context) (env map[string
 	var targetContainerStatus corev1.ContainerStatus
 	found := false
 	for _, containerStatus := range pod.Status.ContainerStatuses {
-		if containerStatus.Name == it.containerName {
+		if containerStatus.Name == containerName {
 			targetContainerStatus = containerStatus
 			found = true
 			break
@@ -64,14 +76,12 @@ func (it *ExitCodeCollector) CollectContext(ctx context.Context) (env map[string
 	}
 
 	if !found {
-		return nil, errors.Errorf("no such contaienr called %s in pod %s/%s", it.containerName, pod.Namespace, pod.Name)
+		return nil, errors.Errorf("no such contaienr called %s in pod %s/%s", containerName, pod.Namespace, pod.Name)
 	}
 
 	if targetContainerStatus.State.Terminated == nil {
-		return nil, errors.Errorf("container %s in pod %s/%s is waiting or running, not in ternimated", it.containerName, pod.Namespace, pod.Name)
+		return nil, errors.Errorf("container %s in pod %s/%s is waiting or running, not in ternimated", containerName, pod.Namespace, pod.Name)
 	}
 
-	return map[string]interface{}{
-		ExitCode: targetContainerStatus.State.Terminated.ExitCode,
-	}, nil
+	return targetContainerStatus.State.Terminated, nil
 }
@@ -0,0 +1,86 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package collector
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+const Outputs string = "outputs"
+
+// OutputsCollector collects the outputs published by the nodes of a workflow,
+// they are organized as outputs.<template name>.<output name>.
+type OutputsCollector struct {
+	kubeClient   client.Reader
+	namespace    string
+	workflowName string
+}
+
+func NewOutputsCollector(kubeClient client.Reader, namespace string, workflowName string) *OutputsCollector {
+	return &OutputsCollector{kubeClient: kubeClient, namespace: namespace, workflowName: workflowName}
+}
+
+func (it *OutputsCollector) CollectContext(ctx context.Context) (env map[string]interface{}, err error) {
+	var nodes v1alpha1.WorkflowNodeList
+	err = it.kubeClient.List(ctx, &nodes, client.InNamespace(it.namespace), client.MatchingLabels{
+		v1alpha1.LabelWorkflow: it.workflowName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the latest node wins if a template is instantiated more than once
+	latest := make(map[string]v1alpha1.WorkflowNode)
+	for _, node := range nodes.Items {
+		if len(node.Status.Outputs) == 0 {
+			continue
+		}
+		if existed, ok := latest[node.Spec.TemplateName]; ok && node.CreationTimestamp.Before(&existed.CreationTimestamp) {
+			continue
+		}
+		latest[node.Spec.TemplateName] = node
+	}
+
+	outputs := make(map[string]interface{})
+	for templateName, node := range latest {
+		values, err := DecodeOutputs(node.Status.Outputs)
+		if err != nil {
+			return nil, errors.Wrapf(err, "decode outputs of node %s/%s", node.Namespace, node.Name)
+		}
+		outputs[templateName] = values
+	}
+
+	return map[string]interface{}{Outputs: outputs}, nil
+}
+
+// DecodeOutputs decodes the outputs encoded in JSON
+func DecodeOutputs(outputs map[string]string) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(outputs))
+	for name, encoded := range outputs {
+		var value interface{}
+		if err := json.Unmarshal([]byte(encoded), &value); err != nil {
+			return nil, errors.Wrapf(err, "decode output %s", name)
+		}
+		result[name] = value
+	}
+	return result, nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package collector
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const TerminationMessage string = "terminationMessage"
+
+type TerminationMessageCollector struct {
+	kubeClient    client.Client
+	namespace     string
+	podName       string
+	containerName string
+}
+
+func NewTerminationMessageCollector(kubeClient client.Client, namespace string, podName string, containerName string) *TerminationMessageCollector {
+	return &TerminationMessageCollector{kubeClient: kubeClient, namespace: namespace, podName: podName, containerName: containerName}
+}
+
+func (it *TerminationMessageCollector) CollectContext(ctx context.Context) (env map[string]interface{}, err error) {
+	terminated, err := terminatedState(ctx, it.kubeClient, it.namespace, it.podName, it.containerName)
+	if err != nil || terminated == nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		TerminationMessage: terminated.Message,
+	}, nil
+}
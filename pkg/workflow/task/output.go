@@ -0,0 +1,80 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+package task
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// OutputFile returns the path of the file which the outputs of task are read from,
+// or an empty string if no output is read from file.
+func OutputFile(task v1alpha1.Task) string {
+	for _, output := range task.Outputs {
+		if output.From == v1alpha1.TaskOutputFromFile {
+			return output.Path
+		}
+	}
+	return ""
+}
+
+// ParseOutputs parses the outputs of task from the stdout and the content of the output file,
+// the value of each output is encoded in JSON.
+func ParseOutputs(outputs []v1alpha1.TaskOutput, stdout string, file string) (map[string]string, error) {
+	if len(outputs) == 0 {
+		return nil, nil
+	}
+
+	result := make(map[string]string)
+	for _, output := range outputs {
+		content := stdout
+		if output.From == v1alpha1.TaskOutputFromFile {
+			content = file
+		}
+
+		value := parseContent(content)
+		if len(output.Key) > 0 {
+			object, ok := value.(map[string]interface{})
+			if !ok {
+				return nil, errors.Errorf("output %s: content is not a JSON object", output.Name)
+			}
+			if value, ok = object[output.Key]; !ok {
+				return nil, errors.Errorf("output %s: key %s not found", output.Name, output.Key)
+			}
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "output %s: encode value", output.Name)
+		}
+		result[output.Name] = string(encoded)
+	}
+	return result, nil
+}
+
+// parseContent parses the content as JSON, or returns the trimmed content itself if it's not a valid JSON.
+func parseContent(content string) interface{} {
+	content = strings.TrimSpace(content)
+	var value interface{}
+	if err := json.Unmarshal([]byte(content), &value); err != nil {
+		return content
+	}
+	return value
+}
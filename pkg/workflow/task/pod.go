@@ -33,6 +33,10 @@ func SpawnPodForTask(task v1alpha1.Task) (corev1.PodSpec, error) {
 		deepCopiedContainer.Resources.Limits.Cpu().SetMilli(1000)
 		deepCopiedContainer.Resources.Limits.Memory().Set(1000)
 	}
+	if outputFile := OutputFile(task); len(outputFile) > 0 {
+		deepCopiedContainer.TerminationMessagePath = outputFile
+		deepCopiedContainer.TerminationMessagePolicy = corev1.TerminationMessageReadFile
+	}
 	result := corev1.PodSpec{
 		RestartPolicy: corev1.RestartPolicyNever,
 		Volumes:       attachVolumes(task),
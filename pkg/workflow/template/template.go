@@ -0,0 +1,69 @@
+// Copyright 2026 Chaos Mesh Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+
+// Package template resolves the WorkflowTemplate or ClusterWorkflowTemplate referenced by a workflow
+package template
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/chaos-mesh/chaos-mesh/api/v1alpha1"
+)
+
+// NeedResolve returns whether the workflow refers a template which has not been resolved yet.
+func NeedResolve(workflow v1alpha1.Workflow) bool {
+	return workflow.Spec.WorkflowTemplateRef != nil && len(workflow.Spec.Templates) == 0
+}
+
+// Fetch fetches the spec of WorkflowTemplate or ClusterWorkflowTemplate referenced by the workflow.
+func Fetch(ctx context.Context, kubeClient client.Reader, workflow v1alpha1.Workflow) (*v1alpha1.WorkflowTemplateSpec, error) {
+	ref := workflow.Spec.WorkflowTemplateRef
+	if ref == nil {
+		return nil, errors.New("workflow does not refer any template")
+	}
+
+	if ref.ClusterScope {
+		template := v1alpha1.ClusterWorkflowTemplate{}
+		if err := kubeClient.Get(ctx, types.NamespacedName{Name: ref.Name}, &template); err != nil {
+			return nil, errors.Wrapf(err, "get cluster workflow template %s", ref.Name)
+		}
+		return &template.Spec, nil
+	}
+
+	template := v1alpha1.WorkflowTemplate{}
+	if err := kubeClient.Get(ctx, types.NamespacedName{Namespace: workflow.Namespace, Name: ref.Name}, &template); err != nil {
+		return nil, errors.Wrapf(err, "get workflow template %s/%s", workflow.Namespace, ref.Name)
+	}
+	return &template.Spec, nil
+}
+
+// Resolve returns a copy of the workflow with the entry, templates and parameters of the referenced template,
+// overridden by the parameters of the workflow.
+func Resolve(ctx context.Context, kubeClient client.Reader, workflow v1alpha1.Workflow) (*v1alpha1.Workflow, error) {
+	template, err := Fetch(ctx, kubeClient, workflow)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := workflow.DeepCopy()
+	if err := resolved.Spec.ApplyTemplate(*template); err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
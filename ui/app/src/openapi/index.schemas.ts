@@ -26,6 +26,39 @@ export type GetWorkflowsParams = {
   status?: GetWorkflowsStatus
 }
 
+export type PostWorkflowsParams = {
+  /**
+   * the value of workflow parameter, in the form of name=value
+   */
+  parameter?: string[]
+}
+
+export type GetTemplatesWorkflowsWorkflowParams = {
+  /**
+   * the namespace of workflow template
+   */
+  namespace?: string
+  /**
+   * the name of workflow template
+   */
+  name: string
+  /**
+   * whether the template is a cluster workflow template
+   */
+  cluster_scope?: boolean
+}
+
+export type GetTemplatesWorkflowsParams = {
+  /**
+   * filter workflow templates by namespace, the cluster workflow templates are always listed
+   */
+  namespace?: string
+  /**
+   * filter workflow templates by name
+   */
+  name?: string
+}
+
 export type GetTemplatesStatuschecksStatuscheckParams = {
   /**
    * the namespace of status check templates
@@ -343,9 +376,57 @@ export type V1alpha1TemplateBody = V1alpha1Template
  */
 export type V1alpha1WorkflowBody = V1alpha1Workflow
 
+export interface V1alpha1WorkflowTemplateSpec {
+  /** Description describes the scenario of the template.
++optional */
+  description?: string
+  entry?: string
+  /** Parameters describes the parameters of the template, they could be overridden by the
+parameters of the workflows referencing the template.
++optional */
+  parameters?: V1alpha1WorkflowParameter[]
+  templates?: V1alpha1Template[]
+}
+
+export interface V1alpha1WorkflowTemplateRef {
+  /** ClusterScope means the referenced template is a ClusterWorkflowTemplate.
++optional */
+  clusterScope?: boolean
+  /** Name is the name of the referenced template. */
+  name?: string
+}
+
 export interface V1alpha1WorkflowSpec {
+  /** Entry is the name of the template to start with, it's required unless WorkflowTemplateRef is specified.
++optional */
   entry?: string
+  /** Parameters describes the parameters of workflow, they could be referenced as `parameters.<name>`
+in the placeholders of the string fields of the chaos, schedule and status check in templates,
+and the deadline of templates.
++optional */
+  parameters?: V1alpha1WorkflowParameter[]
+  /** Templates describes the templates of workflow, they are required unless WorkflowTemplateRef is specified.
++optional */
   templates?: V1alpha1Template[]
+  /** WorkflowTemplateRef refers to a reusable template. If Templates is empty, the entry, templates and
+parameters of the referenced template are resolved into the workflow before it's scheduled, and the
+parameters specified in the workflow override the ones of the template.
++optional */
+  workflowTemplateRef?: V1alpha1WorkflowTemplateRef
+}
+
+export interface V1alpha1WorkflowParameter {
+  /** Default is the default value of parameter, it's used when Value is not specified.
++optional */
+  default?: string
+  /** Description describes the usage of parameter.
++optional */
+  description?: string
+  /** Name is the name of parameter, it should be a valid identifier. */
+  name?: string
+  /** Value is the value of parameter.
++optional */
+  value?: string
 }
 
 export interface V1alpha1WorkflowCondition {
@@ -577,6 +658,7 @@ If not set, the first container will be injected
   containerNames?: string[]
   /** Duration represents the duration of the chaos action */
   duration?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** Mode defines the mode to run chaos action.
 Supported mode: one / all / fixed / fixed-percent / random-max-percent
 +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
@@ -584,6 +666,16 @@ Supported mode: one / all / fixed / fixed-percent / random-max-percent
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   /** TimeOffset defines the delta time of injected program. It's a possibly signed sequence of decimal numbers, such as
 "300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h". */
@@ -598,12 +690,37 @@ IF `RandomMaxPercentMode`,  provide a number from 0-100 to specify the max perce
 
 export interface V1alpha1Task {
   container?: V1Container
+  /** Outputs describes the named outputs published by the task, they could be referenced as
+`outputs.<template name>.<output name>` in the expressions of conditional branches and
+the templated fields of the following nodes.
++optional */
+  outputs?: V1alpha1TaskOutput[]
   /** Volumes is a list of volumes that can be mounted by containers in a template.
 +patchStrategy=merge
 +patchMergeKey=name */
   volumes?: V1Volume[]
 }
 
+export interface V1alpha1TaskOutput {
+  /** From is the source of the output, the content of the source is parsed as JSON,
+or used as a plain string if it's not a valid JSON.
++optional
++kubebuilder:validation:Enum=Stdout;File
++kubebuilder:default=Stdout */
+  from?: string
+  /** Key selects the value with the key from the parsed JSON object.
+The whole parsed content is used if it's empty.
++optional */
+  key?: string
+  /** Name is the name of the output. */
+  name?: string
+  /** Path is the path of the file which the output is read from. Only used when From is File.
+The file is used as the termination message of the container, so all the outputs
+of a task share the same file, and its size should not exceed 4096 bytes.
++optional */
+  path?: string
+}
+
 export interface V1alpha1Template {
   /** AbortWithStatusCheck describe whether to abort the workflow when the failure threshold of StatusCheck is exceeded.
 Only used when Type is TypeStatusCheck.
@@ -612,24 +729,36 @@ Only used when Type is TypeStatusCheck.
   awsChaos?: V1alpha1AWSChaosSpec
   azureChaos?: V1alpha1AzureChaosSpec
   blockChaos?: V1alpha1BlockChaosSpec
-  /** Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial or TypeParallel.
+  /** Children describes the children steps of serial or parallel node. Only used when Type is TypeSerial, TypeParallel,
+TypeRetry, TypeLoop or TypeForEach. The last three types accept exactly one child.
 +optional */
   children?: string[]
+  compositeChaos?: V1alpha1CompositeChaosSpec
   /** ConditionalBranches describes the conditional branches of custom tasks. Only used when Type is TypeTask.
 +optional */
   conditionalBranches?: V1alpha1ConditionalBranch[]
   /** +optional */
   deadline?: string
   dnsChaos?: V1alpha1DNSChaosSpec
+  /** ForEach describes the behavior of ForEach. Only used when Type is TypeForEach.
++optional */
+  forEach?: V1alpha1ForEachSpec
   gcpChaos?: V1alpha1GCPChaosSpec
   httpChaos?: V1alpha1HTTPChaosSpec
   ioChaos?: V1alpha1IOChaosSpec
   jvmChaos?: V1alpha1JVMChaosSpec
   kernelChaos?: V1alpha1KernelChaosSpec
+  /** Loop describes the behavior of Loop. Only used when Type is TypeLoop.
++optional */
+  loop?: V1alpha1LoopSpec
   name?: string
   networkChaos?: V1alpha1NetworkChaosSpec
   physicalmachineChaos?: V1alpha1PhysicalMachineChaosSpec
   podChaos?: V1alpha1PodChaosSpec
+  podfaultChaos?: V1alpha1PodFaultChaosSpec
+  /** Retry describes the behavior of Retry. Only used when Type is TypeRetry.
++optional */
+  retry?: V1alpha1RetrySpec
   schedule?: V1alpha1ChaosOnlyScheduleSpec
   statusCheck?: V1alpha1StatusCheckSpec
   stressChaos?: V1alpha1StressChaosSpec
@@ -659,6 +788,7 @@ If not set, the first container will be injected
   /** Duration represents the duration of the chaos action
 +optional */
   duration?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** Mode defines the mode to run chaos action.
 Supported mode: one / all / fixed / fixed-percent / random-max-percent
 +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
@@ -666,6 +796,16 @@ Supported mode: one / all / fixed / fixed-percent / random-max-percent
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   /** StressngStressors defines plenty of stressors just like `Stressors` except that it's an experimental
 feature and more powerful. You can define stressors in `stress-ng` (see also `man stress-ng`) dialect,
@@ -676,6 +816,7 @@ wins.
 +optional */
   stressngStressors?: string
   stressors?: V1alpha1Stressors
+  throttle?: V1alpha1CgroupThrottle
   /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
 If `FixedMode`, provide an integer of pods to do chaos action.
 If `FixedPercentMode`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
@@ -813,6 +954,7 @@ export interface V1alpha1ScheduleSpec {
   awsChaos?: V1alpha1AWSChaosSpec
   azureChaos?: V1alpha1AzureChaosSpec
   blockChaos?: V1alpha1BlockChaosSpec
+  compositeChaos?: V1alpha1CompositeChaosSpec
   /** +optional
 +kubebuilder:default=Forbid
 +kubebuilder:validation:Enum=Forbid;Allow */
@@ -829,6 +971,7 @@ export interface V1alpha1ScheduleSpec {
   networkChaos?: V1alpha1NetworkChaosSpec
   physicalmachineChaos?: V1alpha1PhysicalMachineChaosSpec
   podChaos?: V1alpha1PodChaosSpec
+  podfaultChaos?: V1alpha1PodFaultChaosSpec
   schedule?: string
   /** +optional
 +nullable
@@ -1020,6 +1163,52 @@ More info: http://kubernetes.io/docs/user-guide/identifiers#uids
   uid?: string
 }
 
+export interface V1alpha1RolloutSpec {
+  /** BatchSize is the number of targets to be injected in every batch.
++kubebuilder:validation:Minimum=1 */
+  batchSize?: number
+  /** Interval is the duration to wait after a batch has been injected, before injecting the next batch.
++optional */
+  interval?: string
+  /** StatusCheck is the name of a StatusCheck in the namespace of the chaos. If it's set, the next batch is
+injected only if the status check hasn't failed, and the rollout is halted once the status check fails.
+A `Synchronous` status check has to be completed before injecting the next batch.
++optional */
+  statusCheck?: string
+  /** StopOnFailure stops injecting the following batches once a target of the current batch fails to be
+injected.
++optional */
+  stopOnFailure?: boolean
+}
+
+export interface V1alpha1RetrySpec {
+  /** Backoff is the duration to wait before the first retry.
++optional */
+  backoff?: string
+  /** Expression decides whether an attempt succeeds, the outputs of nodes and the parameters are available in it.
+If it's empty, an attempt succeeds unless it's aborted, it's a task exiting with non-zero code, or it's
+a status check exceeding the failure threshold.
++optional */
+  expression?: string
+  /** Factor is the multiplier applied to the backoff after each retry.
++optional
++kubebuilder:validation:Minimum=1 */
+  factor?: number
+  /** MaxAttempts is the maximum number of attempts, including the first one.
++kubebuilder:validation:Minimum=1 */
+  maxAttempts?: number
+  /** MaxBackoff is the upper limit of the backoff.
++optional */
+  maxBackoff?: string
+}
+
+export interface V1alpha1ReselectSpec {
+  /** Interval is the period to select the targets again.
++optional
++kubebuilder:default="1m" */
+  interval?: string
+}
+
 export interface V1alpha1ReorderSpec {
   /** +optional */
   correlation?: string
@@ -1172,6 +1361,8 @@ export interface V1alpha1PodSelector {
 Supported mode: one / all / fixed / fixed-percent / random-max-percent
 +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
   mode?: string
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
 If `FixedMode`, provide an integer of pods to do chaos action.
@@ -1181,6 +1372,14 @@ IF `RandomMaxPercentMode`,  provide a number from 0-100 to specify the max perce
   value?: string
 }
 
+export interface V1alpha1PodHttpChaosRate {
+  /** Burst is the maximum number of injections allowed at once, it's the same as Limit if not set.
++optional */
+  burst?: number
+  /** Limit is the number of injections allowed per second. */
+  limit?: number
+}
+
 export interface V1alpha1PodHttpChaosTLS {
   /** CAName represents the data name of ca file in secret, `ca.crt` for example
 +optional */
@@ -1253,6 +1452,83 @@ For example: `[["foo", "bar"], ["foo", "unknown"]]`.
   queries?: string[][]
 }
 
+export interface V1alpha1PodFaultChaosSpec {
+  /** Action defines the specific fault to be injected into the selected containers.
++kubebuilder:validation:Enum=file-create;file-modify;file-delete;file-rename;file-append;file-replace;process;redis-expiration;redis-penetration;redis-cacheLimit;kafka-fill;kafka-flood;kafka-io */
+  action?: string
+  /** ContainerNames indicates list of the name of affected container.
+If not set, the first container will be injected
++optional */
+  containerNames?: string[]
+  /** Duration represents the duration of the chaos action.
++optional */
+  duration?: string
+  /** +ui:form:when=action=='file-append'
++optional */
+  'file-append'?: V1alpha1FileAppendSpec
+  /** +ui:form:when=action=='file-create'
++optional */
+  'file-create'?: V1alpha1FileCreateSpec
+  /** +ui:form:when=action=='file-delete'
++optional */
+  'file-delete'?: V1alpha1FileDeleteSpec
+  /** +ui:form:when=action=='file-modify'
++optional */
+  'file-modify'?: V1alpha1FileModifyPrivilegeSpec
+  /** +ui:form:when=action=='file-rename'
++optional */
+  'file-rename'?: V1alpha1FileRenameSpec
+  /** +ui:form:when=action=='file-replace'
++optional */
+  'file-replace'?: V1alpha1FileReplaceSpec
+  intermittent?: V1alpha1IntermittentSpec
+  /** +ui:form:when=action=='kafka-fill'
++optional */
+  'kafka-fill'?: V1alpha1KafkaFillSpec
+  /** +ui:form:when=action=='kafka-flood'
++optional */
+  'kafka-flood'?: V1alpha1KafkaFloodSpec
+  /** +ui:form:when=action=='kafka-io'
++optional */
+  'kafka-io'?: V1alpha1KafkaIOSpec
+  /** Mode defines the mode to run chaos action.
+Supported mode: one / all / fixed / fixed-percent / random-max-percent
++kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
+  mode?: string
+  /** +ui:form:when=action=='process'
++optional */
+  process?: V1alpha1ProcessSpec
+  /** +ui:form:when=action=='redis-cacheLimit'
++optional */
+  'redis-cacheLimit'?: V1alpha1RedisCacheLimitSpec
+  /** +ui:form:when=action=='redis-expiration'
++optional */
+  'redis-expiration'?: V1alpha1RedisExpirationSpec
+  /** +ui:form:when=action=='redis-penetration'
++optional */
+  'redis-penetration'?: V1alpha1RedisPenetrationSpec
+  /** RemoteCluster represents the remote cluster where the chaos will be deployed
++optional */
+  remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
+  selector?: V1alpha1PodSelectorSpec
+  /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
+If `FixedMode`, provide an integer of pods to do chaos action.
+If `FixedPercentMode`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
+IF `RandomMaxPercentMode`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
++optional */
+  value?: string
+}
+
 export interface V1alpha1PodChaosSpec {
   /** Action defines the specific pod chaos action.
 Supported action: pod-kill / pod-failure / container-kill
@@ -1276,6 +1552,7 @@ Value must be non-negative integer. The default value is zero that indicates del
 +optional
 +kubebuilder:validation:Minimum=0 */
   gracePeriod?: number
+  intermittent?: V1alpha1IntermittentSpec
   /** Mode defines the mode to run chaos action.
 Supported mode: one / all / fixed / fixed-percent / random-max-percent
 +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
@@ -1283,6 +1560,16 @@ Supported mode: one / all / fixed / fixed-percent / random-max-percent
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
 If `FixedMode`, provide an integer of pods to do chaos action.
@@ -1342,6 +1629,9 @@ The key defines the namespace which physical machine belong,
 and each value is a set of physical machine names.
 +optional */
   physicalMachines?: V1alpha1PhysicalMachineSelectorSpecPhysicalMachines
+  /** SkipUnhealthy skips the physical machines whose chaosd is probed to be unreachable.
++optional */
+  skipUnhealthy?: boolean
 }
 
 export interface V1alpha1PMJVMMySQLSpec {
@@ -1541,6 +1831,7 @@ Default action: delay
   /** ExternalTargets represents network targets outside k8s
 +optional */
   externalTargets?: string[]
+  intermittent?: V1alpha1IntermittentSpec
   /** Loss represents the detail about loss action
 +ui:form:when=action=='loss'
 +optional */
@@ -1552,6 +1843,16 @@ Supported mode: one / all / fixed / fixed-percent / random-max-percent
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   target?: V1alpha1PodSelector
   /** TargetDevice represents the network device to be affected in target scope.
@@ -1621,6 +1922,20 @@ export interface V1alpha1LossSpec {
   loss?: string
 }
 
+export interface V1alpha1LoopSpec {
+  /** Count is the maximum number of iterations.
++optional
++kubebuilder:validation:Minimum=1 */
+  count?: number
+  /** Interval is the duration to wait between iterations.
++optional */
+  interval?: string
+  /** Until is the expression evaluated after each iteration, the loop stops when it's evaluated as true.
+The outputs of nodes, the parameters and the index of iteration are available in it.
++optional */
+  until?: string
+}
+
 export interface V1alpha1KernelChaosSpec {
   /** ContainerNames indicates list of the name of affected container.
 If not set, the first container will be injected
@@ -1629,6 +1944,7 @@ If not set, the first container will be injected
   /** Duration represents the duration of the chaos action */
   duration?: string
   failKernRequest?: V1alpha1FailKernRequest
+  intermittent?: V1alpha1IntermittentSpec
   /** Mode defines the mode to run chaos action.
 Supported mode: one / all / fixed / fixed-percent / random-max-percent
 +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
@@ -1636,6 +1952,16 @@ Supported mode: one / all / fixed / fixed-percent / random-max-percent
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
 If `FixedMode`, provide an integer of pods to do chaos action.
@@ -1822,6 +2148,7 @@ Only one of Address and Selector could be specified.
   /** +ui:form:when=action=='http-request'
 +optional */
   'http-request'?: V1alpha1HTTPRequestSpec
+  intermittent?: V1alpha1IntermittentSpec
   /** +ui:form:when=action=='jvm-exception'
 +optional */
   'jvm-exception'?: V1alpha1JVMExceptionSpec
@@ -1904,6 +2231,15 @@ Supported mode: one / all / fixed / fixed-percent / random-max-percent
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PhysicalMachineSelectorSpec
   /** +ui:form:when=action=='stress-cpu'
 +optional */
@@ -1950,6 +2286,7 @@ default value is "", means match all database */
 the exception which needs to throw for action `exception`
 or the exception message needs to throw in action `mysql` */
   exception?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** +optional
 the latency duration for action 'latency', unit ms
 or the latency duration in action `mysql` */
@@ -1977,6 +2314,16 @@ the port of agent server, default 9277 */
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   /** +optional
 the byteman rule's data for action 'ruleData' */
   ruleData?: string
@@ -1993,6 +2340,35 @@ the return value for action 'return' */
   value?: string
 }
 
+export interface V1alpha1IntermittentSpec {
+  /** Jitter is the maximum random duration added to every on and off period.
++optional */
+  jitter?: string
+  /** OffPeriod is the duration to keep the chaos recovered in every cycle. */
+  offPeriod?: string
+  /** OnPeriod is the duration to keep the chaos injected in every cycle. */
+  onPeriod?: string
+}
+
+export interface V1alpha1IOThrottle {
+  /** Device is the block device, either the path in the container like "/dev/sda", or "<major>:<minor>" */
+  device?: string
+  /** ReadBytesPerSecond limits the read bandwidth, in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. "10MiB"
++optional */
+  readBytesPerSecond?: string
+  /** ReadIOPS limits the read operations per second
++kubebuilder:validation:Minimum=1
++optional */
+  readIOPS?: number
+  /** WriteBytesPerSecond limits the write bandwidth, in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. "10MiB"
++optional */
+  writeBytesPerSecond?: string
+  /** WriteIOPS limits the write operations per second
++kubebuilder:validation:Minimum=1
++optional */
+  writeIOPS?: number
+}
+
 export interface V1alpha1IOChaosSpec {
   /** Action defines the specific pod chaos action.
 Supported action: latency / fault / attrOverride / mistake
@@ -2027,6 +2403,7 @@ refer to: https://www-numi.fnal.gov/offline_software/srt_public_context/WebDocs/
 +ui:form:when=action=='fault'
 +optional */
   errno?: number
+  intermittent?: V1alpha1IntermittentSpec
   /** Methods defines the I/O methods for injecting I/O chaos action.
 default: all I/O methods.
 +optional */
@@ -2050,6 +2427,16 @@ default: 100.
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
 If `FixedMode`, provide an integer of pods to do chaos action.
@@ -2139,9 +2526,21 @@ such as "300ms", "2h45m".
 Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
 +optional */
   delay?: string
+  /** Direction is the direction of the traffic to be injected, <Inbound|Outbound>.
+The inbound traffic is received by the selected pods, and the outbound traffic is sent
+by the selected pods to their dependencies.
++optional
++kubebuilder:validation:Enum=Inbound;Outbound
++kubebuilder:default=Inbound */
+  direction?: string
   /** Duration represents the duration of the chaos action.
 +optional */
   duration?: string
+  /** Host is a rule to select target by the host in http request, which is the destination of
+the outbound traffic.
++optional */
+  host?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** Method is a rule to select target by http method in request.
 +optional */
   method?: string
@@ -2155,14 +2554,34 @@ Supported mode: one / all / fixed / fixed-percent / random-max-percent
   /** Path is a rule to select target by uri path in http request.
 +optional */
   path?: string
-  /** Port represents the target port to be proxy of. */
+  /** Percent is the percentage of the selected requests or responses to be injected, in [1, 100].
+All of them are injected if it's not set.
++optional
++kubebuilder:validation:Minimum=1
++kubebuilder:validation:Maximum=100 */
+  percent?: number
+  /** Port represents the target port to be proxy of.
+For the outbound traffic, it's the port of the destination. */
   port?: number
+  /** Rate limits the injections with a token bucket.
++optional */
+  rate?: V1alpha1PodHttpChaosRate
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
   /** Replace is a rule to replace some contents in target.
 +optional */
   replace?: V1alpha1PodHttpChaosReplaceActions
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   /** RequestHeaders is a rule to select target by http headers in request.
 The key-value pairs represent header name and header value pairs.
 +optional */
@@ -2175,6 +2594,10 @@ The key-value pairs represent header name and header value pairs.
   /** Target is the object to be selected and injected.
 +kubebuilder:validation:Enum=Request;Response */
   target?: string
+  /** Times is the maximum times to inject, the rule stops injecting once it's reached.
++optional
++kubebuilder:validation:Minimum=1 */
+  times?: number
   tls?: V1alpha1PodHttpChaosTLS
   /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
 If `FixedMode`, provide an integer of pods to do chaos action.
@@ -2215,11 +2638,20 @@ Needed in disk-loss.
   duration?: string
   /** Instance defines the name of the instance */
   instance?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** Project defines the ID of gcp project. */
   project?: string
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
   /** SecretName defines the name of kubernetes secret. It is used for GCP credentials.
 +optional */
   secretName?: string
@@ -2241,6 +2673,18 @@ to inject for all d_alloc_parallel call chain. */
   predicate?: string
 }
 
+export interface V1alpha1ForEachSpec {
+  /** Items is the expression evaluated as the list of items, like `parameters.namespaces` or `outputs.pick.pods`.
+A string result is parsed as a JSON list, or split by comma. The item and its index are available as `item`
+and `index` in the templated fields of the child and its descendants. */
+  items?: string
+  /** Parallelism is the maximum number of children running at the same time. All the children run
+at the same time if it's not specified.
++optional
++kubebuilder:validation:Minimum=1 */
+  parallelism?: number
+}
+
 export interface V1alpha1FileReplaceSpec {
   /** DestStr is the destination string of the file. */
   'dest-string'?: string
@@ -2375,6 +2819,7 @@ If not set, the first container will be injected
   containerNames?: string[]
   /** Duration represents the duration of the chaos action */
   duration?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** Mode defines the mode to run chaos action.
 Supported mode: one / all / fixed / fixed-percent / random-max-percent
 +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
@@ -2391,6 +2836,16 @@ For example:
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
 If `FixedMode`, provide an integer of pods to do chaos action.
@@ -2414,6 +2869,123 @@ export interface V1alpha1ConditionalBranch {
   target?: string
 }
 
+export interface V1alpha1CompositeTimeFault {
+  /** ClockIds defines all affected clock id, see also the same field of TimeChaos.
+Default value is ["CLOCK_REALTIME"] */
+  clockIds?: string[]
+  /** TimeOffset defines the delta time of injected program. It's a possibly signed sequence of decimal numbers, such as
+"300ms", "-1.5h" or "2h45m". Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h". */
+  timeOffset?: string
+}
+
+export interface V1alpha1CompositeStressFault {
+  /** StressngStressors defines the stressors in `stress-ng` dialect, see also the same field of StressChaos.
++optional */
+  stressngStressors?: string
+  stressors?: V1alpha1Stressors
+}
+
+export interface V1alpha1CompositeNetworkFault {
+  /** Action defines the specific network chaos action.
+Supported action: netem, delay, loss, duplicate, corrupt, bandwidth
++kubebuilder:validation:Enum=netem;delay;loss;duplicate;corrupt;bandwidth */
+  action?: string
+  bandwidth?: V1alpha1BandwidthSpec
+  corrupt?: V1alpha1CorruptSpec
+  delay?: V1alpha1DelaySpec
+  /** Device represents the network device to be affected.
++optional */
+  device?: string
+  duplicate?: V1alpha1DuplicateSpec
+  /** ExternalTargets represents network targets outside k8s
++optional */
+  externalTargets?: string[]
+  loss?: V1alpha1LossSpec
+  rate?: V1alpha1RateSpec
+}
+
+export interface V1alpha1CompositeIOFault {
+  /** Action defines the specific pod chaos action.
+Supported action: latency / fault / attrOverride / mistake
++kubebuilder:validation:Enum=latency;fault;attrOverride;mistake */
+  action?: string
+  attr?: V1alpha1AttrOverrideSpec
+  /** Delay defines the value of I/O chaos action delay.
+A delay string is a possibly signed sequence of
+decimal numbers, each with optional fraction and a unit suffix,
+such as "300ms".
+Valid time units are "ns", "us" (or "µs"), "ms", "s", "m", "h".
++ui:form:when=action=='latency'
++optional */
+  delay?: string
+  /** Errno defines the error code that returned by I/O action.
+refer to: https://www-numi.fnal.gov/offline_software/srt_public_context/WebDocs/Errors/unix_system_errors.html
++ui:form:when=action=='fault'
++optional */
+  errno?: number
+  /** Methods defines the I/O methods for injecting I/O chaos action.
+default: all I/O methods.
++optional */
+  methods?: string[]
+  mistake?: V1alpha1MistakeSpec
+  /** Path defines the path of files for injecting I/O chaos action.
++optional */
+  path?: string
+  /** Percent defines the percentage of injection errors and provides a number from 0-100.
+default: 100.
++optional
++kubebuilder:default=100 */
+  percent?: number
+  /** VolumePath represents the mount path of injected volume */
+  volumePath?: string
+}
+
+export interface V1alpha1CompositeFault {
+  io?: V1alpha1CompositeIOFault
+  /** Name is the name of the fault, which is unique in the CompositeChaos. */
+  name?: string
+  network?: V1alpha1CompositeNetworkFault
+  stress?: V1alpha1CompositeStressFault
+  time?: V1alpha1CompositeTimeFault
+}
+
+export interface V1alpha1CompositeChaosSpec {
+  /** ContainerNames indicates list of the name of affected container.
+If not set, the first container will be injected
++optional */
+  containerNames?: string[]
+  /** Duration represents the duration of the chaos action.
++optional */
+  duration?: string
+  /** Faults is the list of faults to be injected into every selected container. The faults are injected
+in order and recovered in the reverse order. If a fault fails to be injected, the faults injected
+before it are recovered, so that a container is either injected with all the faults or none of them.
++kubebuilder:validation:MinItems=1 */
+  faults?: V1alpha1CompositeFault[]
+  intermittent?: V1alpha1IntermittentSpec
+  /** Mode defines the mode to run chaos action.
+Supported mode: one / all / fixed / fixed-percent / random-max-percent
++kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
+  mode?: string
+  /** RemoteCluster represents the remote cluster where the chaos will be deployed
++optional */
+  remoteCluster?: string
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
+  selector?: V1alpha1PodSelectorSpec
+  /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
+If `FixedMode`, provide an integer of pods to do chaos action.
+If `FixedPercentMode`, provide a number from 0-100 to specify the percent of pods the server can do chaos action.
+IF `RandomMaxPercentMode`,  provide a number from 0-100 to specify the max percent of pods to do chaos action
++optional */
+  value?: string
+}
+
 export interface V1alpha1ClockSpec {
   /** the identifier of the particular clock on which to act.
 More clock description in linux kernel can be found in man page of clock_getres, clock_gettime, clock_settime.
@@ -2429,6 +3001,7 @@ export interface V1alpha1ChaosOnlyScheduleSpec {
   awsChaos?: V1alpha1AWSChaosSpec
   azureChaos?: V1alpha1AzureChaosSpec
   blockChaos?: V1alpha1BlockChaosSpec
+  compositeChaos?: V1alpha1CompositeChaosSpec
   /** +optional
 +kubebuilder:validation:Enum=Forbid;Allow */
   concurrencyPolicy?: string
@@ -2444,6 +3017,7 @@ export interface V1alpha1ChaosOnlyScheduleSpec {
   networkChaos?: V1alpha1NetworkChaosSpec
   physicalmachineChaos?: V1alpha1PhysicalMachineChaosSpec
   podChaos?: V1alpha1PodChaosSpec
+  podfaultChaos?: V1alpha1PodFaultChaosSpec
   schedule?: string
   /** +optional
 +nullable
@@ -2455,6 +3029,20 @@ export interface V1alpha1ChaosOnlyScheduleSpec {
   type?: string
 }
 
+export interface V1alpha1CgroupThrottle {
+  /** CPU limits the CPU time of the container through cpu.max, e.g. "500m" limits the container to
+half of a CPU.
++optional */
+  cpu?: string
+  /** IO limits the IO of the container on the block devices through io.max
++optional */
+  io?: V1alpha1IOThrottle[]
+  /** MemoryHigh sets memory.high of the container, over which the container is throttled and its
+memory is reclaimed heavily. It's in units of B, KB/KiB, MB/MiB, GB/GiB, e.g. "256MiB".
++optional */
+  memoryHigh?: string
+}
+
 export interface V1alpha1CPUStressor {
   /** Load specifies P percent loading per CPU worker. 0 is effectively a sleep (no load) and 100
 is full loading.
@@ -2493,6 +3081,7 @@ If not set, the first container will be injected
   /** Duration represents the duration of the chaos action.
 +optional */
   duration?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** Mode defines the mode to run chaos action.
 Supported mode: one / all / fixed / fixed-percent / random-max-percent
 +kubebuilder:validation:Enum=one;all;fixed;fixed-percent;random-max-percent */
@@ -2500,6 +3089,16 @@ Supported mode: one / all / fixed / fixed-percent / random-max-percent
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
+  reselect?: V1alpha1ReselectSpec
+  rollout?: V1alpha1RolloutSpec
   selector?: V1alpha1PodSelectorSpec
   /** Value is required when the mode is set to `FixedMode` / `FixedPercentMode` / `RandomMaxPercentMode`.
 If `FixedMode`, provide an integer of pods to do chaos action.
@@ -2548,6 +3147,7 @@ Needed in disk-detach.
   /** Duration represents the duration of the chaos action.
 +optional */
   duration?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** LUN indicates the Logical Unit Number of the data disk.
 Needed in disk-detach.
 +optional */
@@ -2555,6 +3155,14 @@ Needed in disk-detach.
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
   /** ResourceGroupName defines the name of ResourceGroup */
   resourceGroupName?: string
   /** SecretName defines the name of kubernetes secret. It is used for Azure credentials.
@@ -2612,9 +3220,18 @@ Needed in detach-volume.
 +ui:form:ignore
 +optional */
   endpoint?: string
+  intermittent?: V1alpha1IntermittentSpec
   /** RemoteCluster represents the remote cluster where the chaos will be deployed
 +optional */
   remoteCluster?: string
+  /** RemoteClusterSelector selects the remote clusters where the chaos will be fanned out by their labels,
+it could be used together with RemoteClusters
++optional */
+  remoteClusterSelector?: V1LabelSelector
+  /** RemoteClusters represents the remote clusters where the chaos will be fanned out, it could not be
+used together with RemoteCluster
++optional */
+  remoteClusters?: string[]
   /** SecretName defines the name of kubernetes secret.
 +optional */
   secretName?: string
@@ -4250,6 +4867,27 @@ export interface UtilsAPIError {
   type?: string
 }
 
+export interface TypesWorkflowTemplateDetail {
+  cluster_scope?: boolean
+  created_at?: string
+  description?: string
+  name?: string
+  /** Namespace is empty if the template is a ClusterWorkflowTemplate. */
+  namespace?: string
+  spec?: V1alpha1WorkflowTemplateSpec
+  uid?: string
+}
+
+export interface TypesWorkflowTemplateBase {
+  cluster_scope?: boolean
+  created_at?: string
+  description?: string
+  name?: string
+  /** Namespace is empty if the template is a ClusterWorkflowTemplate. */
+  namespace?: string
+  uid?: string
+}
+
 export interface TypesStatusCheckTemplateDetail {
   created_at?: string
   description?: string
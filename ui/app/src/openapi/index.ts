@@ -45,9 +45,12 @@ import type {
   GetSchedulesParams,
   GetTemplatesStatuschecksParams,
   GetTemplatesStatuschecksStatuscheckParams,
+  GetTemplatesWorkflowsParams,
+  GetTemplatesWorkflowsWorkflowParams,
   GetWorkflowsParams,
   PostExperiments200,
   PostExperimentsBody,
+  PostWorkflowsParams,
   StatusAllChaosStatus,
   TypesArchive,
   TypesArchiveDetail,
@@ -60,6 +63,8 @@ import type {
   TypesStatusCheckTemplate,
   TypesStatusCheckTemplateBase,
   TypesStatusCheckTemplateDetail,
+  TypesWorkflowTemplateBase,
+  TypesWorkflowTemplateDetail,
   UtilsAPIError,
   UtilsMapStringSliceResponse,
   UtilsResponse,
@@ -1881,6 +1886,104 @@ export const usePutTemplatesStatuschecksStatuscheck = <TError = UtilsAPIError, T
   >(mutationFn, mutationOptions)
 }
 
+/**
+ * Get the WorkflowTemplates and ClusterWorkflowTemplates from k8s cluster in real time.
+ * @summary List workflow templates.
+ */
+export const getTemplatesWorkflows = (params?: GetTemplatesWorkflowsParams, signal?: AbortSignal) => {
+  return customInstance<TypesWorkflowTemplateBase[]>({
+    url: `/templates/workflows`,
+    method: 'get',
+    params,
+    signal,
+  })
+}
+
+export const getGetTemplatesWorkflowsQueryKey = (params?: GetTemplatesWorkflowsParams) => [
+  `/templates/workflows`,
+  ...(params ? [params] : []),
+]
+
+export type GetTemplatesWorkflowsQueryResult = NonNullable<Awaited<ReturnType<typeof getTemplatesWorkflows>>>
+export type GetTemplatesWorkflowsQueryError = UtilsAPIError
+
+export const useGetTemplatesWorkflows = <
+  TData = Awaited<ReturnType<typeof getTemplatesWorkflows>>,
+  TError = UtilsAPIError
+>(
+  params?: GetTemplatesWorkflowsParams,
+  options?: { query?: UseQueryOptions<Awaited<ReturnType<typeof getTemplatesWorkflows>>, TError, TData> }
+): UseQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {}
+
+  const queryKey = queryOptions?.queryKey ?? getGetTemplatesWorkflowsQueryKey(params)
+
+  const queryFn: QueryFunction<Awaited<ReturnType<typeof getTemplatesWorkflows>>> = ({ signal }) =>
+    getTemplatesWorkflows(params, signal)
+
+  const query = useQuery<Awaited<ReturnType<typeof getTemplatesWorkflows>>, TError, TData>({
+    queryKey,
+    queryFn,
+    retry: 1,
+    retryDelay: 3000,
+    ...queryOptions,
+  }) as UseQueryResult<TData, TError> & { queryKey: QueryKey }
+
+  query.queryKey = queryKey
+
+  return query
+}
+
+/**
+ * Get the workflow template's detail by namespaced name, or by name if it's a cluster workflow template.
+ * @summary Get a workflow template.
+ */
+export const getTemplatesWorkflowsWorkflow = (params: GetTemplatesWorkflowsWorkflowParams, signal?: AbortSignal) => {
+  return customInstance<TypesWorkflowTemplateDetail>({
+    url: `/templates/workflows/workflow`,
+    method: 'get',
+    params,
+    signal,
+  })
+}
+
+export const getGetTemplatesWorkflowsWorkflowQueryKey = (params: GetTemplatesWorkflowsWorkflowParams) => [
+  `/templates/workflows/workflow`,
+  ...(params ? [params] : []),
+]
+
+export type GetTemplatesWorkflowsWorkflowQueryResult = NonNullable<
+  Awaited<ReturnType<typeof getTemplatesWorkflowsWorkflow>>
+>
+export type GetTemplatesWorkflowsWorkflowQueryError = UtilsAPIError
+
+export const useGetTemplatesWorkflowsWorkflow = <
+  TData = Awaited<ReturnType<typeof getTemplatesWorkflowsWorkflow>>,
+  TError = UtilsAPIError
+>(
+  params: GetTemplatesWorkflowsWorkflowParams,
+  options?: { query?: UseQueryOptions<Awaited<ReturnType<typeof getTemplatesWorkflowsWorkflow>>, TError, TData> }
+): UseQueryResult<TData, TError> & { queryKey: QueryKey } => {
+  const { query: queryOptions } = options ?? {}
+
+  const queryKey = queryOptions?.queryKey ?? getGetTemplatesWorkflowsWorkflowQueryKey(params)
+
+  const queryFn: QueryFunction<Awaited<ReturnType<typeof getTemplatesWorkflowsWorkflow>>> = ({ signal }) =>
+    getTemplatesWorkflowsWorkflow(params, signal)
+
+  const query = useQuery<Awaited<ReturnType<typeof getTemplatesWorkflowsWorkflow>>, TError, TData>({
+    queryKey,
+    queryFn,
+    retry: 1,
+    retryDelay: 3000,
+    ...queryOptions,
+  }) as UseQueryResult<TData, TError> & { queryKey: QueryKey }
+
+  query.queryKey = queryKey
+
+  return query
+}
+
 /**
  * List workflows from Kubernetes cluster.
  * @summary List workflows from Kubernetes cluster.
@@ -1921,12 +2024,13 @@ export const useGetWorkflows = <TData = Awaited<ReturnType<typeof getWorkflows>>
  * Create a new workflow.
  * @summary Create a new workflow.
  */
-export const postWorkflows = (v1alpha1WorkflowBody: V1alpha1WorkflowBody) => {
+export const postWorkflows = (v1alpha1WorkflowBody: V1alpha1WorkflowBody, params?: PostWorkflowsParams) => {
   return customInstance<CoreWorkflowDetail>({
     url: `/workflows`,
     method: 'post',
     headers: { 'Content-Type': 'application/json' },
     data: v1alpha1WorkflowBody,
+    params,
   })
 }
 
@@ -1938,24 +2042,27 @@ export const usePostWorkflows = <TError = UtilsAPIError, TContext = unknown>(opt
   mutation?: UseMutationOptions<
     Awaited<ReturnType<typeof postWorkflows>>,
     TError,
-    { data: V1alpha1WorkflowBody },
+    { data: V1alpha1WorkflowBody; params?: PostWorkflowsParams },
     TContext
   >
 }) => {
   const { mutation: mutationOptions } = options ?? {}
 
-  const mutationFn: MutationFunction<Awaited<ReturnType<typeof postWorkflows>>, { data: V1alpha1WorkflowBody }> = (
-    props
-  ) => {
-    const { data } = props ?? {}
+  const mutationFn: MutationFunction<
+    Awaited<ReturnType<typeof postWorkflows>>,
+    { data: V1alpha1WorkflowBody; params?: PostWorkflowsParams }
+  > = (props) => {
+    const { data, params } = props ?? {}
 
-    return postWorkflows(data)
+    return postWorkflows(data, params)
   }
 
-  return useMutation<Awaited<ReturnType<typeof postWorkflows>>, TError, { data: V1alpha1WorkflowBody }, TContext>(
-    mutationFn,
-    mutationOptions
-  )
+  return useMutation<
+    Awaited<ReturnType<typeof postWorkflows>>,
+    TError,
+    { data: V1alpha1WorkflowBody; params?: PostWorkflowsParams },
+    TContext
+  >(mutationFn, mutationOptions)
 }
 
 /**